@@ -0,0 +1,188 @@
+package client
+
+import (
+	"io"
+	"net/http"
+)
+
+// callOptions holds the options of a single call
+type callOptions struct {
+	header         http.Header  // Headers added to the request
+	responseHeader *http.Header // Receives the headers of the response
+	trailer        *http.Header // Receives the trailers of the response
+	statusCode     *int         // Receives the status code of the response
+	target         string       // Target the call is sent to instead of the target of the client
+	middlewares    []Middleware // Middlewares applied to the call after the ones of the client
+}
+
+// CallOption defines a function type for modifying the options of a single call,
+// generated client methods accept them after the request
+type CallOption func(o *callOptions)
+
+func newCallOptions(opts ...CallOption) *callOptions {
+	o := &callOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// RequestHeader adds a header to the request of the call
+//
+// Parameters:
+//   - key: The header key
+//   - value: The header value, added to the values already set
+//
+// Returns:
+//   - CallOption: A function that adds the header
+func RequestHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		if o.header == nil {
+			o.header = make(http.Header)
+		}
+		o.header.Add(key, value)
+	}
+}
+
+// ResponseHeader captures the headers of the response of the call, including the ones of
+// error responses
+//
+// Parameters:
+//   - header: The headers receiving the response headers
+//
+// Returns:
+//   - CallOption: A function that sets the header receiver
+func ResponseHeader(header *http.Header) CallOption {
+	return func(o *callOptions) {
+		o.responseHeader = header
+	}
+}
+
+// Trailer captures the trailers of the response of the call, they are set once the
+// response body has been read
+//
+// Parameters:
+//   - trailer: The headers receiving the response trailers
+//
+// Returns:
+//   - CallOption: A function that sets the trailer receiver
+func Trailer(trailer *http.Header) CallOption {
+	return func(o *callOptions) {
+		o.trailer = trailer
+	}
+}
+
+// StatusCode captures the status code of the response of the call
+//
+// Parameters:
+//   - code: The int receiving the status code
+//
+// Returns:
+//   - CallOption: A function that sets the status code receiver
+func StatusCode(code *int) CallOption {
+	return func(o *callOptions) {
+		o.statusCode = code
+	}
+}
+
+// Target sends the call to target instead of the target of the client, it is resolved
+// as the target of the client is
+//
+// Parameters:
+//   - target: The target of the call, e.g. http://localhost:8080/api
+//
+// Returns:
+//   - CallOption: A function that sets the target
+func Target(target string) CallOption {
+	return func(o *callOptions) {
+		o.target = target
+	}
+}
+
+// CallMiddlewares appends middlewares applied to the call after the middlewares of the client
+//
+// Parameters:
+//   - middlewares: A variadic list of middlewares to append
+//
+// Returns:
+//   - CallOption: A function that appends the middlewares
+func CallMiddlewares(middlewares ...Middleware) CallOption {
+	return func(o *callOptions) {
+		o.middlewares = append(o.middlewares, middlewares...)
+	}
+}
+
+// CallTarget returns the target the call is sent to, the one set by the Target option
+// or else the target of the client
+//
+// Parameters:
+//   - target: The target of the client
+//   - opts: The options of the call
+//
+// Returns:
+//   - string: The target of the call
+func CallTarget(target string, opts ...CallOption) string {
+	if o := newCallOptions(opts...); o.target != "" {
+		return o.target
+	}
+	return target
+}
+
+// apply adds the headers of the call to the request and chains the middlewares of the
+// call after the middleware of the client
+func (o *callOptions) apply(middleware Middleware, request *http.Request) Middleware {
+	if len(o.header) > 0 {
+		request.Header = request.Header.Clone()
+		if request.Header == nil {
+			request.Header = make(http.Header)
+		}
+		for key, values := range o.header {
+			for _, value := range values {
+				request.Header.Add(key, value)
+			}
+		}
+	}
+	if len(o.middlewares) == 0 {
+		return middleware
+	}
+	if middleware == nil {
+		return Chain(o.middlewares...)
+	}
+	return Chain(append([]Middleware{middleware}, o.middlewares...)...)
+}
+
+// capture copies the headers and the status code of the response to their receivers,
+// the trailers are copied once the body has been read
+func (o *callOptions) capture(response *http.Response) {
+	if o.responseHeader != nil {
+		*o.responseHeader = response.Header
+	}
+	if o.statusCode != nil {
+		*o.statusCode = response.StatusCode
+	}
+	if o.trailer != nil && response.Body != nil {
+		response.Body = &trailerReader{ReadCloser: response.Body, response: response, trailer: o.trailer}
+	}
+}
+
+// trailerReader copies the trailers of a response to their receiver when its body is
+// read to the end or closed
+type trailerReader struct {
+	io.ReadCloser
+	response *http.Response
+	trailer  *http.Header
+}
+
+func (r *trailerReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err == io.EOF {
+		*r.trailer = r.response.Trailer
+	}
+	return n, err
+}
+
+func (r *trailerReader) Close() error {
+	err := r.ReadCloser.Close()
+	*r.trailer = r.response.Trailer
+	return err
+}
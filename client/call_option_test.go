@@ -0,0 +1,107 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCallOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Trailer", "X-Checksum")
+		w.Header().Set("X-Echo", r.Header.Get("X-Request"))
+		w.Header().Set("X-Middlewares", r.Header.Get("X-Client")+","+r.Header.Get("X-Call"))
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("OK"))
+		w.Header().Set("X-Checksum", "abc")
+	}))
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	var header, trailer http.Header
+	var statusCode int
+	var order []string
+	clientMdw := func(cli *http.Client, request *http.Request, invoker Invoker) (*http.Response, error) {
+		order = append(order, "client")
+		request.Header.Set("X-Client", "client")
+		return invoker(cli, request)
+	}
+	callMdw := func(cli *http.Client, request *http.Request, invoker Invoker) (*http.Response, error) {
+		order = append(order, "call")
+		request.Header.Set("X-Call", "call")
+		return invoker(cli, request)
+	}
+	response, err := Invoke(clientMdw, &http.Client{}, request, nil,
+		RequestHeader("X-Request", "value"),
+		ResponseHeader(&header),
+		Trailer(&trailer),
+		StatusCode(&statusCode),
+		CallMiddlewares(callMdw),
+	)
+	if err != nil {
+		t.Fatalf("Invoke returned error: %v", err)
+	}
+	if statusCode != http.StatusAccepted {
+		t.Errorf("Expected status code %d, got %d", http.StatusAccepted, statusCode)
+	}
+	if got := header.Get("X-Echo"); got != "value" {
+		t.Errorf("Expected the request header to reach the server, got %q", got)
+	}
+	if got := header.Get("X-Middlewares"); got != "client,call" {
+		t.Errorf("Expected both middlewares to run, got %q", got)
+	}
+	if len(order) != 2 || order[0] != "client" || order[1] != "call" {
+		t.Errorf("Expected the call middlewares after the client ones, got %v", order)
+	}
+	if trailer.Get("X-Checksum") != "" {
+		t.Error("Expected no trailers before the body is read")
+	}
+	if _, err := io.ReadAll(response.Body); err != nil {
+		t.Fatalf("Failed to read body: %v", err)
+	}
+	response.Body.Close()
+	if got := trailer.Get("X-Checksum"); got != "abc" {
+		t.Errorf("Expected trailer X-Checksum to be abc, got %q", got)
+	}
+	if request.Header.Get("X-Request") != "" {
+		t.Error("Expected the call headers not to modify the given request")
+	}
+}
+
+func TestCallOptionsWithoutMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Call", r.Header.Get("X-Call"))
+	}))
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	var header http.Header
+	callMdw := func(cli *http.Client, request *http.Request, invoker Invoker) (*http.Response, error) {
+		request.Header.Set("X-Call", "call")
+		return invoker(cli, request)
+	}
+	response, err := Invoke(nil, &http.Client{}, request, nil, CallMiddlewares(callMdw), ResponseHeader(&header))
+	if err != nil {
+		t.Fatalf("Invoke returned error: %v", err)
+	}
+	response.Body.Close()
+	if got := header.Get("X-Call"); got != "call" {
+		t.Errorf("Expected the call middleware to run, got %q", got)
+	}
+}
+
+func TestCallTarget(t *testing.T) {
+	if got := CallTarget("http://client"); got != "http://client" {
+		t.Errorf("Expected the target of the client, got %q", got)
+	}
+	if got := CallTarget("http://client", Target("http://call")); got != "http://call" {
+		t.Errorf("Expected the target of the call, got %q", got)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	rpchttp "google.golang.org/genproto/googleapis/rpc/http"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // EncodeMessage encodes a protobuf message into an HTTP request.
@@ -37,6 +38,29 @@ func EncodeMessage(ctx context.Context, req proto.Message, header http.Header, b
 	return nil
 }
 
+// OmitFields returns a copy of req without its top-level fields named names. The generated
+// encoders leave the fields bound to headers and cookies out of a "*" body.
+//
+// Parameters:
+//   - req: The protobuf message to copy, it is not modified
+//   - names: The proto names of the fields to leave out
+//
+// Returns:
+//   - proto.Message: req itself if no field is named, a copy of req otherwise
+func OmitFields(req proto.Message, names ...protoreflect.Name) proto.Message {
+	if len(names) == 0 {
+		return req
+	}
+	clone := proto.Clone(req)
+	m := clone.ProtoReflect()
+	for _, name := range names {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil {
+			m.Clear(fd)
+		}
+	}
+	return clone
+}
+
 // EncodeHttpBody encodes an HttpBody message into an HTTP request.
 // It writes the raw data from the HttpBody to the body writer
 // and sets the content type header from the HttpBody.
@@ -58,6 +58,28 @@ func TestEncodeMessage(t *testing.T) {
 	}
 }
 
+func TestOmitFields(t *testing.T) {
+	req, err := structpb.NewStruct(map[string]interface{}{"key": "value"})
+	if err != nil {
+		t.Fatalf("Failed to create test struct: %v", err)
+	}
+
+	if got := OmitFields(req); got != req {
+		t.Error("OmitFields without names should return the message itself")
+	}
+
+	got, ok := OmitFields(req, "fields", "unknown").(*structpb.Struct)
+	if !ok {
+		t.Fatalf("OmitFields returned %T, want *structpb.Struct", got)
+	}
+	if len(got.GetFields()) != 0 {
+		t.Errorf("OmitFields kept the field: %v", got.GetFields())
+	}
+	if len(req.GetFields()) != 1 {
+		t.Errorf("OmitFields modified the request: %v", req.GetFields())
+	}
+}
+
 func TestEncodeHttpBody(t *testing.T) {
 	// Test data
 	testContentType := "application/json"
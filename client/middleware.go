@@ -75,22 +75,34 @@ func getInvoker(interceptors []Middleware, curr int, finalInvoker Invoker) Invok
 
 // Invoke executes an HTTP request with the given middleware.
 // If no middleware is provided, it directly executes the request using the HTTP client.
+// The options of the call add headers to the request, chain middlewares after the given
+// middleware and capture the headers, trailers and status code of the response.
 //
 // Parameters:
-//   - ctx: The context.Context for the request
 //   - middleware: The middleware to apply to the request (can be nil)
 //   - cli: The HTTP client to use for the request
 //   - request: The HTTP request to execute
+//   - routeInfo: The route information injected into the request context
+//   - opts: The options of the call
 //
 // Returns:
 //   - *http.Response: The HTTP response from the request
 //   - error: Any error that occurred during the request, or nil if successful
-func Invoke(middleware Middleware, cli *http.Client, request *http.Request, routeInfo *goose.RouteInfo) (*http.Response, error) {
+func Invoke(middleware Middleware, cli *http.Client, request *http.Request, routeInfo *goose.RouteInfo, opts ...CallOption) (*http.Response, error) {
 	request = request.WithContext(goose.InjectRouteInfo(request.Context(), routeInfo))
+	o := newCallOptions(opts...)
+	middleware = o.apply(middleware, request)
+	var response *http.Response
+	var err error
 	if middleware == nil {
-		return invoke(cli, request)
+		response, err = invoke(cli, request)
+	} else {
+		response, err = middleware(cli, request, invoke)
+	}
+	if response != nil {
+		o.capture(response)
 	}
-	return middleware(cli, request, invoke)
+	return response, err
 }
 
 func invoke(cli *http.Client, request *http.Request) (*http.Response, error) {
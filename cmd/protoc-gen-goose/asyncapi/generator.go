@@ -0,0 +1,335 @@
+// Package asyncapi generates AsyncAPI documents describing the websocket routes of streaming
+// methods: the channel of each route, the messages each side sends and the lifecycle of the
+// stream, that is the empty end-of-stream frame ending the messages of a side and the side
+// closing the connection.
+package asyncapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/openapi"
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Versions of the generated documents.
+const (
+	// Version30 writes AsyncAPI 3.0.0 documents.
+	Version30 = "3.0"
+	// Version26 writes AsyncAPI 2.6.0 documents.
+	Version26 = "2.6"
+)
+
+// ParseVersion returns the version of the documents selected by the asyncapi_version parameter.
+// An empty value selects AsyncAPI 3.0.
+func ParseVersion(value string) (string, error) {
+	switch value {
+	case "", "3", "3.0", "3.0.0":
+		return Version30, nil
+	case "2", "2.6", "2.6.0":
+		return Version26, nil
+	}
+	return "", fmt.Errorf("asyncapi: unsupported asyncapi_version %q, expected 3.0 or 2.6", value)
+}
+
+const (
+	// endOfStream is the name of the message standing for the end-of-stream marker.
+	endOfStream = "goose.EndOfStream"
+	// messagesPrefix is the prefix of the references to component messages.
+	messagesPrefix = "#/components/messages/"
+	// wsBindingVersion is the version of the websocket bindings.
+	wsBindingVersion = "0.1.0"
+)
+
+var addressParameter = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Generator generates the AsyncAPI documents of proto files.
+type Generator struct {
+	// Version is the version of the documents, one of Version30 and Version26.
+	Version string
+	// ProtoNames names the properties of the schemas by the proto names of the fields,
+	// for streams marshaling with protojson UseProtoNames.
+	ProtoNames bool
+}
+
+// channel is a websocket route of a streaming method, rendered by both versions.
+type channel struct {
+	name        string
+	address     string
+	summary     string
+	description string
+	lifecycle   string
+	tag         *Tag
+	parameters  map[string]*Parameter
+	request     string
+	response    string
+	stream      *Stream
+}
+
+// Generate creates an AsyncAPI document for the streaming methods of the given services,
+// writing it as a generated JSON file. Files without streaming methods get no document.
+func (gen *Generator) Generate(plugin *protogen.Plugin, file *protogen.File, services []*parser.Service) error {
+	var streamings []*parser.Service
+	for _, service := range services {
+		if streaming := service.StreamingService(); len(streaming.Endpoints) > 0 {
+			streamings = append(streamings, streaming)
+		}
+	}
+	if len(streamings) == 0 {
+		return nil
+	}
+
+	// Schemas are the ones of the OpenAPI documents, written as JSON Schema
+	collector := openapi.NewSchemaCollector()
+	collector.ProtoNames = gen.ProtoNames
+	schemas := collector.Collect(streamings)
+	for _, schema := range schemas {
+		openapi.ToJSONSchema(schema)
+	}
+	components := &Components{
+		Messages: map[string]*Message{
+			endOfStream: {
+				Name:        "EndOfStream",
+				Title:       "End of stream",
+				Description: "An empty text frame, sent by a side after its last message since websocket can not half-close a connection.",
+				ContentType: "text/plain",
+				Payload:     &openapi.Schema{Type: "string", Enum: []any{""}},
+			},
+		},
+		Schemas: schemas,
+	}
+
+	var channels []*channel
+	for _, service := range streamings {
+		tag := &Tag{
+			Name:        string(service.ProtoService.Desc.Name()),
+			Description: openapi.CommentText(service.ProtoService.Comments),
+		}
+		for _, endpoint := range service.Bindings() {
+			request := addMessage(components, endpoint.Input())
+			response := addMessage(components, endpoint.Output())
+			summary, description := openapi.SplitSummary(openapi.CommentText(endpoint.Comments()))
+			if summary == "" {
+				summary = endpoint.Name()
+			}
+			address := channelAddress(endpoint.RoutePath())
+			stream := newStream(endpoint)
+			channels = append(channels, &channel{
+				name:        tag.Name + "_" + endpoint.BindingName(),
+				address:     address,
+				summary:     summary,
+				description: description,
+				lifecycle:   lifecycle(stream, components.Messages[request].Name, components.Messages[response].Name),
+				tag:         tag,
+				parameters:  channelParameters(address),
+				request:     request,
+				response:    response,
+				stream:      stream,
+			})
+		}
+	}
+
+	info := &Info{
+		Title:   fmt.Sprintf("%s API", file.Desc.Package()),
+		Version: "1.0.0",
+	}
+	var out any
+	switch gen.Version {
+	case Version26:
+		out = documentV2(info, channels, components)
+	default:
+		out = document(info, channels, components)
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal AsyncAPI document: %w", err)
+	}
+	g := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+"_goose.asyncapi.json", "")
+	g.Write(data)
+	return nil
+}
+
+// document renders the channels as an AsyncAPI 3.0 document. Each channel gets a receive
+// operation for the messages of the client and a send operation for the ones of the server.
+func document(info *Info, channels []*channel, components *Components) *Document {
+	doc := &Document{
+		AsyncAPI:           "3.0.0",
+		Info:               info,
+		DefaultContentType: "application/json",
+		Channels:           make(map[string]*Channel, len(channels)),
+		Operations:         make(map[string]*Operation, 2*len(channels)),
+		Components:         components,
+	}
+	for _, ch := range channels {
+		doc.Channels[ch.name] = &Channel{
+			Address:     ch.address,
+			Title:       ch.summary,
+			Description: ch.lifecycle,
+			Messages: map[string]*Reference{
+				"request":     {Ref: messagesPrefix + ch.request},
+				"response":    {Ref: messagesPrefix + ch.response},
+				"endOfStream": {Ref: messagesPrefix + endOfStream},
+			},
+			Parameters: ch.parameters,
+			Bindings:   wsBindings(),
+		}
+		channelRef := "#/channels/" + ch.name
+		receive := []*Reference{{Ref: channelRef + "/messages/request"}}
+		if ch.stream.ClientEndOfStream {
+			receive = append(receive, &Reference{Ref: channelRef + "/messages/endOfStream"})
+		}
+		doc.Operations[ch.name+"_receive"] = &Operation{
+			Action:      "receive",
+			Channel:     &Reference{Ref: channelRef},
+			Summary:     ch.summary,
+			Description: ch.description,
+			Tags:        []*Tag{ch.tag},
+			Messages:    receive,
+			Stream:      ch.stream,
+		}
+		doc.Operations[ch.name+"_send"] = &Operation{
+			Action:      "send",
+			Channel:     &Reference{Ref: channelRef},
+			Summary:     ch.summary,
+			Description: ch.description,
+			Tags:        []*Tag{ch.tag},
+			Messages: []*Reference{
+				{Ref: channelRef + "/messages/response"},
+				{Ref: channelRef + "/messages/endOfStream"},
+			},
+			Stream: ch.stream,
+		}
+	}
+	return doc
+}
+
+// documentV2 renders the channels as an AsyncAPI 2.6 document, channels are keyed by their
+// address. Publish describes the messages of the client, subscribe the ones of the server.
+func documentV2(info *Info, channels []*channel, components *Components) *DocumentV2 {
+	doc := &DocumentV2{
+		AsyncAPI:           "2.6.0",
+		Info:               info,
+		DefaultContentType: "application/json",
+		Channels:           make(map[string]*ChannelV2, len(channels)),
+		Components:         components,
+	}
+	for _, ch := range channels {
+		publish := []*Reference{{Ref: messagesPrefix + ch.request}}
+		if ch.stream.ClientEndOfStream {
+			publish = append(publish, &Reference{Ref: messagesPrefix + endOfStream})
+		}
+		doc.Channels[ch.address] = &ChannelV2{
+			Description: ch.lifecycle,
+			Parameters:  ch.parameters,
+			Bindings:    wsBindings(),
+			Publish: &OperationV2{
+				OperationID: ch.name + "_receive",
+				Summary:     ch.summary,
+				Description: ch.description,
+				Tags:        []*Tag{ch.tag},
+				Message:     &MessageV2{OneOf: publish},
+				Stream:      ch.stream,
+			},
+			Subscribe: &OperationV2{
+				OperationID: ch.name + "_send",
+				Summary:     ch.summary,
+				Description: ch.description,
+				Tags:        []*Tag{ch.tag},
+				Message: &MessageV2{OneOf: []*Reference{
+					{Ref: messagesPrefix + ch.response},
+					{Ref: messagesPrefix + endOfStream},
+				}},
+				Stream: ch.stream,
+			},
+		}
+	}
+	return doc
+}
+
+// addMessage adds the message of a proto message to components, returning its name.
+func addMessage(components *Components, msg *protogen.Message) string {
+	name := string(msg.Desc.FullName())
+	if _, ok := components.Messages[name]; ok {
+		return name
+	}
+	payload := openapi.GetSchema(msg)
+	openapi.ToJSONSchema(payload)
+	components.Messages[name] = &Message{
+		Name:        string(msg.Desc.Name()),
+		Description: openapi.CommentText(msg.Comments),
+		ContentType: "application/json",
+		Payload:     payload,
+	}
+	return name
+}
+
+// newStream describes the lifecycle of the stream of a streaming method. The server always
+// ends its messages with the end-of-stream marker and closes the connection once its
+// handler returns, the client of a server-streaming method sends its only request as the
+// first frame.
+func newStream(endpoint *parser.Endpoint) *Stream {
+	stream := &Stream{
+		ClientMessages:    "many",
+		ServerMessages:    "many",
+		ClientEndOfStream: true,
+		ServerEndOfStream: true,
+		ClosedBy:          "server",
+	}
+	switch {
+	case endpoint.IsClientStreaming():
+		stream.Kind = "client-streaming"
+		stream.ServerMessages = "one"
+	case endpoint.IsServerStreaming():
+		stream.Kind = "server-streaming"
+		stream.ClientMessages = "one"
+		stream.ClientEndOfStream = false
+	default:
+		stream.Kind = "bidi-streaming"
+	}
+	return stream
+}
+
+// lifecycle describes the lifecycle of a stream in words.
+func lifecycle(stream *Stream, request string, response string) string {
+	var client, server string
+	if stream.ClientMessages == "one" {
+		client = fmt.Sprintf("the client sends one %s message as the first frame", request)
+	} else {
+		client = fmt.Sprintf("the client sends %s messages, then an empty end-of-stream frame once it is done", request)
+	}
+	if stream.ServerMessages == "one" {
+		server = fmt.Sprintf("the server replies with one %s message", response)
+	} else {
+		server = fmt.Sprintf("the server sends %s messages", response)
+	}
+	return fmt.Sprintf("Websocket %s stream: %s; %s, then an empty end-of-stream frame, and closes the connection with a normal closure.", stream.Kind, client, server)
+}
+
+// channelAddress returns the address of the channel of a route pattern,
+// wildcards such as {path...} become plain parameters.
+func channelAddress(pattern string) string {
+	address := strings.ReplaceAll(pattern, "{$}", "")
+	return strings.ReplaceAll(address, "...}", "}")
+}
+
+// channelParameters describes the parameters of a channel address.
+func channelParameters(address string) map[string]*Parameter {
+	matches := addressParameter.FindAllStringSubmatch(address, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+	parameters := make(map[string]*Parameter, len(matches))
+	for _, match := range matches {
+		parameters[match[1]] = &Parameter{Description: fmt.Sprintf("The %s segment of the route.", match[1])}
+	}
+	return parameters
+}
+
+func wsBindings() *ChannelBindings {
+	return &ChannelBindings{WS: &WebsocketBinding{Method: http.MethodGet, BindingVersion: wsBindingVersion}}
+}
@@ -0,0 +1,137 @@
+package asyncapi
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"github.com/soyacen/goose/example/websocket"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// generate runs the generator on file as protoc would run it and returns the generated document.
+func generate(t *testing.T, file protoreflect.FileDescriptor, version string) []byte {
+	t.Helper()
+	var files []*descriptorpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(file)
+	parameter := "paths=source_relative"
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.Path()},
+		Parameter:      &parameter,
+		ProtoFile:      files,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	services, err := parser.NewServices(plugin.FilesByPath[file.Path()])
+	if err != nil {
+		t.Fatalf("failed to parse services: %v", err)
+	}
+	gen := &Generator{Version: version}
+	if err := gen.Generate(plugin, plugin.FilesByPath[file.Path()], services); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	resp := plugin.Response()
+	if resp.Error != nil {
+		t.Fatalf("plugin error: %s", resp.GetError())
+	}
+	if len(resp.File) != 1 {
+		t.Fatalf("expected 1 generated file, got %d", len(resp.File))
+	}
+	if name := resp.File[0].GetName(); name != "example/websocket/websocket_goose.asyncapi.json" {
+		t.Fatalf("unexpected file name %s", name)
+	}
+	return []byte(resp.File[0].GetContent())
+}
+
+func TestGolden(t *testing.T) {
+	versions := map[string]string{
+		Version30: "websocket.asyncapi30.json",
+		Version26: "websocket.asyncapi26.json",
+	}
+	for version, name := range versions {
+		t.Run(version, func(t *testing.T) {
+			got := generate(t, websocket.File_example_websocket_websocket_proto, version)
+			golden := filepath.Join("testdata", name)
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("%s does not match the generated document, run go test -update to update it", golden)
+			}
+		})
+	}
+}
+
+func TestStreamLifecycle(t *testing.T) {
+	doc := &Document{}
+	if err := json.Unmarshal(generate(t, websocket.File_example_websocket_websocket_proto, Version30), doc); err != nil {
+		t.Fatalf("failed to unmarshal document: %v", err)
+	}
+	tests := map[string]Stream{
+		"Websocket_ClientStream": {Kind: "client-streaming", ClientMessages: "many", ServerMessages: "one", ClientEndOfStream: true, ServerEndOfStream: true, ClosedBy: "server"},
+		"Websocket_ServerStream": {Kind: "server-streaming", ClientMessages: "one", ServerMessages: "many", ClientEndOfStream: false, ServerEndOfStream: true, ClosedBy: "server"},
+		"Chat_Talk":              {Kind: "bidi-streaming", ClientMessages: "many", ServerMessages: "many", ClientEndOfStream: true, ServerEndOfStream: true, ClosedBy: "server"},
+	}
+	for name, want := range tests {
+		receive := doc.Operations[name+"_receive"]
+		if receive == nil || receive.Action != "receive" || *receive.Stream != want {
+			t.Errorf("unexpected receive operation of %s: %+v", name, receive)
+			continue
+		}
+		if got := len(receive.Messages); want.ClientEndOfStream && got != 2 || !want.ClientEndOfStream && got != 1 {
+			t.Errorf("%s receives %d messages", name, got)
+		}
+		if send := doc.Operations[name+"_send"]; send == nil || send.Action != "send" || len(send.Messages) != 2 {
+			t.Errorf("unexpected send operation of %s: %+v", name, send)
+		}
+	}
+	if address := doc.Channels["Chat_Talk"].Address; address != "/v1/talk" {
+		t.Errorf("Chat_Talk address = %q", address)
+	}
+	if _, ok := doc.Channels["Chat_CreateRoom"]; ok {
+		t.Error("unary methods should not have channels")
+	}
+}
+
+func TestChannelAddress(t *testing.T) {
+	address := channelAddress("/v1/rooms/{id}/files/{path...}")
+	if address != "/v1/rooms/{id}/files/{path}" {
+		t.Errorf("address = %q", address)
+	}
+	parameters := channelParameters(address)
+	if len(parameters) != 2 || parameters["id"] == nil || parameters["path"] == nil {
+		t.Errorf("unexpected parameters %v", parameters)
+	}
+	if channelParameters("/v1/talk") != nil {
+		t.Error("expected no parameters")
+	}
+}
@@ -0,0 +1,137 @@
+package asyncapi
+
+import "github.com/soyacen/goose/cmd/protoc-gen-goose/openapi"
+
+// Document is the root object of an AsyncAPI 3.0 specification.
+type Document struct {
+	AsyncAPI           string                `json:"asyncapi"`
+	Info               *Info                 `json:"info"`
+	DefaultContentType string                `json:"defaultContentType,omitempty"`
+	Channels           map[string]*Channel   `json:"channels,omitempty"`
+	Operations         map[string]*Operation `json:"operations,omitempty"`
+	Components         *Components           `json:"components,omitempty"`
+}
+
+// Info provides metadata about the API.
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Channel describes a websocket route, the messages sent on it are keyed by their role:
+// request, response and endOfStream.
+type Channel struct {
+	Address     string                `json:"address"`
+	Title       string                `json:"title,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Messages    map[string]*Reference `json:"messages,omitempty"`
+	Parameters  map[string]*Parameter `json:"parameters,omitempty"`
+	Bindings    *ChannelBindings      `json:"bindings,omitempty"`
+}
+
+// Parameter describes a variable of a channel address.
+type Parameter struct {
+	Description string `json:"description,omitempty"`
+}
+
+// ChannelBindings holds the protocol specific information of a channel.
+type ChannelBindings struct {
+	WS *WebsocketBinding `json:"ws,omitempty"`
+}
+
+// WebsocketBinding describes the http request opening a websocket channel.
+type WebsocketBinding struct {
+	Method         string `json:"method,omitempty"`
+	BindingVersion string `json:"bindingVersion,omitempty"`
+}
+
+// Operation describes the messages the server sends or receives on a channel.
+type Operation struct {
+	Action      string       `json:"action"`
+	Channel     *Reference   `json:"channel"`
+	Summary     string       `json:"summary,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Tags        []*Tag       `json:"tags,omitempty"`
+	Messages    []*Reference `json:"messages,omitempty"`
+	// Stream is the x-goose-stream extension describing the lifecycle of the stream.
+	Stream *Stream `json:"x-goose-stream,omitempty"`
+}
+
+// Stream describes the lifecycle of a websocket stream: how many messages each side sends,
+// how each side ends its messages and which side closes the connection.
+type Stream struct {
+	// Kind is client-streaming, server-streaming or bidi-streaming.
+	Kind string `json:"kind"`
+	// ClientMessages is one or many, the number of messages the client sends.
+	ClientMessages string `json:"clientMessages"`
+	// ServerMessages is one or many, the number of messages the server sends.
+	ServerMessages string `json:"serverMessages"`
+	// ClientEndOfStream reports whether the client sends the end-of-stream marker after its messages.
+	ClientEndOfStream bool `json:"clientEndOfStream"`
+	// ServerEndOfStream reports whether the server sends the end-of-stream marker after its messages.
+	ServerEndOfStream bool `json:"serverEndOfStream"`
+	// ClosedBy is the side closing the websocket connection.
+	ClosedBy string `json:"closedBy"`
+}
+
+// Tag groups the operations of a service.
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// Message describes a websocket text frame.
+type Message struct {
+	Name        string          `json:"name,omitempty"`
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	ContentType string          `json:"contentType,omitempty"`
+	Payload     *openapi.Schema `json:"payload,omitempty"`
+}
+
+// Reference is a reference to another object of the document.
+type Reference struct {
+	Ref string `json:"$ref"`
+}
+
+// Components holds the reusable messages and schemas.
+type Components struct {
+	Messages map[string]*Message        `json:"messages,omitempty"`
+	Schemas  map[string]*openapi.Schema `json:"schemas,omitempty"`
+}
+
+// DocumentV2 is the root object of an AsyncAPI 2.6 specification.
+type DocumentV2 struct {
+	AsyncAPI           string                `json:"asyncapi"`
+	Info               *Info                 `json:"info"`
+	DefaultContentType string                `json:"defaultContentType,omitempty"`
+	Channels           map[string]*ChannelV2 `json:"channels"`
+	Components         *Components           `json:"components,omitempty"`
+}
+
+// ChannelV2 describes a websocket route keyed by its address. Publish describes the messages
+// clients send to the server, Subscribe the messages the server sends to clients.
+type ChannelV2 struct {
+	Description string                `json:"description,omitempty"`
+	Parameters  map[string]*Parameter `json:"parameters,omitempty"`
+	Bindings    *ChannelBindings      `json:"bindings,omitempty"`
+	Publish     *OperationV2          `json:"publish,omitempty"`
+	Subscribe   *OperationV2          `json:"subscribe,omitempty"`
+}
+
+// OperationV2 describes the messages sent in one direction of a channel.
+type OperationV2 struct {
+	OperationID string     `json:"operationId,omitempty"`
+	Summary     string     `json:"summary,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Tags        []*Tag     `json:"tags,omitempty"`
+	Message     *MessageV2 `json:"message,omitempty"`
+	// Stream is the x-goose-stream extension describing the lifecycle of the stream.
+	Stream *Stream `json:"x-goose-stream,omitempty"`
+}
+
+// MessageV2 lists the messages an operation may carry.
+type MessageV2 struct {
+	OneOf []*Reference `json:"oneOf"`
+}
@@ -9,7 +9,7 @@ import (
 type Generator struct{}
 
 func (f *Generator) GenerateNewClient(service *parser.Service, g *protogen.GeneratedFile) error {
-	g.P("func ", service.NewClientName(), "(target string, opts ...", constant.ClientOptionIdent, ") ", service.ServiceName(), " {")
+	g.P("func ", service.NewClientName(), "(target string, opts ...", constant.ClientOptionIdent, ") ", service.ClientName(), " {")
 	g.P("options := ", constant.ClientNewOptionsIdent, "(opts...)")
 	g.P("client :=  &", service.Unexported(service.ClientName()), "{")
 	g.P("client: options.Client(),")
@@ -34,6 +34,18 @@ func (f *Generator) GenerateNewClient(service *parser.Service, g *protogen.Gener
 }
 
 func (f *Generator) GenerateClient(service *parser.Service, g *protogen.GeneratedFile) error {
+	// the methods of the client take the options of the call after the request.
+	g.P("type ", service.ClientName(), " interface {")
+	for _, endpoint := range service.Endpoints {
+		if endpoint.Deprecated() {
+			g.P(constant.DeprecationComment)
+		}
+		g.P(endpoint.Name(), "(ctx ", constant.ContextIdent, ", req *", endpoint.InputGoIdent(), ", opts ...", constant.ClientCallOptionIdent, ") (*", endpoint.OutputGoIdent(), ", error)")
+	}
+	g.P("}")
+	g.P()
+	g.P("var _ ", service.ClientName(), " = (*", service.Unexported(service.ClientName()), ")(nil)")
+	g.P()
 	g.P("type ", service.Unexported(service.ClientName()), " struct {")
 	g.P("client *", constant.ClientIdent)
 	g.P("encoder ", service.Unexported(service.RequestEncoderName()))
@@ -43,16 +55,23 @@ func (f *Generator) GenerateClient(service *parser.Service, g *protogen.Generate
 	g.P("middleware ", constant.ClientMiddlewareIdent)
 	g.P("}")
 	g.P()
+	// clients always call the primary binding, additional bindings only add server routes.
 	for _, endpoint := range service.Endpoints {
-		g.P("func (c *", service.Unexported(service.ClientName()), ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ", req *", endpoint.InputGoIdent(), ") (*", endpoint.OutputGoIdent(), ", error){")
+		g.P("func (c *", service.Unexported(service.ClientName()), ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ", req *", endpoint.InputGoIdent(), ", opts ...", constant.ClientCallOptionIdent, ") (*", endpoint.OutputGoIdent(), ", error){")
+		if timeout := endpoint.Timeout(); timeout > 0 {
+			g.P(append(append([]any{"ctx, cancel := ", constant.ContextWithTimeoutIdent, "(ctx, "}, constant.DurationExpr(timeout)...), ")")...)
+			g.P("defer cancel()")
+		}
 		g.P("if err := ", constant.ValidateRequestIdent, "(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {")
 		g.P("return nil, err")
 		g.P("}")
-		g.P("request, err := c.encoder.", endpoint.Name(), "(ctx, req)")
+		g.P("encoder := c.encoder")
+		g.P("encoder.target = ", constant.ClientCallTargetIdent, "(encoder.target, opts...)")
+		g.P("request, err := encoder.", endpoint.Name(), "(ctx, req)")
 		g.P("if err != nil {")
 		g.P("return nil, err")
 		g.P("}")
-		g.P("response, err := ", constant.ClientInvokeIdent, "(c.middleware, c.client, request, ", endpoint.DescName(), ".RouteInfo)")
+		g.P("response, err := ", constant.ClientInvokeIdent, "(c.middleware, c.client, request, ", endpoint.DescName(), ".RouteInfo, opts...)")
 		g.P("if err != nil {")
 		g.P("return nil, err")
 		g.P("}")
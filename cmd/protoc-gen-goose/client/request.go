@@ -2,6 +2,7 @@ package client
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/constant"
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
@@ -41,13 +42,21 @@ func (f *Generator) GenerateRequestEncoder(service *parser.Service, g *protogen.
 			case "google.rpc.HttpRequest":
 				f.PrintEncodeHttpRequestToRequest(g, srcValue)
 			default:
+				if metadataFields := endpoint.MetadataFields(); len(metadataFields) > 0 {
+					// the fields bound to headers and cookies are left out of the body
+					srcValue = []any{constant.OmitFieldsIdent, "(req"}
+					for _, metadataField := range metadataFields {
+						srcValue = append(srcValue, ", ", strconv.Quote(string(metadataField.FieldPath.Leaf().Desc.Name())))
+					}
+					srcValue = append(srcValue, ")")
+				}
 				f.PrintEncodeMessageToRequest(g, srcValue)
 			}
 		} else if bodyField != nil {
-			switch bodyField.Desc.Kind() {
+			switch bodyField.Leaf().Desc.Kind() {
 			case protoreflect.MessageKind:
-				srcValue := []any{"req.Get", bodyField.GoName, "()"}
-				switch bodyField.Message.Desc.FullName() {
+				srcValue := []any{"req.", bodyField.Getter()}
+				switch bodyField.Leaf().Message.Desc.FullName() {
 				case "google.api.HttpBody":
 					f.PrintEncodeHttpBodyToRequest(g, srcValue)
 				default:
@@ -56,11 +65,13 @@ func (f *Generator) GenerateRequestEncoder(service *parser.Service, g *protogen.
 			}
 		}
 
-		g.P("path := ", strconv.Quote(endpoint.Path()))
-		f.PrintPathField(g, pathFields)
+		g.P("path := ", strconv.Quote(endpoint.Pattern().Template()))
+		f.PrintPathField(g, pathFields, endpoint.PathVariables())
 		g.P("target.Path = path")
 
 		f.PrintQueryField(g, queryFields)
+		f.PrintMetadataField(g, endpoint.HeaderFields(), constant.AddHeaderFormIdent, "headers")
+		f.PrintMetadataField(g, endpoint.CookieFields(), constant.AddCookieFormIdent, "cookies")
 
 		g.P("request, err := ", constant.NewRequestWithContextIndent, "(ctx, method, target.String(), &body)")
 		g.P("if err != nil {")
@@ -93,13 +104,13 @@ func (f *Generator) PrintEncodeMessageToRequest(g *protogen.GeneratedFile, srcVa
 	g.P("}")
 }
 
-func (f *Generator) PrintPathField(g *protogen.GeneratedFile, pathFields []*protogen.Field) {
+func (f *Generator) PrintPathField(g *protogen.GeneratedFile, pathFields []parser.FieldPath, variables []*parser.Variable) {
 	if len(pathFields) <= 0 {
 		return
 	}
 	g.P("pairs := map[string]string{")
-	for _, field := range pathFields {
-		g.P(append(append([]any{strconv.Quote(string(field.Desc.Name())), ": "}, f.PathFieldFormat(field)...), ",")...)
+	for i, fieldPath := range pathFields {
+		g.P(append(append([]any{strconv.Quote(variables[i].Name()), ": "}, f.PathFieldFormat(fieldPath)...), ",")...)
 	}
 	g.P("}")
 	g.P("path = ", constant.URLPathIdent, "(path, pairs)")
@@ -109,8 +120,9 @@ func (f *Generator) PrintPathField(g *protogen.GeneratedFile, pathFields []*prot
 	g.P("}")
 }
 
-func (f *Generator) PathFieldFormat(field *protogen.Field) []any {
-	srcValue := []any{"req.Get", field.GoName, "()"}
+func (f *Generator) PathFieldFormat(fieldPath parser.FieldPath) []any {
+	field := fieldPath.Leaf()
+	srcValue := []any{"req.", fieldPath.Getter()}
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind: // bool
 		return f.BoolValueFormat(srcValue)
@@ -148,133 +160,230 @@ func (f *Generator) PathFieldFormat(field *protogen.Field) []any {
 			return f.UnwrapFloatValueFormat(srcValue, "64")
 		case "google.protobuf.StringValue":
 			return f.UnwrapStringValueFormat(srcValue)
+		case "google.protobuf.Timestamp":
+			return f.MessageFormat(srcValue, constant.FormatTimestampIdent)
+		case "google.protobuf.Duration":
+			return f.MessageFormat(srcValue, constant.FormatDurationIdent)
+		case "google.protobuf.FieldMask":
+			return f.MessageFormat(srcValue, constant.FormatFieldMaskIdent)
 		}
 	}
 	return nil
 }
 
-func (f *Generator) PrintQueryField(g *protogen.GeneratedFile, queryFields []*protogen.Field) {
+func (f *Generator) PrintQueryField(g *protogen.GeneratedFile, queryFields []parser.FieldPath) {
 	if len(queryFields) <= 0 {
 		return
 	}
 	g.P("queries := ", constant.URLValuesIndent, "{}")
-	for _, field := range queryFields {
-		srcValue := []any{"req.Get", field.GoName, "()"}
-		fieldName := string(field.Desc.Name())
-		switch field.Desc.Kind() {
-		case protoreflect.BoolKind: // bool
+	for _, fieldPath := range queryFields {
+		if len(fieldPath) > 1 {
+			// fields of absent nested messages are not sent.
+			g.P("if req.", fieldPath.Parents().Getter(), " != nil {")
+		}
+		f.PrintFormField(g, fieldPath, fieldPath.Name(), "queries")
+		if len(fieldPath) > 1 {
+			g.P("}")
+		}
+	}
+	g.P("target.RawQuery = queries.Encode()")
+}
+
+// PrintMetadataField sets the fields bound from headers or cookies to the headers of the
+// request, fields with presence are only sent when they are set.
+func (f *Generator) PrintMetadataField(g *protogen.GeneratedFile, metadataFields []*parser.MetadataField, addFunc protogen.GoIdent, form string) {
+	if len(metadataFields) <= 0 {
+		return
+	}
+	g.P(form, " := ", constant.URLValuesIndent, "{}")
+	for _, metadataField := range metadataFields {
+		fieldPath := metadataField.FieldPath
+		// optional scalars and wrappers, the other messages are checked by PrintMessageQuery
+		checkNil := fieldPath.Leaf().Desc.HasPresence()
+		if message := fieldPath.Leaf().Message; message != nil {
+			checkNil = strings.HasSuffix(string(message.Desc.Name()), "Value")
+		}
+		if checkNil {
+			g.P("if req.", fieldPath.GoName(), " != nil {")
+		}
+		f.PrintFormField(g, fieldPath, metadataField.Name, form)
+		if checkNil {
+			g.P("}")
+		}
+	}
+	g.P(addFunc, "(header, ", form, ")")
+}
+
+// PrintFormField adds the values of a field to form, keyed by fieldName.
+func (f *Generator) PrintFormField(g *protogen.GeneratedFile, fieldPath parser.FieldPath, fieldName string, form string) {
+	field := fieldPath.Leaf()
+	srcValue := []any{"req.", fieldPath.Getter()}
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind: // bool
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.BoolSliceFormat(srcValue), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.BoolValueFormat(srcValue))
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind: // int32
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.IntSliceFormat(srcValue), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.IntValueFormat(srcValue))
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind: // uint32
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.UintSliceFormat(srcValue), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.UintValueFormat(srcValue))
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind: // int64
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.IntSliceFormat(srcValue), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.IntValueFormat(srcValue))
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind: // uint64
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.UintSliceFormat(srcValue), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.UintValueFormat(srcValue))
+		}
+	case protoreflect.FloatKind: // float32
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.FloatSliceFormat(srcValue, "32"), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.FloatValueFormat(srcValue, "32"))
+		}
+	case protoreflect.DoubleKind: // float64
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.FloatSliceFormat(srcValue, "64"), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.FloatValueFormat(srcValue, "64"))
+		}
+	case protoreflect.StringKind: // string
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.StringKindFormat(srcValue), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.StringKindFormat(srcValue))
+		}
+	case protoreflect.EnumKind: // enum int32
+		if field.Desc.IsList() {
+			f.PrintQuery(g, form, fieldName, append(f.IntSliceFormat(srcValue), []any{"..."}...))
+		} else {
+			f.PrintQuery(g, form, fieldName, f.IntValueFormat(srcValue))
+		}
+	case protoreflect.MessageKind:
+		if field.Desc.IsMap() {
+			f.PrintMapQuery(g, form, fieldName, field, srcValue)
+			break
+		}
+		switch field.Message.Desc.FullName() {
+		case "google.protobuf.BoolValue":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.BoolSliceFormat(srcValue), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.UnwrapBoolSliceFormat(srcValue), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.BoolValueFormat(srcValue))
+				f.PrintQuery(g, form, fieldName, f.UnwrapBoolValueFormat(srcValue))
 			}
-		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind: // int32
+		case "google.protobuf.Int32Value":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.IntSliceFormat(srcValue), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.UnwrapIntSliceFormat(srcValue, constant.UnwrapInt32SliceIdent, "32"), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.IntValueFormat(srcValue))
+				f.PrintQuery(g, form, fieldName, f.UnwrapIntValueFormat(srcValue))
 			}
-		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind: // uint32
+		case "google.protobuf.UInt32Value":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.UintSliceFormat(srcValue), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.UnwrapUintSliceFormat(srcValue, constant.UnwrapUint32SliceIdent, "32"), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.UintValueFormat(srcValue))
+				f.PrintQuery(g, form, fieldName, f.UnwrapUintValueFormat(srcValue))
 			}
-		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind: // int64
+		case "google.protobuf.Int64Value":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.IntSliceFormat(srcValue), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.UnwrapIntSliceFormat(srcValue, constant.UnwrapInt64SliceIdent, "64"), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.IntValueFormat(srcValue))
+				f.PrintQuery(g, form, fieldName, f.UnwrapIntValueFormat(srcValue))
 			}
-		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind: // uint64
+		case "google.protobuf.UInt64Value":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.UintSliceFormat(srcValue), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.UnwrapUintSliceFormat(srcValue, constant.UnwrapUint64SliceIdent, "64"), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.UintValueFormat(srcValue))
+				f.PrintQuery(g, form, fieldName, f.UnwrapUintValueFormat(srcValue))
 			}
-		case protoreflect.FloatKind: // float32
+
+		case "google.protobuf.FloatValue":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.FloatSliceFormat(srcValue, "32"), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.UnwrapFloatSliceFormat(srcValue, constant.UnwrapFloat32SliceIdent, "32"), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.FloatValueFormat(srcValue, "32"))
+				f.PrintQuery(g, form, fieldName, f.UnwrapFloatValueFormat(srcValue, "32"))
 			}
-		case protoreflect.DoubleKind: // float64
+		case "google.protobuf.DoubleValue":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.FloatSliceFormat(srcValue, "64"), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.UnwrapFloatSliceFormat(srcValue, constant.UnwrapFloat64SliceIdent, "64"), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.FloatValueFormat(srcValue, "64"))
+				f.PrintQuery(g, form, fieldName, f.UnwrapFloatValueFormat(srcValue, "64"))
 			}
-		case protoreflect.StringKind: // string
+		case "google.protobuf.StringValue":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.StringKindFormat(srcValue), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.UnwrapStringSliceFormat(srcValue), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.StringKindFormat(srcValue))
+				f.PrintQuery(g, form, fieldName, f.UnwrapStringValueFormat(srcValue))
 			}
-		case protoreflect.EnumKind: // enum int32
+		case "google.protobuf.Timestamp":
 			if field.Desc.IsList() {
-				f.PrintQuery(g, fieldName, append(f.IntSliceFormat(srcValue), []any{"..."}...))
+				f.PrintQuery(g, form, fieldName, append(f.MessageFormat(srcValue, constant.FormatTimestampSliceIdent), []any{"..."}...))
 			} else {
-				f.PrintQuery(g, fieldName, f.IntValueFormat(srcValue))
+				f.PrintMessageQuery(g, form, fieldName, srcValue, constant.FormatTimestampIdent)
 			}
-		case protoreflect.MessageKind:
-			switch field.Message.Desc.FullName() {
-			case "google.protobuf.BoolValue":
-				if field.Desc.IsList() {
-					f.PrintQuery(g, fieldName, append(f.UnwrapBoolSliceFormat(srcValue), []any{"..."}...))
-				} else {
-					f.PrintQuery(g, fieldName, f.UnwrapBoolValueFormat(srcValue))
-				}
-			case "google.protobuf.Int32Value":
-				if field.Desc.IsList() {
-					f.PrintQuery(g, fieldName, append(f.UnwrapIntSliceFormat(srcValue, constant.UnwrapInt32SliceIdent, "32"), []any{"..."}...))
-				} else {
-					f.PrintQuery(g, fieldName, f.UnwrapIntValueFormat(srcValue))
-				}
-			case "google.protobuf.UInt32Value":
-				if field.Desc.IsList() {
-					f.PrintQuery(g, fieldName, append(f.UnwrapUintSliceFormat(srcValue, constant.UnwrapUint32SliceIdent, "32"), []any{"..."}...))
-				} else {
-					f.PrintQuery(g, fieldName, f.UnwrapUintValueFormat(srcValue))
-				}
-			case "google.protobuf.Int64Value":
-				if field.Desc.IsList() {
-					f.PrintQuery(g, fieldName, append(f.UnwrapIntSliceFormat(srcValue, constant.UnwrapInt64SliceIdent, "64"), []any{"..."}...))
-				} else {
-					f.PrintQuery(g, fieldName, f.UnwrapIntValueFormat(srcValue))
-				}
-			case "google.protobuf.UInt64Value":
-				if field.Desc.IsList() {
-					f.PrintQuery(g, fieldName, append(f.UnwrapUintSliceFormat(srcValue, constant.UnwrapUint64SliceIdent, "64"), []any{"..."}...))
-				} else {
-					f.PrintQuery(g, fieldName, f.UnwrapUintValueFormat(srcValue))
-				}
-
-			case "google.protobuf.FloatValue":
-				if field.Desc.IsList() {
-					f.PrintQuery(g, fieldName, append(f.UnwrapFloatSliceFormat(srcValue, constant.UnwrapFloat32SliceIdent, "32"), []any{"..."}...))
-				} else {
-					f.PrintQuery(g, fieldName, f.UnwrapFloatValueFormat(srcValue, "32"))
-				}
-			case "google.protobuf.DoubleValue":
-				if field.Desc.IsList() {
-					f.PrintQuery(g, fieldName, append(f.UnwrapFloatSliceFormat(srcValue, constant.UnwrapFloat64SliceIdent, "64"), []any{"..."}...))
-				} else {
-					f.PrintQuery(g, fieldName, f.UnwrapFloatValueFormat(srcValue, "64"))
-				}
-			case "google.protobuf.StringValue":
-				if field.Desc.IsList() {
-					f.PrintQuery(g, fieldName, append(f.UnwrapStringSliceFormat(srcValue), []any{"..."}...))
-				} else {
-					f.PrintQuery(g, fieldName, f.UnwrapStringValueFormat(srcValue))
-				}
+		case "google.protobuf.Duration":
+			if field.Desc.IsList() {
+				f.PrintQuery(g, form, fieldName, append(f.MessageFormat(srcValue, constant.FormatDurationSliceIdent), []any{"..."}...))
+			} else {
+				f.PrintMessageQuery(g, form, fieldName, srcValue, constant.FormatDurationIdent)
+			}
+		case "google.protobuf.FieldMask":
+			if field.Desc.IsList() {
+				f.PrintQuery(g, form, fieldName, append(f.MessageFormat(srcValue, constant.FormatFieldMaskSliceIdent), []any{"..."}...))
+			} else {
+				f.PrintMessageQuery(g, form, fieldName, srcValue, constant.FormatFieldMaskIdent)
 			}
 		}
 	}
-	g.P("target.RawQuery = queries.Encode()")
 }
 
-func (f *Generator) PrintQuery(g *protogen.GeneratedFile, fieldName string, srcValue []any) {
-	g.P(append(append([]any{"queries[", strconv.Quote(fieldName), "] = append(queries[", strconv.Quote(fieldName), "], "}, srcValue...), []any{")"}...)...)
+func (f *Generator) PrintQuery(g *protogen.GeneratedFile, form string, fieldName string, srcValue []any) {
+	g.P(append(append([]any{form, "[", strconv.Quote(fieldName), "] = append(", form, "[", strconv.Quote(fieldName), "], "}, srcValue...), []any{")"}...)...)
+}
+
+// PrintMapQuery adds the entries of a map field to the queries, keyed as "labels.env".
+func (f *Generator) PrintMapQuery(g *protogen.GeneratedFile, form string, fieldName string, field *protogen.Field, srcValue []any) {
+	valueField := field.Message.Fields[1]
+	var value []any
+	switch valueField.Desc.Kind() {
+	case protoreflect.BoolKind: // bool
+		value = f.BoolValueFormat([]any{"v"})
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, // int32
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, // int64
+		protoreflect.EnumKind: // enum int32
+		value = f.IntValueFormat([]any{"v"})
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind: // uint32, uint64
+		value = f.UintValueFormat([]any{"v"})
+	case protoreflect.FloatKind: // float32
+		value = f.FloatValueFormat([]any{"v"}, "32")
+	case protoreflect.DoubleKind: // float64
+		value = f.FloatValueFormat([]any{"v"}, "64")
+	default: // string
+		value = f.StringKindFormat([]any{"v"})
+	}
+	g.P(append(append([]any{"for k, v := range "}, srcValue...), " {")...)
+	g.P(append(append([]any{form, ".Add(", constant.MapFormKeyIdent, "(", strconv.Quote(fieldName), ", ", constant.FormatMapKeyIdent, "(k)), "}, value...), ")")...)
+	g.P("}")
+}
+
+// PrintMessageQuery adds a well-known message field to the queries, nil messages are not sent.
+func (f *Generator) PrintMessageQuery(g *protogen.GeneratedFile, form string, fieldName string, srcValue []any, formatter any) {
+	g.P(append(append([]any{"if "}, srcValue...), " != nil {")...)
+	f.PrintQuery(g, form, fieldName, f.MessageFormat(srcValue, formatter))
+	g.P("}")
 }
 
 func (f *Generator) BoolValueFormat(srcValue []any) []any {
@@ -352,3 +461,7 @@ func (f *Generator) UnwrapStringValueFormat(srcValue []any) []any {
 func (f *Generator) UnwrapStringSliceFormat(srcValue []any) []any {
 	return append(append([]any{constant.UnwrapStringSliceIdent, "("}, srcValue...), []any{")"}...)
 }
+
+func (f *Generator) MessageFormat(srcValue []any, formatter any) []any {
+	return append(append([]any{formatter, "("}, srcValue...), []any{")"}...)
+}
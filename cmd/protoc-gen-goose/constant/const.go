@@ -36,8 +36,17 @@ var (
 var (
 	ContextPackage = protogen.GoImportPath("context")
 	ContextIdent   = ContextPackage.Ident("Context")
+
+	ContextWithTimeoutIdent = ContextPackage.Ident("WithTimeout")
 )
 
+var (
+	TimePackage = protogen.GoImportPath("time")
+)
+
+// DeprecationComment marks the generated methods of deprecated methods, as protoc-gen-go does.
+const DeprecationComment = "// Deprecated: Do not use."
+
 var (
 	HttpPackage                 = protogen.GoImportPath("net/http")
 	NewServeMuxIndent           = HttpPackage.Ident("NewServeMux")
@@ -45,6 +54,7 @@ var (
 	ClientIdent                 = HttpPackage.Ident("Client")
 	HttpHandlerIdent            = HttpPackage.Ident("Handler")
 	HttpHandlerFuncIdent        = HttpPackage.Ident("HandlerFunc")
+	HttpNotFoundIdent           = HttpPackage.Ident("NotFound")
 	ResponseWriterIdent         = HttpPackage.Ident("ResponseWriter")
 	RequestIdent                = HttpPackage.Ident("Request")
 	ResponseIdent               = HttpPackage.Ident("Response")
@@ -67,17 +77,28 @@ var (
 	GoosePackage = protogen.GoImportPath("github.com/soyacen/goose")
 
 	ValidateRequestIdent = GoosePackage.Ident("ValidateRequest")
+	ClearOutputOnlyIdent = GoosePackage.Ident("ClearOutputOnly")
 	OnErrCallbackIdent   = GoosePackage.Ident("OnValidationErrCallback")
 
 	ErrorEncoderIdent = GoosePackage.Ident("ErrorEncoder")
 	ErrorDecoderIdent = GoosePackage.Ident("ErrorDecoder")
 	ErrorFactoryIdent = GoosePackage.Ident("ErrorFactory")
 
-	URLPathIdent = GoosePackage.Ident("URLPath")
+
+	URLPathIdent   = GoosePackage.Ident("URLPath")
+	MatchVerbIdent = GoosePackage.Ident("MatchVerb")
 
 	CopyHeaderIdent = GoosePackage.Ident("CopyHeader")
 
-	FormFromPathIdent = GoosePackage.Ident("FormFromPath")
+	ResponseMetadataIdent       = GoosePackage.Ident("ResponseMetadata")
+	InjectResponseMetadataIdent = GoosePackage.Ident("InjectResponseMetadata")
+
+	FormFromPathIdent   = GoosePackage.Ident("FormFromPath")
+	FormFromQueryIdent  = GoosePackage.Ident("FormFromQuery")
+	FormFromHeaderIdent = GoosePackage.Ident("FormFromHeader")
+	FormFromCookieIdent = GoosePackage.Ident("FormFromCookie")
+	AddHeaderFormIdent  = GoosePackage.Ident("AddHeaderForm")
+	AddCookieFormIdent  = GoosePackage.Ident("AddCookieForm")
 
 	FormatBoolIdent       = GoosePackage.Ident("FormatBool")
 	FormatBoolSliceIdent  = GoosePackage.Ident("FormatBoolSlice")
@@ -88,6 +109,13 @@ var (
 	FormatFloatIdent      = GoosePackage.Ident("FormatFloat")
 	FormatFloatSliceIdent = GoosePackage.Ident("FormatFloatSlice")
 
+	FormatTimestampIdent      = GoosePackage.Ident("FormatTimestamp")
+	FormatTimestampSliceIdent = GoosePackage.Ident("FormatTimestampSlice")
+	FormatDurationIdent       = GoosePackage.Ident("FormatDuration")
+	FormatDurationSliceIdent  = GoosePackage.Ident("FormatDurationSlice")
+	FormatFieldMaskIdent      = GoosePackage.Ident("FormatFieldMask")
+	FormatFieldMaskSliceIdent = GoosePackage.Ident("FormatFieldMaskSlice")
+
 	UnwrapBoolSliceIdent    = GoosePackage.Ident("UnwrapBoolSlice")
 	UnwrapInt32SliceIdent   = GoosePackage.Ident("UnwrapInt32Slice")
 	UnwrapUint32SliceIdent  = GoosePackage.Ident("UnwrapUint32Slice")
@@ -133,12 +161,29 @@ var (
 	GetFloat64ValueIdent      = GoosePackage.Ident("GetFloat64Value")
 	GetFloat64ValueSliceIdent = GoosePackage.Ident("GetFloat64ValueSlice")
 
+	GetBoolMapIdent   = GoosePackage.Ident("GetBoolMap")
+	GetIntMapIdent    = GoosePackage.Ident("GetIntMap")
+	GetUintMapIdent   = GoosePackage.Ident("GetUintMap")
+	GetFloatMapIdent  = GoosePackage.Ident("GetFloatMap")
+	GetStringMapIdent = GoosePackage.Ident("GetStringMap")
+	FormatMapKeyIdent = GoosePackage.Ident("FormatMapKey")
+	MapFormKeyIdent   = GoosePackage.Ident("MapFormKey")
+	HasMapFormIdent   = GoosePackage.Ident("HasMapForm")
+
+	GetTimestampIdent      = GoosePackage.Ident("GetTimestamp")
+	GetTimestampSliceIdent = GoosePackage.Ident("GetTimestampSlice")
+	GetDurationIdent       = GoosePackage.Ident("GetDuration")
+	GetDurationSliceIdent  = GoosePackage.Ident("GetDurationSlice")
+	GetFieldMaskIdent      = GoosePackage.Ident("GetFieldMask")
+	GetFieldMaskSliceIdent = GoosePackage.Ident("GetFieldMaskSlice")
+
 	WrapStringSliceIdent = GoosePackage.Ident("WrapStringSlice")
 
 	GetFormIdent = GoosePackage.Ident("GetForm")
 
 	RouteInfoIdent = GoosePackage.Ident("RouteInfo")
 	DescIdent      = GoosePackage.Ident("Desc")
+	RouterIfaceIdent = GoosePackage.Ident("Router")
 )
 
 func GetEnumIdent(g *protogen.GeneratedFile, ident protogen.GoIdent) protogen.GoIdent {
@@ -180,10 +225,14 @@ var (
 	EncodeHttpBodyToRequestIdent    = ClientPackage.Ident("EncodeHttpBody")
 	EncodeHttpRequestIdent          = ClientPackage.Ident("EncodeHttpRequest")
 	EncodeMessageIdent              = ClientPackage.Ident("EncodeMessage")
+	OmitFieldsIdent                 = ClientPackage.Ident("OmitFields")
 
 	ClientOptionIdent     = ClientPackage.Ident("Option")
 	ClientNewOptionsIdent = ClientPackage.Ident("NewOptions")
 
+	ClientCallOptionIdent = ClientPackage.Ident("CallOption")
+	ClientCallTargetIdent = ClientPackage.Ident("CallTarget")
+
 	ClientChainIdent      = ClientPackage.Ident("Chain")
 	ClientMiddlewareIdent = ClientPackage.Ident("Middleware")
 	ClientInvokeIdent     = ClientPackage.Ident("Invoke")
@@ -224,6 +273,28 @@ var (
 	WsConnConfigIdent              = WsPackage.Ident("ConnConfig")
 	WsAcceptOptionsIdent           = WsPackage.Ident("AcceptOptions")
 	WsDialOptionsIdent             = WsPackage.Ident("DialOptions")
+	WsOptionIdent                  = WsPackage.Ident("Option")
+	WsNewOptionsIdent              = WsPackage.Ident("NewOptions")
+	WsMarshalOptionsIdent          = WsPackage.Ident("MarshalOptions")
+	WsUnmarshalOptionsIdent        = WsPackage.Ident("UnmarshalOptions")
+	WsMiddlewaresIdent             = WsPackage.Ident("Middlewares")
+	WsPrefixIdent                  = WsPackage.Ident("Prefix")
+	WsErrorEncoderIdent            = WsPackage.Ident("ErrorEncoder")
+	WsOnValidationErrCallbackIdent = WsPackage.Ident("OnValidationErrCallback")
+	WsFailFastIdent                = WsPackage.Ident("FailFast")
+	WsResolveTargetIdent           = WsPackage.Ident("ResolveTarget")
+	WsIsWebsocketRequestIdent      = WsPackage.Ident("IsWebsocketRequest")
+	WsNewSSEServerStreamIdent      = WsPackage.Ident("NewSSEServerStream")
+	WsNewSSEClientStreamIdent      = WsPackage.Ident("NewSSEClientStream")
+	WsEventIDFuncIdent             = WsPackage.Ident("EventIDFunc")
+	WsEventStreamTargetIdent       = WsPackage.Ident("EventStreamTarget")
+)
+
+var (
+	MockPackage            = protogen.GoImportPath("github.com/soyacen/goose/mock")
+	MockMethodIdent        = MockPackage.Ident("Method")
+	MockNotStubbedIdent    = MockPackage.Ident("NotStubbed")
+	MockNewClientStreamIdent = MockPackage.Ident("NewClientStream")
 )
 
 var (
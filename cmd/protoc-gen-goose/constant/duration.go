@@ -0,0 +1,29 @@
+package constant
+
+import (
+	"strconv"
+	"time"
+)
+
+// durationUnits are the units of time durations, from the largest.
+var durationUnits = []struct {
+	unit time.Duration
+	name string
+}{
+	{time.Hour, "Hour"},
+	{time.Minute, "Minute"},
+	{time.Second, "Second"},
+	{time.Millisecond, "Millisecond"},
+	{time.Microsecond, "Microsecond"},
+}
+
+// DurationExpr returns the expression of a time duration in its largest whole unit,
+// e.g. 1500 * time.Millisecond.
+func DurationExpr(d time.Duration) []any {
+	for _, u := range durationUnits {
+		if d%u.unit == 0 {
+			return []any{strconv.FormatInt(int64(d/u.unit), 10), " * ", TimePackage.Ident(u.name)}
+		}
+	}
+	return []any{strconv.FormatInt(int64(d), 10), " * ", TimePackage.Ident("Nanosecond")}
+}
@@ -1,18 +1,22 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/asyncapi"
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/client"
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/constant"
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/mock"
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/openapi"
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/server"
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/stream"
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/ts"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/types/pluginpb"
 )
@@ -22,6 +26,17 @@ var flags flag.FlagSet
 var (
 	Version = "v1.7.18"
 	openapiFlag = flags.Bool("openapi", false, "generate OpenAPI documentation")
+	openapiVersionFlag = flags.String("openapi_version", openapi.Version30, "version of the OpenAPI documentation, 3.0, 3.1 or 2.0 for Swagger 2.0")
+	openapiMergeFlag = flags.String("openapi_merge", "", "merge the OpenAPI documentation of all files into one document with the given name")
+	protoNamesFlag = flags.Bool("proto_names", false, "name the properties of the OpenAPI and AsyncAPI schemas and of the TypeScript interfaces by the proto field names, as protojson UseProtoNames does")
+	asyncapiFlag = flags.Bool("asyncapi", false, "generate AsyncAPI documentation of the websocket streaming methods")
+	asyncapiVersionFlag = flags.String("asyncapi_version", asyncapi.Version30, "version of the AsyncAPI documentation, 3.0 or 2.6")
+	tsFlag      = flags.Bool("ts", false, "generate a TypeScript client of the unary methods")
+	sseFlag     = flags.Bool("sse", false, "serve server-streaming methods as server-sent events too")
+	mockFlag    = flags.Bool("mock", false, "generate in-memory mocks of the service interfaces")
+	serverFlag  = flags.Bool("server", true, "generate the server side code")
+	clientFlag  = flags.Bool("client", true, "generate the client side code")
+	splitFlag   = flags.Bool("split", false, "write the server and client side code into _goose_server.pb.go and _goose_client.pb.go")
 )
 
 func main() {
@@ -37,6 +52,20 @@ func main() {
 }
 
 func generate(plugin *protogen.Plugin) error {
+	if !*serverFlag && !*clientFlag {
+		return errors.New("protoc-gen-goose: server and client can not both be false")
+	}
+	openapiVersion, err := openapi.ParseVersion(*openapiVersionFlag)
+	if err != nil {
+		return err
+	}
+	openapiGen := &openapi.Generator{Version: openapiVersion, Merge: *openapiMergeFlag, ProtoNames: *protoNamesFlag}
+	asyncapiVersion, err := asyncapi.ParseVersion(*asyncapiVersionFlag)
+	if err != nil {
+		return err
+	}
+	asyncapiGen := &asyncapi.Generator{Version: asyncapiVersion, ProtoNames: *protoNamesFlag}
+	tsGen := &ts.Generator{ProtoNames: *protoNamesFlag}
 	for _, file := range plugin.Files {
 		if !file.Generate {
 			continue
@@ -48,80 +77,204 @@ func generate(plugin *protogen.Plugin) error {
 		if err != nil {
 			return err
 		}
-		filename := file.GeneratedFilenamePrefix + "_goose.pb.go"
-		g := plugin.NewGeneratedFile(filename, file.GoImportPath)
-		g.P("// Code generated by protoc-gen-goose. DO NOT EDIT.")
-		g.P()
-		g.P("package ", file.GoPackageName)
-		g.P()
+		out := newFiles(plugin, file)
 
 		for _, service := range services {
-			if service.IsStreamingService() {
-				streamGen := new(stream.Generator)
-				if err := streamGen.GenerateStreamServerInterface(service, g); err != nil {
-					return err
-				}
-				if err := streamGen.GenerateStreamClientInterface(service, g); err != nil {
-					return err
-				}
-				if err := streamGen.GenerateAppendStreamRouteFunc(service, g); err != nil {
-					return err
-				}
-				if err := streamGen.GenerateStreamHandlerStruct(service, g); err != nil {
-					return err
-				}
-				if err := streamGen.GenerateStreamHandlerMethods(service, g); err != nil {
-					return err
-				}
-				if err := streamGen.GenerateStreamClientStruct(service, g); err != nil {
-					return err
-				}
-				if err := streamGen.GenerateNewStreamClientFunc(service, g); err != nil {
-					return err
+			// unary methods get http handlers and clients, streaming methods get websocket ones.
+			// server-streaming methods served as server-sent events share the request codecs of unary ones.
+			unary := service.UnaryService()
+			if codec := service.HttpService(*sseFlag); len(codec.Endpoints) > 0 {
+				hasUnary := len(unary.Endpoints) > 0
+				srvGen := new(server.Generator)
+				cliGen := new(client.Generator)
+				if hasUnary {
+					if err := GenerateServices(unary, out.shared); err != nil {
+						return err
+					}
 				}
-				if err := streamGen.GenerateStreamClientMethods(service, g); err != nil {
-					return err
+				if out.server != nil {
+					if hasUnary {
+						if err := srvGen.GenerateAppendServerFunc(unary, out.server); err != nil {
+							return err
+						}
+						if !service.IsMixedService() {
+							if err := srvGen.GenerateNewHandlerFunc(unary, out.server); err != nil {
+								return err
+							}
+						}
+						if err := srvGen.GenerateHandlers(unary, out.server); err != nil {
+							return err
+						}
+					}
+					if err := srvGen.GenerateDecodeRequest(codec, out.server); err != nil {
+						return err
+					}
+					if hasUnary {
+						if err := srvGen.GenerateEncodeResponse(unary, out.server); err != nil {
+							return err
+						}
+					}
 				}
-			} else {
-				if err := GenerateServices(service, g); err != nil {
-					return err
+				if out.client != nil {
+					if hasUnary {
+						if err := cliGen.GenerateNewClient(unary, out.client); err != nil {
+							return err
+						}
+						if err := cliGen.GenerateClient(unary, out.client); err != nil {
+							return err
+						}
+					}
+					if err := cliGen.GenerateRequestEncoder(codec, out.client); err != nil {
+						return err
+					}
+					if hasUnary {
+						if err := cliGen.GenerateResponseDecoder(unary, out.client); err != nil {
+							return err
+						}
+					}
 				}
+			}
 
-				srvGen := new(server.Generator)
-				if err := srvGen.GenerateAppendServerFunc(service, g); err != nil {
+			if streaming := service.StreamingService(); len(streaming.Endpoints) > 0 {
+				streamGen := &stream.Generator{SSE: *sseFlag}
+				if err := streamGen.GenerateStreamServerInterface(streaming, out.shared); err != nil {
 					return err
 				}
-				if err := srvGen.GenerateHandlers(service, g); err != nil {
+				if err := streamGen.GenerateStreamClientInterface(streaming, out.shared); err != nil {
 					return err
 				}
-				if err := srvGen.GenerateDecodeRequest(service, g); err != nil {
-					return err
+				if out.server != nil {
+					if err := streamGen.GenerateAppendStreamRouteFunc(streaming, out.server); err != nil {
+						return err
+					}
+					if !service.IsMixedService() {
+						if err := streamGen.GenerateNewStreamHandlerFunc(streaming, out.server); err != nil {
+							return err
+						}
+					}
+					if err := streamGen.GenerateStreamHandlerStruct(streaming, out.server); err != nil {
+						return err
+					}
+					if err := streamGen.GenerateStreamHandlerMethods(streaming, out.server); err != nil {
+						return err
+					}
 				}
-				if err := srvGen.GenerateEncodeResponse(service, g); err != nil {
-					return err
+				if out.client != nil {
+					if err := streamGen.GenerateStreamClientStruct(streaming, out.client); err != nil {
+						return err
+					}
+					if err := streamGen.GenerateNewStreamClientFunc(streaming, out.client); err != nil {
+						return err
+					}
+					if err := streamGen.GenerateStreamClientMethods(streaming, out.client); err != nil {
+						return err
+					}
 				}
+			}
 
-				cliGen := new(client.Generator)
-				if err := cliGen.GenerateNewClient(service, g); err != nil {
+			if service.IsMixedService() {
+				if err := GenerateServerInterface(service, out.shared); err != nil {
 					return err
 				}
-				if err := cliGen.GenerateClient(service, g); err != nil {
-					return err
-				}
-				if err := cliGen.GenerateRequestEncoder(service, g); err != nil {
-					return err
-				}
-				if err := cliGen.GenerateResponseDecoder(service, g); err != nil {
-					return err
+				if out.server != nil {
+					if err := GenerateAppendRouteFunc(service, out.server); err != nil {
+						return err
+					}
+					if err := GenerateNewHandlerFunc(service, out.server); err != nil {
+						return err
+					}
 				}
 			}
 
-			if err := GenerateDescs(service, g); err != nil {
+			if err := GenerateDescs(service, out.shared); err != nil {
+				return err
+			}
+		}
+		if *mockFlag {
+			if err := GenerateMocks(plugin, file, services); err != nil {
+				return err
+			}
+		}
+		if *openapiFlag || *openapiMergeFlag != "" {
+			if err := openapiGen.Generate(plugin, file, services); err != nil {
 				return err
 			}
 		}
-		if *openapiFlag {
-			if err := openapi.Generate(plugin, file, services); err != nil {
+		if *asyncapiFlag {
+			if err := asyncapiGen.Generate(plugin, file, services); err != nil {
+				return err
+			}
+		}
+		if *tsFlag {
+			if err := tsGen.Generate(plugin, file, services); err != nil {
+				return err
+			}
+		}
+	}
+	return openapiGen.WriteMerged(plugin)
+}
+
+// files are the generated files the code of the services of a proto file is written to.
+// Without split they are all the _goose.pb.go file. With split the server and client sides
+// go to _goose_server.pb.go and _goose_client.pb.go, the interfaces and descs both sides
+// depend on stay in _goose.pb.go so that either side compiles without the other.
+type files struct {
+	shared *protogen.GeneratedFile // interfaces and descs
+	server *protogen.GeneratedFile // routes, handlers, request decoders and response encoders, nil with server=false
+	client *protogen.GeneratedFile // clients, request encoders and response decoders, nil with client=false
+}
+
+func newFiles(plugin *protogen.Plugin, file *protogen.File) *files {
+	out := &files{shared: newGeneratedFile(plugin, file, "_goose.pb.go")}
+	if *serverFlag {
+		out.server = out.shared
+		if *splitFlag {
+			out.server = newGeneratedFile(plugin, file, "_goose_server.pb.go")
+		}
+	}
+	if *clientFlag {
+		out.client = out.shared
+		if *splitFlag {
+			out.client = newGeneratedFile(plugin, file, "_goose_client.pb.go")
+		}
+	}
+	return out
+}
+
+func newGeneratedFile(plugin *protogen.Plugin, file *protogen.File, suffix string) *protogen.GeneratedFile {
+	g := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+suffix, file.GoImportPath)
+	g.P("// Code generated by protoc-gen-goose. DO NOT EDIT.")
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+	return g
+}
+
+// GenerateMocks generates the mocks of the service interfaces into a separate file.
+func GenerateMocks(plugin *protogen.Plugin, file *protogen.File, services []*parser.Service) error {
+	filename := file.GeneratedFilenamePrefix + "_goose_mock.pb.go"
+	g := plugin.NewGeneratedFile(filename, file.GoImportPath)
+	g.P("// Code generated by protoc-gen-goose. DO NOT EDIT.")
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+	mockGen := new(mock.Generator)
+	for _, service := range services {
+		if unary := service.UnaryService(); len(unary.Endpoints) > 0 {
+			if err := mockGen.GenerateMockService(unary, g); err != nil {
+				return err
+			}
+		}
+		if streaming := service.StreamingService(); len(streaming.Endpoints) > 0 {
+			if err := mockGen.GenerateMockStreamServer(streaming, g); err != nil {
+				return err
+			}
+			if err := mockGen.GenerateMockStreamClient(streaming, g); err != nil {
+				return err
+			}
+		}
+		if service.IsMixedService() {
+			if err := mockGen.GenerateMockServer(service, g); err != nil {
 				return err
 			}
 		}
@@ -132,6 +285,9 @@ func generate(plugin *protogen.Plugin) error {
 func GenerateServices(service *parser.Service, g *protogen.GeneratedFile) error {
 	g.P("type ", service.ServiceName(), " interface {")
 	for _, endpoint := range service.Endpoints {
+		if endpoint.Deprecated() {
+			g.P(constant.DeprecationComment)
+		}
 		g.P(endpoint.Name(), "(ctx ", constant.ContextIdent, ", req *", endpoint.InputGoIdent(), ") (*", endpoint.OutputGoIdent(), ", error)")
 	}
 	g.P("}")
@@ -139,12 +295,49 @@ func GenerateServices(service *parser.Service, g *protogen.GeneratedFile) error
 	return nil
 }
 
+// GenerateServerInterface generates the interface embedding the unary and stream server
+// interfaces of a service mixing unary and streaming methods.
+func GenerateServerInterface(service *parser.Service, g *protogen.GeneratedFile) error {
+	g.P("type ", service.ServerName(), " interface {")
+	g.P(service.ServiceName())
+	g.P(service.StreamServerName())
+	g.P("}")
+	g.P()
+	return nil
+}
+
+// GenerateAppendRouteFunc generates the registration entry point of a service mixing unary
+// and streaming methods. The codec options, middlewares, prefix, error encoder and validation
+// options of the server options are shared with the websocket routes, which otherwise use the
+// default ws options.
+func GenerateAppendRouteFunc(service *parser.Service, g *protogen.GeneratedFile) error {
+	g.P("func ", service.AppendAllRouteName(), "[R ", constant.RouterIfaceIdent, "](router R, service ", service.ServerName(), ", opts ...", constant.ServerOptionIdent, ") R {")
+	g.P("options := ", constant.ServerNewOptionsIdent, "(opts...)")
+	g.P("router = ", service.AppendRouteName(), "(router, service, opts...)")
+	g.P("wsOpts := []", constant.WsOptionIdent, "{")
+	g.P(constant.WsMarshalOptionsIdent, "(options.MarshalOptions()),")
+	g.P(constant.WsUnmarshalOptionsIdent, "(options.UnmarshalOptions()),")
+	g.P(constant.WsMiddlewaresIdent, "(options.Middlewares()...),")
+	g.P(constant.WsPrefixIdent, "(options.Prefix()),")
+	g.P(constant.WsErrorEncoderIdent, "(options.ErrorEncoder()),")
+	g.P(constant.WsOnValidationErrCallbackIdent, "(options.OnValidationErrCallback()),")
+	g.P("}")
+	g.P("if options.ShouldFailFast() {")
+	g.P("wsOpts = append(wsOpts, ", constant.WsFailFastIdent, "())")
+	g.P("}")
+	g.P("router = ", service.AppendStreamRouteName(), "(router, service, wsOpts...)")
+	g.P("return router")
+	g.P("}")
+	g.P()
+	return nil
+}
+
 func GenerateDescs(service *parser.Service, g *protogen.GeneratedFile) error {
-	for _, endpoint := range service.Endpoints {
+	for _, endpoint := range service.Bindings() {
 		g.P("var ", endpoint.DescName(), " = &", constant.DescIdent, "{")
 		g.P("RouteInfo: &", constant.RouteInfoIdent, "{")
 		g.P("HttpMethod: ", strconv.Quote(endpoint.Method()), ",")
-		g.P("Pattern: ", strconv.Quote(endpoint.Path()), ",")
+		g.P("Pattern: ", strconv.Quote(endpoint.RoutePath()), ",")
 		g.P("FullMethod: ", strconv.Quote(endpoint.FullName()), ",")
 		g.P("},")
 		g.P("}")
@@ -152,3 +345,13 @@ func GenerateDescs(service *parser.Service, g *protogen.GeneratedFile) error {
 	}
 	return nil
 }
+
+// GenerateNewHandlerFunc generates the function returning a standalone http.Handler serving
+// both the http and the websocket routes of a mixed service.
+func GenerateNewHandlerFunc(service *parser.Service, g *protogen.GeneratedFile) error {
+	g.P("func ", service.NewHandlerName(), "(service ", service.ServerName(), ", opts ...", constant.ServerOptionIdent, ") ", constant.HttpHandlerIdent, " {")
+	g.P("return ", service.AppendAllRouteName(), "(", constant.NewServeMuxIndent, "(), service, opts...)")
+	g.P("}")
+	g.P()
+	return nil
+}
@@ -0,0 +1,193 @@
+package mock
+
+import (
+	"strconv"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/constant"
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Generator generates in-memory mocks of the service interfaces. A mocked method calls its
+// <Method>Func field when set, otherwise it returns the result stubbed in its <Method>Mock field.
+type Generator struct{}
+
+// GenerateMockService generates the mock of the unary service interface.
+func (gen *Generator) GenerateMockService(service *parser.Service, g *protogen.GeneratedFile) error {
+	name := service.MockServiceName()
+	g.P("// ", name, " is an in-memory ", service.ServiceName(), " for tests.")
+	g.P("type ", name, " struct {")
+	for _, endpoint := range service.Endpoints {
+		g.P(endpoint.Name(), "Func func(ctx ", constant.ContextIdent, ", req *", endpoint.InputGoIdent(), ") (*", endpoint.OutputGoIdent(), ", error)")
+		g.P(endpoint.Name(), "Mock ", constant.MockMethodIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "]")
+	}
+	g.P("}")
+	g.P()
+	g.P("var _ ", service.ServiceName(), " = (*", name, ")(nil)")
+	g.P()
+	for _, endpoint := range service.Endpoints {
+		g.P("func (m *", name, ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ", req *", endpoint.InputGoIdent(), ") (*", endpoint.OutputGoIdent(), ", error) {")
+		g.P("m.", endpoint.Name(), "Mock.Record(req)")
+		g.P("if m.", endpoint.Name(), "Func != nil {")
+		g.P("return m.", endpoint.Name(), "Func(ctx, req)")
+		g.P("}")
+		g.P("if resp, err, ok := m.", endpoint.Name(), "Mock.Stubbed(req); ok {")
+		g.P("return resp, err")
+		g.P("}")
+		g.P("return nil, ", constant.MockNotStubbedIdent, "(", strconv.Quote(endpoint.FullName()), ")")
+		g.P("}")
+		g.P()
+	}
+	return nil
+}
+
+// GenerateMockStreamServer generates the mock of the stream server interface. Stubbed results of
+// server-streaming and bidi-streaming methods are the responses sent before returning the error.
+func (gen *Generator) GenerateMockStreamServer(service *parser.Service, g *protogen.GeneratedFile) error {
+	name := service.MockStreamServerName()
+	g.P("// ", name, " is an in-memory ", service.StreamServerName(), " for tests.")
+	g.P("type ", name, " struct {")
+	for _, endpoint := range service.Endpoints {
+		if endpoint.IsClientStreaming() {
+			g.P(endpoint.Name(), "Func func(", constant.WsClientStreamingServerIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "]) error")
+			g.P(endpoint.Name(), "Mock ", constant.MockMethodIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "]")
+		} else if endpoint.IsServerStreaming() {
+			g.P(endpoint.Name(), "Func func(*", endpoint.InputGoIdent(), ", ", constant.WsServerStreamingServerIdent, "[*", endpoint.OutputGoIdent(), "]) error")
+			g.P(endpoint.Name(), "Mock ", constant.MockMethodIdent, "[*", endpoint.InputGoIdent(), ", []*", endpoint.OutputGoIdent(), "]")
+		} else if endpoint.IsBidiStreaming() {
+			g.P(endpoint.Name(), "Func func(", constant.WsBidiStreamingServerIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "]) error")
+			g.P(endpoint.Name(), "Mock ", constant.MockMethodIdent, "[*", endpoint.InputGoIdent(), ", []*", endpoint.OutputGoIdent(), "]")
+		}
+	}
+	g.P("}")
+	g.P()
+	g.P("var _ ", service.StreamServerName(), " = (*", name, ")(nil)")
+	g.P()
+	for _, endpoint := range service.Endpoints {
+		if endpoint.IsClientStreaming() {
+			g.P("func (m *", name, ") ", endpoint.Name(), "(stream ", constant.WsClientStreamingServerIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "]) error {")
+			g.P("m.", endpoint.Name(), "Mock.Record(nil)")
+			g.P("if m.", endpoint.Name(), "Func != nil {")
+			g.P("return m.", endpoint.Name(), "Func(stream)")
+			g.P("}")
+			g.P("if resp, err, ok := m.", endpoint.Name(), "Mock.Stubbed(nil); ok {")
+			g.P("if err != nil {")
+			g.P("return err")
+			g.P("}")
+			g.P("return stream.SendAndClose(resp)")
+			g.P("}")
+		} else if endpoint.IsServerStreaming() {
+			g.P("func (m *", name, ") ", endpoint.Name(), "(req *", endpoint.InputGoIdent(), ", stream ", constant.WsServerStreamingServerIdent, "[*", endpoint.OutputGoIdent(), "]) error {")
+			g.P("m.", endpoint.Name(), "Mock.Record(req)")
+			g.P("if m.", endpoint.Name(), "Func != nil {")
+			g.P("return m.", endpoint.Name(), "Func(req, stream)")
+			g.P("}")
+			gen.printSendStubbed(endpoint, "req", g)
+		} else if endpoint.IsBidiStreaming() {
+			g.P("func (m *", name, ") ", endpoint.Name(), "(stream ", constant.WsBidiStreamingServerIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "]) error {")
+			g.P("m.", endpoint.Name(), "Mock.Record(nil)")
+			g.P("if m.", endpoint.Name(), "Func != nil {")
+			g.P("return m.", endpoint.Name(), "Func(stream)")
+			g.P("}")
+			gen.printSendStubbed(endpoint, "nil", g)
+		}
+		g.P("return ", constant.MockNotStubbedIdent, "(", strconv.Quote(endpoint.FullName()), ")")
+		g.P("}")
+		g.P()
+	}
+	return nil
+}
+
+// printSendStubbed sends the stubbed responses of a streaming method then returns the stubbed error.
+func (gen *Generator) printSendStubbed(endpoint *parser.Endpoint, req string, g *protogen.GeneratedFile) {
+	g.P("if resps, err, ok := m.", endpoint.Name(), "Mock.Stubbed(", req, "); ok {")
+	g.P("for _, resp := range resps {")
+	g.P("if err := stream.Send(resp); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+	g.P("return err")
+	g.P("}")
+}
+
+// GenerateMockStreamClient generates the mock of the stream client interface. Stubbed results are
+// received from an in-memory stream, client-streaming methods stub the response of CloseAndRecv.
+func (gen *Generator) GenerateMockStreamClient(service *parser.Service, g *protogen.GeneratedFile) error {
+	name := service.MockStreamClientName()
+	g.P("// ", name, " is an in-memory ", service.StreamClientName(), " for tests.")
+	g.P("type ", name, " struct {")
+	for _, endpoint := range service.Endpoints {
+		if endpoint.IsClientStreaming() {
+			g.P(endpoint.Name(), "Func func(ctx ", constant.ContextIdent, ") (", constant.WsClientStreamingClientIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "], error)")
+			g.P(endpoint.Name(), "Mock ", constant.MockMethodIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "]")
+		} else if endpoint.IsServerStreaming() {
+			g.P(endpoint.Name(), "Func func(ctx ", constant.ContextIdent, ", in *", endpoint.InputGoIdent(), ") (", constant.WsServerStreamingClientIdent, "[*", endpoint.OutputGoIdent(), "], error)")
+			g.P(endpoint.Name(), "Mock ", constant.MockMethodIdent, "[*", endpoint.InputGoIdent(), ", []*", endpoint.OutputGoIdent(), "]")
+		} else if endpoint.IsBidiStreaming() {
+			g.P(endpoint.Name(), "Func func(ctx ", constant.ContextIdent, ") (", constant.WsBidiStreamingClientIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "], error)")
+			g.P(endpoint.Name(), "Mock ", constant.MockMethodIdent, "[*", endpoint.InputGoIdent(), ", []*", endpoint.OutputGoIdent(), "]")
+		}
+	}
+	g.P("}")
+	g.P()
+	g.P("var _ ", service.StreamClientName(), " = (*", name, ")(nil)")
+	g.P()
+	for _, endpoint := range service.Endpoints {
+		if endpoint.IsClientStreaming() {
+			g.P("func (m *", name, ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ") (", constant.WsClientStreamingClientIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "], error) {")
+			g.P("m.", endpoint.Name(), "Mock.Record(nil)")
+			g.P("if m.", endpoint.Name(), "Func != nil {")
+			g.P("return m.", endpoint.Name(), "Func(ctx)")
+			g.P("}")
+			g.P("if resp, err, ok := m.", endpoint.Name(), "Mock.Stubbed(nil); ok {")
+			g.P("if err != nil {")
+			g.P("return nil, err")
+			g.P("}")
+			g.P("return ", constant.MockNewClientStreamIdent, "[*", endpoint.InputGoIdent(), "](ctx, resp), nil")
+			g.P("}")
+		} else if endpoint.IsServerStreaming() {
+			g.P("func (m *", name, ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ", in *", endpoint.InputGoIdent(), ") (", constant.WsServerStreamingClientIdent, "[*", endpoint.OutputGoIdent(), "], error) {")
+			g.P("m.", endpoint.Name(), "Mock.Record(in)")
+			g.P("if m.", endpoint.Name(), "Func != nil {")
+			g.P("return m.", endpoint.Name(), "Func(ctx, in)")
+			g.P("}")
+			gen.printRecvStubbed(endpoint, "in", g)
+		} else if endpoint.IsBidiStreaming() {
+			g.P("func (m *", name, ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ") (", constant.WsBidiStreamingClientIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "], error) {")
+			g.P("m.", endpoint.Name(), "Mock.Record(nil)")
+			g.P("if m.", endpoint.Name(), "Func != nil {")
+			g.P("return m.", endpoint.Name(), "Func(ctx)")
+			g.P("}")
+			gen.printRecvStubbed(endpoint, "nil", g)
+		}
+		g.P("return nil, ", constant.MockNotStubbedIdent, "(", strconv.Quote(endpoint.FullName()), ")")
+		g.P("}")
+		g.P()
+	}
+	return nil
+}
+
+// printRecvStubbed returns an in-memory stream receiving the stubbed responses.
+func (gen *Generator) printRecvStubbed(endpoint *parser.Endpoint, req string, g *protogen.GeneratedFile) {
+	g.P("if resps, err, ok := m.", endpoint.Name(), "Mock.Stubbed(", req, "); ok {")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("return ", constant.MockNewClientStreamIdent, "[*", endpoint.InputGoIdent(), "](ctx, resps...), nil")
+	g.P("}")
+}
+
+// GenerateMockServer generates the mock of the interface embedding the unary and stream
+// server interfaces of a mixed service.
+func (gen *Generator) GenerateMockServer(service *parser.Service, g *protogen.GeneratedFile) error {
+	name := service.MockServerName()
+	g.P("// ", name, " is an in-memory ", service.ServerName(), " for tests.")
+	g.P("type ", name, " struct {")
+	g.P(service.MockServiceName())
+	g.P(service.MockStreamServerName())
+	g.P("}")
+	g.P()
+	g.P("var _ ", service.ServerName(), " = (*", name, ")(nil)")
+	g.P()
+	return nil
+}
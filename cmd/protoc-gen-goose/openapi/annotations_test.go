@@ -0,0 +1,111 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"github.com/soyacen/goose/example/user"
+)
+
+func TestMethodRule(t *testing.T) {
+	plugin := newPlugin(t, user.File_example_user_user_proto)
+	file := plugin.FilesByPath[user.File_example_user_user_proto.Path()]
+	services, err := parser.NewServices(file)
+	if err != nil {
+		t.Fatalf("failed to parse services: %v", err)
+	}
+	doc := (&Generator{}).document(file, services)
+	operations := make(map[string]*Operation)
+	for _, item := range doc.Paths {
+		for _, operation := range []*Operation{item.Get, item.Post, item.Put, item.Delete, item.Patch} {
+			if operation != nil {
+				operations[operation.OperationID] = operation
+			}
+		}
+	}
+
+	create := operations["CreateUserRequest_CreateUser"]
+	if create == nil || create.Responses["201"] == nil || create.Responses["200"] != nil {
+		t.Errorf("expected CreateUser to respond 201, got %v", create)
+	}
+	if modify := operations["ModifyUserRequest_ModifyUser"]; modify == nil || !modify.Deprecated {
+		t.Errorf("expected ModifyUser to be deprecated, got %v", modify)
+	}
+	if list := operations["ListUserRequest_ListUser"]; list == nil || list.XGooseTimeout != "5s" {
+		t.Errorf("expected ListUser to time out after 5s, got %v", list)
+	}
+	get := operations["GetUserRequest_GetUser"]
+	if get == nil || get.Responses["200"] == nil || get.Responses["200"].Headers["Cache-Control"] == nil {
+		t.Fatalf("expected GetUser to document the Cache-Control header, got %v", get)
+	}
+	if remove := operations["DeleteUserRequest_DeleteUser"]; remove == nil || remove.Responses["200"] == nil {
+		t.Errorf("expected DeleteUser to respond 200, got %v", remove)
+	}
+
+	swagger := toSwagger(doc)
+	var found bool
+	for _, item := range swagger.Paths {
+		if operation := item.Get; operation != nil && operation.OperationID == "GetUserRequest_GetUser" {
+			found = true
+			if header := operation.Responses["200"].Headers["Cache-Control"]; header == nil || header.Type != "string" {
+				t.Errorf("expected the swagger document to keep the Cache-Control header, got %v", header)
+			}
+		}
+		if operation := item.Put; operation != nil && operation.OperationID == "ModifyUserRequest_ModifyUser" && !operation.Deprecated {
+			t.Error("expected the swagger document to keep the deprecation")
+		}
+	}
+	if !found {
+		t.Error("expected the swagger document to have GetUser")
+	}
+}
+
+func TestFieldRule(t *testing.T) {
+	plugin := newPlugin(t, user.File_example_user_user_proto)
+	file := plugin.FilesByPath[user.File_example_user_user_proto.Path()]
+	services, err := parser.NewServices(file)
+	if err != nil {
+		t.Fatalf("failed to parse services: %v", err)
+	}
+	doc := (&Generator{}).document(file, services)
+	list := doc.Paths["/v1/users"].Get
+	if list == nil {
+		t.Fatal("expected the ListUser operation")
+	}
+	in := make(map[string]string)
+	for _, param := range list.Parameters {
+		in[param.Name] = param.In
+	}
+	want := map[string]string{"page_num": "query", "page_size": "query", "X-Tenant-Id": "header", "X-Api-Version": "header", "session": "cookie"}
+	if len(in) != len(want) {
+		t.Errorf("expected the parameters %v, got %v", want, in)
+	}
+	for name, location := range want {
+		if in[name] != location {
+			t.Errorf("expected parameter %s in %s, got %q", name, location, in[name])
+		}
+	}
+
+	swagger := toSwagger(doc)
+	for _, param := range swagger.Paths["/v1/users"].Get.Parameters {
+		if param.In == "cookie" {
+			t.Errorf("expected the swagger document to leave out the cookie parameter %s", param.Name)
+		}
+	}
+
+	// the header fields are left out of a "*" body
+	create := doc.Paths["/v1/user"].Post
+	if create == nil || create.RequestBody == nil {
+		t.Fatal("expected the CreateUser operation and its body")
+	}
+	body := create.RequestBody.Content["application/json"].Schema
+	if _, ok := body.Properties["idempotencyKey"]; ok || body.Ref != "" {
+		t.Errorf("expected the body to leave out the header field, got %+v", body)
+	}
+	if _, ok := body.Properties["name"]; !ok {
+		t.Errorf("expected the body to hold the other fields, got %+v", body)
+	}
+	if _, ok := doc.Components.Schemas["leo.goose.example.user.v1.CreateUserRequest"].Properties["idempotencyKey"]; !ok {
+		t.Error("expected the schema of the message to keep the header field")
+	}
+}
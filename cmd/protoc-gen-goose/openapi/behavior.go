@@ -0,0 +1,22 @@
+package openapi
+
+import (
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// hasFieldBehavior reports whether a field is annotated with the given google.api.field_behavior.
+func hasFieldBehavior(field *protogen.Field, behavior annotations.FieldBehavior) bool {
+	options, ok := field.Desc.Options().(*descriptorpb.FieldOptions)
+	if !ok || options == nil {
+		return false
+	}
+	for _, b := range proto.GetExtension(options, annotations.E_FieldBehavior).([]annotations.FieldBehavior) {
+		if b == behavior {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package openapi
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// CommentText returns the text of the leading comments of a proto element, falling back to
+// its trailing comments. The comment markers and the indentation of each line are trimmed.
+func CommentText(comments protogen.CommentSet) string {
+	text := cleanComments(comments.Leading)
+	if text == "" {
+		text = cleanComments(comments.Trailing)
+	}
+	return text
+}
+
+func cleanComments(comments protogen.Comments) string {
+	lines := strings.Split(string(comments), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// SplitSummary splits a comment into its first line, the summary, and the remaining lines,
+// the description.
+func SplitSummary(text string) (summary string, description string) {
+	summary, description, _ = strings.Cut(text, "\n")
+	return strings.TrimSpace(summary), strings.TrimSpace(description)
+}
+
+// enumDescription describes an enum by its comment followed by one line per commented value,
+// e.g. "- ACTIVE: the user can sign in".
+func enumDescription(enum *protogen.Enum) string {
+	var parts []string
+	if text := CommentText(enum.Comments); text != "" {
+		parts = append(parts, text)
+	}
+	var values []string
+	for _, value := range enum.Values {
+		if text := CommentText(value.Comments); text != "" {
+			values = append(values, fmt.Sprintf("- %s: %s", value.Desc.Name(), strings.ReplaceAll(text, "\n", " ")))
+		}
+	}
+	if len(values) > 0 {
+		parts = append(parts, strings.Join(values, "\n"))
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// fieldDescription describes a field by its comment, followed by the description of its enum.
+func fieldDescription(field *protogen.Field) string {
+	var parts []string
+	if text := CommentText(field.Comments); text != "" {
+		parts = append(parts, text)
+	}
+	if field.Enum != nil {
+		if text := enumDescription(field.Enum); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
@@ -0,0 +1,134 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"github.com/soyacen/goose/example/query"
+	"github.com/soyacen/goose/example/user"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// withComments returns an edit adding leading comments to the elements at the given source
+// code info paths.
+func withComments(comments map[string][]int32) func(fdp *descriptorpb.FileDescriptorProto) {
+	return func(fdp *descriptorpb.FileDescriptorProto) {
+		info := &descriptorpb.SourceCodeInfo{}
+		for comment, path := range comments {
+			info.Location = append(info.Location, &descriptorpb.SourceCodeInfo_Location{
+				Path:            path,
+				Span:            []int32{0, 0, 0},
+				LeadingComments: proto.String(comment),
+			})
+		}
+		fdp.SourceCodeInfo = info
+	}
+}
+
+func generateDocument(t *testing.T, fd protoreflect.FileDescriptor, comments map[string][]int32) *Document {
+	t.Helper()
+	plugin := newPlugin(t, fd, withComments(comments))
+	file := plugin.FilesByPath[fd.Path()]
+	services, err := parser.NewServices(file)
+	if err != nil {
+		t.Fatalf("failed to parse services: %v", err)
+	}
+	if err := new(Generator).Generate(plugin, file, services); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	resp := plugin.Response()
+	if resp.Error != nil {
+		t.Fatalf("plugin error: %s", resp.GetError())
+	}
+	doc := &Document{}
+	if err := json.Unmarshal([]byte(resp.File[0].GetContent()), doc); err != nil {
+		t.Fatalf("failed to unmarshal document: %v", err)
+	}
+	return doc
+}
+
+func TestComments(t *testing.T) {
+	services := user.File_example_user_user_proto.Services()
+	messages := user.File_example_user_user_proto.Messages()
+	service := int32(services.ByName("User").Index())
+	method := int32(services.ByName("User").Methods().ByName("CreateUser").Index())
+	item := int32(messages.ByName("UserItem").Index())
+	name := int32(messages.ByName("UserItem").Fields().ByName("name").Index())
+
+	doc := generateDocument(t, user.File_example_user_user_proto, map[string][]int32{
+		" User manages users.\n": {6, service},
+		" CreateUser 创建用户\n `POST /v1/user { \"name\": \"Leo\" }`\n": {6, service, 2, method},
+		" UserItem is a user.\n": {4, item},
+		" name of the user\n":    {4, item, 2, name},
+	})
+
+	if len(doc.Tags) != 1 || doc.Tags[0].Name != "User" || doc.Tags[0].Description != "User manages users." {
+		t.Errorf("unexpected tags: %+v", doc.Tags)
+	}
+	operation := doc.Paths["/v1/user"].Post
+	if operation.Summary != "CreateUser 创建用户" {
+		t.Errorf("summary = %q", operation.Summary)
+	}
+	if operation.Description != "`POST /v1/user { \"name\": \"Leo\" }`" {
+		t.Errorf("description = %q", operation.Description)
+	}
+	if len(operation.Tags) != 1 || operation.Tags[0] != "User" {
+		t.Errorf("operation tags = %v", operation.Tags)
+	}
+	if summary := doc.Paths["/v1/user/{id}"].Delete.Summary; summary != "DeleteUser" {
+		t.Errorf("uncommented summary = %q, want the method name", summary)
+	}
+	schema := doc.Components.Schemas["leo.goose.example.user.v1.UserItem"]
+	if schema.Description != "UserItem is a user." {
+		t.Errorf("message description = %q", schema.Description)
+	}
+	if description := schema.Properties["name"].Description; description != "name of the user" {
+		t.Errorf("field description = %q", description)
+	}
+}
+
+func TestEnumComments(t *testing.T) {
+	fd := query.File_example_query_query_proto
+	message := fd.Messages().ByName("EnumQueryRequest")
+	enum := message.Enums().ByName("Status")
+	msgIndex := int32(message.Index())
+	enumIndex := int32(enum.Index())
+	ok := int32(enum.Values().ByName("OK").Index())
+
+	doc := generateDocument(t, fd, map[string][]int32{
+		" Status of a request.\n":  {4, msgIndex, 4, enumIndex},
+		" the request succeeded\n": {4, msgIndex, 4, enumIndex, 2, ok},
+	})
+
+	want := "Status of a request.\n\n- OK: the request succeeded"
+	params := doc.Paths["/v1/enum"].Get.Parameters
+	if len(params) == 0 {
+		t.Fatal("expected enum query parameters")
+	}
+	for _, param := range params {
+		if param.Description != want {
+			t.Errorf("parameter %s description = %q, want %q", param.Name, param.Description, want)
+		}
+	}
+}
+
+func TestSplitSummary(t *testing.T) {
+	tests := []struct {
+		text        string
+		summary     string
+		description string
+	}{
+		{"", "", ""},
+		{"GetUser", "GetUser", ""},
+		{"GetUser 获取用户\n`GET /v1/user/10000`\n`GET /v1/users/10000`", "GetUser 获取用户", "`GET /v1/user/10000`\n`GET /v1/users/10000`"},
+	}
+	for _, tt := range tests {
+		summary, description := SplitSummary(tt.text)
+		if summary != tt.summary || description != tt.description {
+			t.Errorf("SplitSummary(%q) = %q, %q, want %q, %q", tt.text, summary, description, tt.summary, tt.description)
+		}
+	}
+}
@@ -4,23 +4,59 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
+// Generator generates the OpenAPI documents of proto files.
+type Generator struct {
+	// Version is the version of the documents, one of Version30, Version31 and Version20.
+	Version string
+	// Merge is the name of the single document the documents of all files are merged into,
+	// each file gets its own document when empty.
+	Merge string
+	// ProtoNames names the properties of the schemas by the proto names of the fields,
+	// for servers marshaling with protojson UseProtoNames.
+	ProtoNames bool
+
+	merged *merger
+}
+
 // Generate creates an OpenAPI document for the given proto file and services,
-// writing it as a generated JSON file.
-func Generate(plugin *protogen.Plugin, file *protogen.File, services []*parser.Service) error {
+// writing it as a generated JSON file. With Merge the document is merged into the
+// document written by WriteMerged instead.
+func (gen *Generator) Generate(plugin *protogen.Plugin, file *protogen.File, services []*parser.Service) error {
+	doc := gen.document(file, services)
+	if gen.Merge != "" {
+		if gen.merged == nil {
+			gen.merged = newMerger(gen.Merge)
+		}
+		gen.merged.merge(file, doc)
+		return nil
+	}
+	return gen.write(plugin, gen.filename(file.GeneratedFilenamePrefix+"_goose"), doc)
+}
+
+// document builds the OpenAPI 3.0 document of the given proto file and services.
+func (gen *Generator) document(file *protogen.File, services []*parser.Service) *Document {
 	// Collect all schemas
 	collector := NewSchemaCollector()
+	collector.ProtoNames = gen.ProtoNames
 	schemas := collector.Collect(services)
 
-	// Generate paths
+	// Generate paths, the operations of each service are grouped by a tag
 	paths := make(map[string]*PathItem)
+	var tags []*Tag
 	for _, service := range services {
-		servicePaths := GeneratePaths(service)
+		tags = append(tags, &Tag{
+			Name:        serviceTag(service),
+			Description: CommentText(service.ProtoService.Comments),
+		})
+		servicePaths := GeneratePaths(service, collector)
 		for path, item := range servicePaths {
 			if existing, ok := paths[path]; ok {
 				mergePathItem(existing, item)
@@ -37,6 +73,7 @@ func Generate(plugin *protogen.Plugin, file *protogen.File, services []*parser.S
 			Title:   fmt.Sprintf("%s API", file.Desc.Package()),
 			Version: "1.0.0",
 		},
+		Tags:  tags,
 		Paths: paths,
 	}
 
@@ -45,36 +82,80 @@ func Generate(plugin *protogen.Plugin, file *protogen.File, services []*parser.S
 			Schemas: schemas,
 		}
 	}
+	return doc
+}
+
+// WriteMerged writes the document the documents of all files were merged into, if any.
+// The conflicts between the files are returned instead, all of them at once.
+func (gen *Generator) WriteMerged(plugin *protogen.Plugin) error {
+	if gen.merged == nil {
+		return nil
+	}
+	if err := gen.merged.err(); err != nil {
+		return err
+	}
+	filename := gen.Merge
+	if !strings.HasSuffix(filename, ".json") {
+		filename = gen.filename(filename)
+	}
+	return gen.write(plugin, filename, gen.merged.doc)
+}
+
+// filename returns the name of the document file with the given prefix.
+func (gen *Generator) filename(prefix string) string {
+	if gen.Version == Version20 {
+		return prefix + ".swagger.json"
+	}
+	return prefix + ".openapi.json"
+}
+
+// write converts doc to the requested version and writes it as a generated JSON file.
+func (gen *Generator) write(plugin *protogen.Plugin, filename string, doc *Document) error {
+	// Convert to the requested version
+	var out any = doc
+	switch gen.Version {
+	case Version31:
+		toV31(doc)
+	case Version20:
+		out = toSwagger(doc)
+	}
 
 	// Marshal to JSON
-	data, err := json.MarshalIndent(doc, "", "  ")
+	data, err := json.MarshalIndent(out, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal OpenAPI document: %w", err)
 	}
 
 	// Write generated file
-	filename := file.GeneratedFilenamePrefix + "_goose.openapi.json"
 	g := plugin.NewGeneratedFile(filename, "")
 	g.Write(data)
 
 	return nil
 }
 
-// GeneratePaths generates OpenAPI path items for all endpoints in a service.
-func GeneratePaths(service *parser.Service) map[string]*PathItem {
+// GeneratePaths generates OpenAPI path items for all endpoints in a service,
+// including one operation per additional binding. The request bodies are described by the
+// schemas of collector.
+func GeneratePaths(service *parser.Service, collector *SchemaCollector) map[string]*PathItem {
 	paths := make(map[string]*PathItem)
-	for _, endpoint := range service.Endpoints {
-		path := normalizePath(endpoint.Path())
+	for _, endpoint := range service.Bindings() {
+		path := normalizePath(endpoint.Pattern().Template())
 		if _, ok := paths[path]; !ok {
 			paths[path] = &PathItem{}
 		}
 
-		operation := generateOperation(endpoint)
+		operation := generateOperation(endpoint, collector)
+		operation.Tags = []string{serviceTag(service)}
 		setOperation(paths[path], endpoint.Method(), operation)
 	}
 	return paths
 }
 
+// serviceTag returns the name of the tag grouping the operations of a service.
+func serviceTag(service *parser.Service) string {
+	return string(service.ProtoService.Desc.Name())
+}
+
 // normalizePath converts goose path patterns to OpenAPI compatible paths.
 func normalizePath(path string) string {
 	// Go 1.22 ServeMux uses {$} for matching trailing slash; OpenAPI doesn't need this.
@@ -83,54 +164,137 @@ func normalizePath(path string) string {
 }
 
 // generateOperation creates an OpenAPI Operation for a single endpoint.
-func generateOperation(endpoint *parser.Endpoint) *Operation {
+func generateOperation(endpoint *parser.Endpoint, collector *SchemaCollector) *Operation {
 	operation := &Operation{
-		OperationID: fmt.Sprintf("%s_%s", endpoint.Input().GoIdent.GoName, endpoint.Name()),
+		OperationID: fmt.Sprintf("%s_%s", endpoint.Input().GoIdent.GoName, endpoint.BindingName()),
 		Responses:   make(map[string]*Response),
 	}
 
-	// Extract summary from proto comments if available
-	if endpoint.Input() != nil {
-		operation.Summary = fmt.Sprintf("%s", endpoint.Name())
+	// The first line of the method comments is the summary, the other lines the description
+	operation.Summary, operation.Description = SplitSummary(CommentText(endpoint.Comments()))
+	if operation.Summary == "" {
+		operation.Summary = endpoint.Name()
 	}
 
 	// Parse parameters (path and query)
 	_, _, pathFields, queryFields, _ := endpoint.ParseParameters()
 
-	// Path parameters
-	for _, field := range pathFields {
-		param := &Parameter{
-			Name:     field.Desc.JSONName(),
-			In:       "path",
-			Required: true,
-			Schema:   GetFieldSchema(field),
+	// Path parameters, named as written in the path template
+	variables := endpoint.PathVariables()
+	for i, fieldPath := range pathFields {
+		param := newParameter(variables[i].Name(), "path", protoFieldToParameterSchema(fieldPath.Leaf()))
+		param.Required = true
+		if !variables[i].IsWildcard() {
+			// resource names such as {name=shelves/*} span several segments
+			param.Schema.Pattern = variables[i].Regexp()
 		}
 		operation.Parameters = append(operation.Parameters, param)
 	}
 
-	// Query parameters
-	for _, field := range queryFields {
-		param := &Parameter{
-			Name:   field.Desc.JSONName(),
-			In:     "query",
-			Schema: GetFieldSchema(field),
+	// Query parameters, fields of nested messages are described by one deepObject parameter
+	// per top level message field, e.g. filter[status]=ACTIVE or filter.status=ACTIVE.
+	deepObjects := make(map[*protogen.Field]bool)
+	for _, fieldPath := range queryFields {
+		if hasFieldBehavior(fieldPath[0], annotations.FieldBehavior_OUTPUT_ONLY) {
+			// the server clears output only fields of requests
+			continue
+		}
+		if len(fieldPath) > 1 {
+			field := fieldPath[0]
+			if deepObjects[field] {
+				continue
+			}
+			deepObjects[field] = true
+			explode := true
+			param := newParameter(string(field.Desc.Name()), "query", queryObjectSchema(fieldPath[:1], queryFields))
+			param.Style = "deepObject"
+			param.Explode = &explode
+			operation.Parameters = append(operation.Parameters, param)
+			continue
+		}
+		// the decoders read queries by the proto names of the fields
+		param := newParameter(fieldPath.Name(), "query", protoFieldToParameterSchema(fieldPath.Leaf()))
+		param.Required = isRequired(fieldPath.Leaf())
+		if fieldPath.Leaf().Desc.IsMap() {
+			// map entries are keyed by the map key, e.g. labels[env]=prod or labels.env=prod.
+			explode := true
+			param.Style = "deepObject"
+			param.Explode = &explode
+			param.Description = strings.TrimSpace(fmt.Sprintf("%s\n\nMap entries are sent as %s[key]=value or %s.key=value.", param.Description, param.Name, param.Name))
 		}
 		operation.Parameters = append(operation.Parameters, param)
 	}
 
+	// Header and cookie parameters, named by the goose.field annotation
+	for _, in := range []string{"header", "cookie"} {
+		metadataFields := endpoint.HeaderFields()
+		if in == "cookie" {
+			metadataFields = endpoint.CookieFields()
+		}
+		for _, metadataField := range metadataFields {
+			field := metadataField.FieldPath.Leaf()
+			if hasFieldBehavior(field, annotations.FieldBehavior_OUTPUT_ONLY) {
+				continue
+			}
+			param := newParameter(metadataField.Name, in, protoFieldToParameterSchema(field))
+			param.Required = isRequired(field)
+			operation.Parameters = append(operation.Parameters, param)
+		}
+	}
+
 	// Request body
-	if body := generateRequestBody(endpoint); body != nil {
+	if body := generateRequestBody(endpoint, collector); body != nil {
 		operation.RequestBody = body
 	}
 
 	// Response
 	operation.Responses = generateResponses(endpoint)
 
+	operation.Deprecated = endpoint.Deprecated()
+	if timeout := endpoint.Timeout(); timeout > 0 {
+		operation.XGooseTimeout = timeout.String()
+	}
+
 	return operation
 }
 
+// queryObjectSchema returns the inline schema of the nested message bound from the queries
+// prefixed by parent. The properties are the proto names the decoders read, e.g.
+// filter[owner][id] or filter.owner.id, and hold the query fields of the message only.
+func queryObjectSchema(parent parser.FieldPath, queryFields []parser.FieldPath) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema), Description: fieldDescription(parent.Leaf())}
+	for _, fieldPath := range queryFields {
+		if len(fieldPath) <= len(parent) || !fieldPath.HasPrefix(parent) {
+			continue
+		}
+		field := fieldPath[len(parent)]
+		name := string(field.Desc.Name())
+		if _, ok := schema.Properties[name]; ok {
+			continue
+		}
+		if len(fieldPath) > len(parent)+1 {
+			schema.Properties[name] = queryObjectSchema(fieldPath[:len(parent)+1], queryFields)
+			continue
+		}
+		schema.Properties[name] = protoFieldToParameterSchema(field)
+	}
+	return schema
+}
+
+// newParameter creates a parameter described by the comments of its field.
+func newParameter(name string, in string, schema *Schema) *Parameter {
+	param := &Parameter{
+		Name:        name,
+		In:          in,
+		Description: schema.Description,
+		Schema:      schema,
+	}
+	schema.Description = ""
+	return param
+}
+
 // generateRequestBody creates a RequestBody for an endpoint if applicable.
-func generateRequestBody(endpoint *parser.Endpoint) *RequestBody {
+func generateRequestBody(endpoint *parser.Endpoint, collector *SchemaCollector) *RequestBody {
 	method := endpoint.Method()
 	// GET, HEAD, DELETE typically don't have request bodies
 	if method == http.MethodGet || method == http.MethodHead || method == http.MethodDelete {
@@ -144,10 +308,10 @@ func generateRequestBody(endpoint *parser.Endpoint) *RequestBody {
 
 	var schema *Schema
 	if bodyParam == "*" {
-		schema = GetSchema(endpoint.Input())
+		schema = collector.BodySchema(endpoint)
 	} else {
-		// Named body field
-		field := parser.FindField(bodyParam, endpoint.Input())
+		// Named body field, possibly nested
+		field := parser.FindFieldPath(bodyParam, endpoint.Input()).Leaf()
 		if field != nil {
 			schema = GetFieldSchema(field)
 		}
@@ -168,14 +332,9 @@ func generateRequestBody(endpoint *parser.Endpoint) *RequestBody {
 func generateResponses(endpoint *parser.Endpoint) map[string]*Response {
 	responses := make(map[string]*Response)
 
-	// Determine success status code
-	statusCode := "200"
-	switch endpoint.Method() {
-	case http.MethodPost:
-		statusCode = "201"
-	case http.MethodDelete:
-		statusCode = "204"
-	}
+	// The success status code is the one the handler writes
+	status := endpoint.SuccessStatus()
+	statusCode := strconv.Itoa(status)
 
 	var schema *Schema
 	responseBody := endpoint.ResponseBody()
@@ -208,15 +367,18 @@ func generateResponses(endpoint *parser.Endpoint) map[string]*Response {
 	}
 
 	description := "Success"
-	if statusCode == "201" {
-		description = "Created"
-	} else if statusCode == "204" {
-		description = "No Content"
+	if status != http.StatusOK {
+		description = http.StatusText(status)
 	}
 
 	resp := &Response{
 		Description: description,
 	}
+	if cacheControl := endpoint.CacheControl(); cacheControl != "" {
+		resp.Headers = map[string]*Header{
+			"Cache-Control": {Description: cacheControl, Schema: &Schema{Type: "string"}},
+		}
+	}
 	if schema != nil || isDynamicContent {
 		contentType := "application/json"
 		if isDynamicContent {
@@ -3,7 +3,13 @@ package openapi
 import (
 	"encoding/json"
 	"net/http"
+	"slices"
 	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"github.com/soyacen/goose/example/query"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestNormalizePath(t *testing.T) {
@@ -144,3 +150,52 @@ func TestDocumentJSONMarshal(t *testing.T) {
 		t.Error("marshaled document string is empty")
 	}
 }
+
+func TestDeepObjectProtoNames(t *testing.T) {
+	// rename NestedQueryRequest.Filter.owner to owner_info, whose JSON name differs
+	rename := func(fdp *descriptorpb.FileDescriptorProto) {
+		for _, m := range fdp.GetMessageType() {
+			if m.GetName() != "NestedQueryRequest" {
+				continue
+			}
+			for _, nested := range m.GetNestedType() {
+				for _, f := range nested.GetField() {
+					if nested.GetName() == "Filter" && f.GetName() == "owner" {
+						f.Name, f.JsonName = proto.String("owner_info"), proto.String("ownerInfo")
+					}
+				}
+			}
+		}
+	}
+	plugin := newPlugin(t, query.File_example_query_query_proto, rename)
+	file := plugin.FilesByPath[query.File_example_query_query_proto.Path()]
+	services, err := parser.NewServices(file)
+	if err != nil {
+		t.Fatalf("failed to parse services: %v", err)
+	}
+	doc := (&Generator{}).document(file, services)
+	var filter *Parameter
+	for _, param := range doc.Paths["/v1/nested"].Get.Parameters {
+		if param.Name == "filter" {
+			filter = param
+		}
+	}
+	if filter == nil || filter.Style != "deepObject" {
+		t.Fatalf("expected the deepObject parameter filter, got %+v", filter)
+	}
+	owner := filter.Schema.Properties["owner_info"]
+	if owner == nil || owner.Properties["id"] == nil {
+		t.Fatalf("expected the proto named property owner_info, got %+v", filter.Schema.Properties)
+	}
+	if id := owner.Properties["id"]; id.Type != "integer" {
+		t.Errorf("expected the 64-bit query integer to be an integer, got %+v", id)
+	}
+
+	var names []string
+	for _, param := range toSwagger(doc).Paths["/v1/nested"].Get.Parameters {
+		names = append(names, param.Name)
+	}
+	if !slices.Contains(names, "filter.owner_info.id") {
+		t.Errorf("expected the swagger parameter filter.owner_info.id, got %v", names)
+	}
+}
@@ -0,0 +1,123 @@
+package openapi
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"github.com/soyacen/goose/example/body"
+	"github.com/soyacen/goose/example/query"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// newPlugin returns a plugin generating file, as protoc would run it. The edits are applied
+// to the descriptor of file, e.g. to add the source code info compiled descriptors lack.
+func newPlugin(t *testing.T, file protoreflect.FileDescriptor, edits ...func(fdp *descriptorpb.FileDescriptorProto)) *protogen.Plugin {
+	t.Helper()
+	var files []*descriptorpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(file)
+	for _, edit := range edits {
+		edit(files[len(files)-1])
+	}
+	parameter := "paths=source_relative"
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.Path()},
+		Parameter:      &parameter,
+		ProtoFile:      files,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	return plugin
+}
+
+func TestGolden(t *testing.T) {
+	files := map[string]protoreflect.FileDescriptor{
+		"query": query.File_example_query_query_proto,
+		"body":  body.File_example_body_body_proto,
+	}
+	versions := map[string]string{
+		Version30: "openapi30.json",
+		Version31: "openapi31.json",
+		Version20: "swagger20.json",
+	}
+	for name, fd := range files {
+		for version, suffix := range versions {
+			t.Run(name+"_"+version, func(t *testing.T) {
+				plugin := newPlugin(t, fd)
+				file := plugin.FilesByPath[fd.Path()]
+				services, err := parser.NewServices(file)
+				if err != nil {
+					t.Fatalf("failed to parse services: %v", err)
+				}
+				gen := &Generator{Version: version}
+				if err := gen.Generate(plugin, file, services); err != nil {
+					t.Fatalf("failed to generate: %v", err)
+				}
+				resp := plugin.Response()
+				if resp.Error != nil {
+					t.Fatalf("plugin error: %s", resp.GetError())
+				}
+				if len(resp.File) != 1 {
+					t.Fatalf("expected 1 generated file, got %d", len(resp.File))
+				}
+				got := []byte(resp.File[0].GetContent())
+
+				golden := filepath.Join("testdata", name+"."+suffix)
+				if *update {
+					if err := os.WriteFile(golden, got, 0o644); err != nil {
+						t.Fatalf("failed to update golden file: %v", err)
+					}
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("failed to read golden file: %v", err)
+				}
+				if string(got) != string(want) {
+					t.Errorf("%s does not match the generated document, run go test -update to update it", golden)
+				}
+			})
+		}
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := map[string]string{
+		"":        Version30,
+		"3.0.3":   Version30,
+		"3.1":     Version31,
+		"3.1.0":   Version31,
+		"2.0":     Version20,
+		"swagger": Version20,
+	}
+	for value, want := range tests {
+		got, err := ParseVersion(value)
+		if err != nil || got != want {
+			t.Errorf("ParseVersion(%q) = %q, %v, want %q", value, got, err, want)
+		}
+	}
+	if _, err := ParseVersion("4.0"); err == nil {
+		t.Error("expected an error for an unsupported version")
+	}
+}
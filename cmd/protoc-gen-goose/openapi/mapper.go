@@ -1,52 +1,65 @@
 package openapi
 
 import (
+	"encoding/json"
+	"math"
+	"strconv"
+
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// Patterns of the strings protojson writes for 64-bit integers and durations.
+const (
+	int64Pattern    = `^-?[0-9]+$`
+	uint64Pattern   = `^[0-9]+$`
+	durationPattern = `^-?[0-9]+(\.[0-9]{1,9})?s$`
+)
+
 // isWellKnownType returns true if the message is a well-known protobuf type
 // that has special OpenAPI representation.
 func isWellKnownType(name protoreflect.FullName) bool {
-	switch name {
-	case "google.protobuf.Timestamp",
-		"google.protobuf.Duration",
-		"google.protobuf.Empty",
-		"google.protobuf.StringValue",
-		"google.protobuf.Int32Value",
-		"google.protobuf.Int64Value",
-		"google.protobuf.UInt32Value",
-		"google.protobuf.UInt64Value",
-		"google.protobuf.BoolValue",
-		"google.protobuf.FloatValue",
-		"google.protobuf.DoubleValue",
-		"google.protobuf.BytesValue",
-		"google.api.HttpBody":
-		return true
-	}
-	return false
+	return wellKnownTypeToSchema(name) != nil
 }
 
-// wellKnownTypeToSchema returns the OpenAPI Schema for a well-known protobuf type.
-// Returns nil if the type is not a well-known type.
+// wellKnownTypeToSchema returns the OpenAPI Schema for a well-known protobuf type,
+// as protojson writes it. Returns nil if the type is not a well-known type.
 func wellKnownTypeToSchema(name protoreflect.FullName) *Schema {
 	switch name {
 	case "google.protobuf.Timestamp":
 		return &Schema{Type: "string", Format: "date-time"}
 	case "google.protobuf.Duration":
-		return &Schema{Type: "string"}
+		return &Schema{Type: "string", Pattern: durationPattern, Description: "Seconds with up to nine fractional digits, suffixed with s, e.g. 1.5s."}
+	case "google.protobuf.FieldMask":
+		return &Schema{Type: "string", Description: "Comma separated lowerCamelCase field paths, e.g. user.displayName,photo."}
 	case "google.protobuf.Empty":
 		return &Schema{Type: "object"}
+	case "google.protobuf.Struct":
+		return &Schema{Type: "object", AdditionalProperties: &Schema{}}
+	case "google.protobuf.Value":
+		// any JSON value
+		return &Schema{}
+	case "google.protobuf.ListValue":
+		return &Schema{Type: "array", Items: &Schema{}}
+	case "google.protobuf.Any":
+		return &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"@type": {Type: "string", Description: "The URL of the type of the message, e.g. type.googleapis.com/google.protobuf.Duration."},
+			},
+			Required:             []string{"@type"},
+			AdditionalProperties: &Schema{},
+		}
 	case "google.protobuf.StringValue":
 		return &Schema{Type: "string", Nullable: true}
 	case "google.protobuf.Int32Value":
 		return &Schema{Type: "integer", Format: "int32", Nullable: true}
 	case "google.protobuf.Int64Value":
-		return &Schema{Type: "integer", Format: "int64", Nullable: true}
+		return &Schema{Type: "string", Format: "int64", Pattern: int64Pattern, Nullable: true}
 	case "google.protobuf.UInt32Value":
-		return &Schema{Type: "integer", Format: "int32", Nullable: true}
+		return uint32Schema(true)
 	case "google.protobuf.UInt64Value":
-		return &Schema{Type: "integer", Format: "int64", Nullable: true}
+		return &Schema{Type: "string", Format: "uint64", Pattern: uint64Pattern, Nullable: true}
 	case "google.protobuf.BoolValue":
 		return &Schema{Type: "boolean", Nullable: true}
 	case "google.protobuf.FloatValue":
@@ -61,31 +74,36 @@ func wellKnownTypeToSchema(name protoreflect.FullName) *Schema {
 	return nil
 }
 
-// protoFieldToSchema converts a protobuf field descriptor to an OpenAPI Schema.
+// protoFieldToSchema converts a protobuf field descriptor to an OpenAPI Schema,
+// described by the comments of the field.
 func protoFieldToSchema(field *protogen.Field, schemaResolver func(*protogen.Message) string) *Schema {
-	if field.Desc.IsList() {
+	var schema *Schema
+	switch {
+	case field.Desc.IsList():
 		elemSchema := protoKindToSchema(field, schemaResolver)
-		return &Schema{
+		schema = &Schema{
 			Type:  "array",
 			Items: elemSchema,
 		}
-	}
-
-	if field.Desc.IsMap() {
+	case field.Desc.IsMap():
 		// Map fields have a synthetic message with key and value sub-fields.
 		valueField := field.Message.Fields[1]
 		valueSchema := protoFieldToSchema(valueField, schemaResolver)
-		return &Schema{
+		schema = &Schema{
 			Type:                 "object",
 			AdditionalProperties: valueSchema,
 		}
+	default:
+		schema = protoKindToSchema(field, schemaResolver)
 	}
-
-	return protoKindToSchema(field, schemaResolver)
+	schema.Description = fieldDescription(field)
+	applyRules(schema, field)
+	return schema
 }
 
 // protoKindToSchema converts a protobuf field's kind to an OpenAPI Schema.
 // This function handles scalar, enum, and message types (but not list/map).
+// 64-bit integers are strings and enums their value names, as protojson writes them.
 func protoKindToSchema(field *protogen.Field, schemaResolver func(*protogen.Message) string) *Schema {
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
@@ -93,11 +111,11 @@ func protoKindToSchema(field *protogen.Field, schemaResolver func(*protogen.Mess
 	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
 		return &Schema{Type: "integer", Format: "int32", Nullable: field.Desc.HasPresence()}
 	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
-		return &Schema{Type: "integer", Format: "int32", Nullable: field.Desc.HasPresence()}
+		return uint32Schema(field.Desc.HasPresence())
 	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
-		return &Schema{Type: "integer", Format: "int64", Nullable: field.Desc.HasPresence()}
+		return &Schema{Type: "string", Format: "int64", Pattern: int64Pattern, Nullable: field.Desc.HasPresence()}
 	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
-		return &Schema{Type: "integer", Format: "int64", Nullable: field.Desc.HasPresence()}
+		return &Schema{Type: "string", Format: "uint64", Pattern: uint64Pattern, Nullable: field.Desc.HasPresence()}
 	case protoreflect.FloatKind:
 		return &Schema{Type: "number", Format: "float", Nullable: field.Desc.HasPresence()}
 	case protoreflect.DoubleKind:
@@ -107,7 +125,10 @@ func protoKindToSchema(field *protogen.Field, schemaResolver func(*protogen.Mess
 	case protoreflect.BytesKind:
 		return &Schema{Type: "string", Format: "byte", Nullable: field.Desc.HasPresence()}
 	case protoreflect.EnumKind:
-		values := make([]string, 0, len(field.Enum.Values))
+		if field.Enum.Desc.FullName() == "google.protobuf.NullValue" {
+			return &Schema{Nullable: true, Enum: []any{nil}}
+		}
+		values := make([]any, 0, len(field.Enum.Values))
 		for i := 0; i < len(field.Enum.Values); i++ {
 			values = append(values, string(field.Enum.Values[i].Desc.Name()))
 		}
@@ -133,3 +154,73 @@ func protoKindToSchema(field *protogen.Field, schemaResolver func(*protogen.Mess
 		return &Schema{Type: "object"}
 	}
 }
+
+// uint32Schema returns the schema of an unsigned 32-bit integer, whose values above 2^31-1
+// do not fit the int32 format.
+func uint32Schema(nullable bool) *Schema {
+	minimum, maximum := float64(0), float64(math.MaxUint32)
+	return &Schema{Type: "integer", Format: "int64", Minimum: &minimum, Maximum: &maximum, Nullable: nullable}
+}
+
+// protoFieldToParameterSchema converts a protobuf field to the Schema of a path or query
+// parameter. Parameters are not protojson, 64-bit integers are plain integers and enums
+// are bound by their numbers.
+func protoFieldToParameterSchema(field *protogen.Field) *Schema {
+	schema := GetFieldSchema(field)
+	target, enum := schema, field.Enum
+	switch {
+	case field.Desc.IsMap():
+		target, enum = schema.AdditionalProperties, field.Message.Fields[1].Enum
+	case field.Desc.IsList():
+		target = schema.Items
+	}
+	switch {
+	case target.Format == "int64" || target.Format == "uint64":
+		target.Type, target.Format, target.Pattern = "integer", "int64", ""
+		// values of the validation rules
+		toNumbers(target.Enum)
+		if target.Not != nil {
+			toNumbers(target.Not.Enum)
+		}
+		toNumberBounds(target)
+	case enum != nil && enum.Desc.FullName() != "google.protobuf.NullValue":
+		// the names left by the validation rules
+		values := make([]any, 0, len(target.Enum))
+		names := make([]string, 0, len(target.Enum))
+		for _, name := range target.Enum {
+			value := enum.Desc.Values().ByName(protoreflect.Name(name.(string)))
+			values = append(values, int32(value.Number()))
+			names = append(names, string(value.Name()))
+		}
+		target.Type, target.Format, target.Enum, target.XEnumVarnames = "integer", "int32", values, names
+	}
+	return schema
+}
+
+// toNumberBounds turns the bounds of a 64-bit integer written as a string into the bounds
+// of an integer.
+func toNumberBounds(schema *Schema) {
+	if bound := schema.XMinimum; bound != nil {
+		value, _ := strconv.ParseFloat(bound.Value, 64)
+		schema.Minimum, schema.XMinimum = &value, nil
+		if bound.Exclusive {
+			schema.ExclusiveMinimum = true
+		}
+	}
+	if bound := schema.XMaximum; bound != nil {
+		value, _ := strconv.ParseFloat(bound.Value, 64)
+		schema.Maximum, schema.XMaximum = &value, nil
+		if bound.Exclusive {
+			schema.ExclusiveMaximum = true
+		}
+	}
+}
+
+// toNumbers turns the decimal strings of values into numbers.
+func toNumbers(values []any) {
+	for i, value := range values {
+		if s, ok := value.(string); ok {
+			values[i] = json.Number(s)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package openapi
 
 import (
+	"math"
 	"testing"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -15,15 +16,20 @@ func TestWellKnownTypeToSchema(t *testing.T) {
 	}{
 		{"google.protobuf.Timestamp", "string", "date-time", false},
 		{"google.protobuf.Duration", "string", "", false},
+		{"google.protobuf.FieldMask", "string", "", false},
 		{"google.protobuf.Empty", "object", "", false},
 		{"google.protobuf.StringValue", "string", "", true},
 		{"google.protobuf.Int32Value", "integer", "int32", true},
-		{"google.protobuf.Int64Value", "integer", "int64", true},
+		{"google.protobuf.Int64Value", "string", "int64", true},
 		{"google.protobuf.BoolValue", "boolean", "", true},
 		{"google.protobuf.FloatValue", "number", "float", true},
 		{"google.protobuf.DoubleValue", "number", "double", true},
-		{"google.protobuf.UInt32Value", "integer", "int32", true},
-		{"google.protobuf.UInt64Value", "integer", "int64", true},
+		{"google.protobuf.UInt32Value", "integer", "int64", true},
+		{"google.protobuf.UInt64Value", "string", "uint64", true},
+		{"google.protobuf.Struct", "object", "", false},
+		{"google.protobuf.Value", "", "", false},
+		{"google.protobuf.ListValue", "array", "", false},
+		{"google.protobuf.Any", "object", "", false},
 		{"google.api.HttpBody", "string", "binary", false},
 	}
 
@@ -46,6 +52,13 @@ func TestWellKnownTypeToSchema(t *testing.T) {
 	}
 }
 
+func TestWellKnownTypeToSchema_UInt32Bounds(t *testing.T) {
+	got := wellKnownTypeToSchema("google.protobuf.UInt32Value")
+	if got.Minimum == nil || *got.Minimum != 0 || got.Maximum == nil || *got.Maximum != math.MaxUint32 {
+		t.Errorf("UInt32Value bounds = %v, %v; want 0, %d", got.Minimum, got.Maximum, uint32(math.MaxUint32))
+	}
+}
+
 func TestWellKnownTypeToSchema_Unknown(t *testing.T) {
 	got := wellKnownTypeToSchema("unknown.Type")
 	if got != nil {
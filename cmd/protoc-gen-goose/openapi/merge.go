@@ -0,0 +1,118 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// merger merges the documents of several proto files into one document. Operations are
+// merged by path and method, schemas by name. An operation defined by two files, or a schema
+// name defined differently by two files, is a conflict.
+type merger struct {
+	name string
+	doc  *Document
+	// operations are the files defining the merged operations, keyed by "METHOD path".
+	operations map[string]string
+	// schemas are the files that first defined the merged schemas, keyed by name.
+	schemas map[string]string
+	// errs are the conflicts found so far.
+	errs []error
+}
+
+func newMerger(name string) *merger {
+	return &merger{
+		name: name,
+		doc: &Document{
+			OpenAPI: "3.0.3",
+			Paths:   make(map[string]*PathItem),
+		},
+		operations: make(map[string]string),
+		schemas:    make(map[string]string),
+	}
+}
+
+// merge merges the document of file, recording the conflicts it has with the documents
+// merged before.
+func (m *merger) merge(file *protogen.File, doc *Document) {
+	source := file.Desc.Path()
+
+	// The title is the one of the package when all files share it
+	switch {
+	case m.doc.Info == nil:
+		info := *doc.Info
+		m.doc.Info = &info
+	case m.doc.Info.Title != doc.Info.Title:
+		m.doc.Info.Title = fmt.Sprintf("%s API", strings.TrimSuffix(path.Base(m.name), ".json"))
+	}
+
+	for _, tag := range doc.Tags {
+		if !hasTag(m.doc.Tags, tag.Name) {
+			m.doc.Tags = append(m.doc.Tags, tag)
+		}
+	}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for p := range doc.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		item, ok := m.doc.Paths[p]
+		if !ok {
+			item = &PathItem{}
+			m.doc.Paths[p] = item
+		}
+		eachOperation(doc.Paths[p], func(method string, operation *Operation) {
+			key := method + " " + p
+			if other, ok := m.operations[key]; ok {
+				m.errs = append(m.errs, fmt.Errorf("openapi_merge: path %s of %s conflicts with %s", key, source, other))
+				return
+			}
+			m.operations[key] = source
+			setOperation(item, method, operation)
+		})
+	}
+
+	if doc.Components != nil {
+		names := make([]string, 0, len(doc.Components.Schemas))
+		for name := range doc.Components.Schemas {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if m.doc.Components == nil {
+			m.doc.Components = &Components{Schemas: make(map[string]*Schema)}
+		}
+		for _, name := range names {
+			schema := doc.Components.Schemas[name]
+			if existing, ok := m.doc.Components.Schemas[name]; ok {
+				// messages shared by several files are collected by each of them
+				if !reflect.DeepEqual(existing, schema) {
+					m.errs = append(m.errs, fmt.Errorf("openapi_merge: schema %s of %s conflicts with %s", name, source, m.schemas[name]))
+				}
+				continue
+			}
+			m.schemas[name] = source
+			m.doc.Components.Schemas[name] = schema
+		}
+	}
+}
+
+// err returns the conflicts found while merging, nil if none.
+func (m *merger) err() error {
+	return errors.Join(m.errs...)
+}
+
+func hasTag(tags []*Tag, name string) bool {
+	for _, tag := range tags {
+		if tag.Name == name {
+			return true
+		}
+	}
+	return false
+}
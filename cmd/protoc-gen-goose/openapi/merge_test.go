@@ -0,0 +1,113 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"github.com/soyacen/goose/example/body"
+	"github.com/soyacen/goose/example/query"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// mergeFiles merges the documents of fds, returning the plugin the merged document is
+// written to and the error of WriteMerged.
+func mergeFiles(t *testing.T, gen *Generator, fds ...protoreflect.FileDescriptor) (*protogen.Plugin, error) {
+	t.Helper()
+	var plugin *protogen.Plugin
+	for _, fd := range fds {
+		plugin = newPlugin(t, fd)
+		file := plugin.FilesByPath[fd.Path()]
+		services, err := parser.NewServices(file)
+		if err != nil {
+			t.Fatalf("failed to parse services: %v", err)
+		}
+		if err := gen.Generate(plugin, file, services); err != nil {
+			t.Fatalf("failed to generate: %v", err)
+		}
+	}
+	return plugin, gen.WriteMerged(plugin)
+}
+
+func TestMerge(t *testing.T) {
+	gen := &Generator{Merge: "api"}
+	plugin, err := mergeFiles(t, gen, query.File_example_query_query_proto, body.File_example_body_body_proto)
+	if err != nil {
+		t.Fatalf("failed to merge: %v", err)
+	}
+	resp := plugin.Response()
+	if len(resp.File) != 1 || resp.File[0].GetName() != "api.openapi.json" {
+		t.Fatalf("expected only api.openapi.json, got %v", resp.File)
+	}
+	doc := &Document{}
+	if err := json.Unmarshal([]byte(resp.File[0].GetContent()), doc); err != nil {
+		t.Fatalf("failed to unmarshal document: %v", err)
+	}
+	if doc.Info.Title != "api API" {
+		t.Errorf("title = %q, want the merge name for files of different packages", doc.Info.Title)
+	}
+	if doc.Paths["/v1/enum"] == nil || doc.Paths["/v1/star/body"] == nil {
+		t.Error("expected the paths of both files")
+	}
+	if doc.Components.Schemas["leo.goose.example.query.v1.EnumQueryRequest"] == nil ||
+		doc.Components.Schemas["leo.goose.example.body.v1.BodyRequest"] == nil {
+		t.Error("expected the schemas of both files")
+	}
+	if !hasTag(doc.Tags, "EnumQuery") || !hasTag(doc.Tags, "Body") {
+		t.Error("expected the tags of both files")
+	}
+}
+
+func TestMergeSwaggerFilename(t *testing.T) {
+	gen := &Generator{Version: Version20, Merge: "docs/api.json"}
+	plugin, err := mergeFiles(t, gen, query.File_example_query_query_proto)
+	if err != nil {
+		t.Fatalf("failed to merge: %v", err)
+	}
+	resp := plugin.Response()
+	if len(resp.File) != 1 || resp.File[0].GetName() != "docs/api.json" {
+		t.Fatalf("expected only docs/api.json, got %v", resp.File)
+	}
+}
+
+func TestMergePathConflict(t *testing.T) {
+	gen := &Generator{Merge: "api"}
+	plugin, err := mergeFiles(t, gen, query.File_example_query_query_proto, query.File_example_query_query_proto)
+	if err == nil {
+		t.Fatal("expected conflicting paths to be reported")
+	}
+	if !strings.Contains(err.Error(), "path GET /v1/enum of example/query/query.proto conflicts with example/query/query.proto") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(plugin.Response().File) != 0 {
+		t.Error("expected no document to be written")
+	}
+}
+
+func TestMergeSchemaConflict(t *testing.T) {
+	plugin := newPlugin(t, query.File_example_query_query_proto)
+	first := plugin.FilesByPath["example/query/query.proto"]
+	second := plugin.FilesByPath["google/api/httpbody.proto"]
+	m := newMerger("api")
+	m.merge(first, &Document{
+		Info:       &Info{Title: "a API"},
+		Components: &Components{Schemas: map[string]*Schema{"a.Item": {Type: "object"}}},
+	})
+	m.merge(second, &Document{
+		Info:       &Info{Title: "a API"},
+		Components: &Components{Schemas: map[string]*Schema{"a.Item": {Type: "object"}}},
+	})
+	if err := m.err(); err != nil {
+		t.Fatalf("identical schemas should merge, got %v", err)
+	}
+	m.merge(second, &Document{
+		Info:       &Info{Title: "a API"},
+		Components: &Components{Schemas: map[string]*Schema{"a.Item": {Type: "string"}}},
+	})
+	err := m.err()
+	if err == nil || !strings.Contains(err.Error(), "schema a.Item of google/api/httpbody.proto conflicts with example/query/query.proto") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,338 @@
+package openapi
+
+import (
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Extensions holding the validation rules of a field.
+const (
+	// pgvRules is the extension of protoc-gen-validate, validate/validate.proto.
+	pgvRules protoreflect.FullName = "validate.rules"
+	// protovalidateRules is the extension of protovalidate, buf/validate/validate.proto.
+	protovalidateRules protoreflect.FullName = "buf.validate.field"
+)
+
+// stringFormats are the string rules standing for a format of the schema.
+var stringFormats = map[protoreflect.Name]string{
+	"email":    "email",
+	"hostname": "hostname",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"uri":      "uri",
+	"uuid":     "uuid",
+}
+
+// fieldRules returns the validation rules of a field, nil if it has none. The rules are read
+// through the descriptors of the validate.proto imported by the file of the field, so the
+// plugin does not depend on the Go packages of the validators.
+func fieldRules(field protoreflect.FieldDescriptor) protoreflect.Message {
+	options, ok := field.Options().(*descriptorpb.FieldOptions)
+	if !ok || options == nil {
+		return nil
+	}
+	for _, name := range []protoreflect.FullName{protovalidateRules, pgvRules} {
+		xd := findExtension(field.ParentFile(), name, make(map[string]bool))
+		if xd == nil {
+			continue
+		}
+		xt := dynamicpb.NewExtensionType(xd)
+		types := new(protoregistry.Types)
+		if err := types.RegisterExtension(xt); err != nil {
+			continue
+		}
+		// extensions of unknown types are kept as unknown fields, parse them again
+		data, err := proto.Marshal(options)
+		if err != nil {
+			continue
+		}
+		resolved := &descriptorpb.FieldOptions{}
+		if err := (proto.UnmarshalOptions{Resolver: types}).Unmarshal(data, resolved); err != nil {
+			continue
+		}
+		// the extendee of xd is the FieldOptions of the request, not the linked one
+		var rules protoreflect.Message
+		resolved.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			if fd.FullName() == name {
+				rules = value.Message()
+			}
+			return rules == nil
+		})
+		if rules != nil {
+			return rules
+		}
+	}
+	return nil
+}
+
+// findExtension finds the extension named name in file and the files it imports.
+func findExtension(file protoreflect.FileDescriptor, name protoreflect.FullName, visited map[string]bool) protoreflect.ExtensionDescriptor {
+	if visited[file.Path()] {
+		return nil
+	}
+	visited[file.Path()] = true
+	if file.Package() == name.Parent() {
+		if xd := file.Extensions().ByName(name.Name()); xd != nil {
+			return xd
+		}
+	}
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if xd := findExtension(imports.Get(i).FileDescriptor, name, visited); xd != nil {
+			return xd
+		}
+	}
+	return nil
+}
+
+// isRequired reports whether a field must be set, as annotated by google.api.field_behavior
+// or by the required rule of the validators.
+func isRequired(field *protogen.Field) bool {
+	if hasFieldBehavior(field, annotations.FieldBehavior_REQUIRED) {
+		return true
+	}
+	rules := fieldRules(field.Desc)
+	if rules == nil {
+		return false
+	}
+	// protovalidate: (buf.validate.field).required
+	if value, ok := ruleValue(rules, "required"); ok && value.Bool() {
+		return true
+	}
+	// protoc-gen-validate: (validate.rules).message.required
+	if message, ok := ruleValue(rules, "message"); ok {
+		if value, ok := ruleValue(message.Message(), "required"); ok && value.Bool() {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRules adds the constraints of the validation rules of a field to its schema.
+func applyRules(schema *Schema, field *protogen.Field) {
+	if rules := fieldRules(field.Desc); rules != nil {
+		applyTypeRules(schema, field, rules)
+	}
+}
+
+// applyTypeRules adds the constraints of the rules of the type of a field, the type rules
+// are the set field of the type oneof of the rules.
+func applyTypeRules(schema *Schema, field *protogen.Field, rules protoreflect.Message) {
+	oneof := rules.Descriptor().Oneofs().ByName("type")
+	if oneof == nil || schema == nil {
+		return
+	}
+	fd := rules.WhichOneof(oneof)
+	if fd == nil || fd.Message() == nil {
+		return
+	}
+	typeRules := rules.Get(fd).Message()
+	switch fd.Name() {
+	case "string":
+		applyStringRules(schema, typeRules)
+	case "enum":
+		if field.Enum != nil {
+			applyEnumRules(schema, field.Enum, typeRules)
+		}
+	case "repeated":
+		setCount(&schema.MinItems, typeRules, "min_items")
+		setCount(&schema.MaxItems, typeRules, "max_items")
+		if value, ok := ruleValue(typeRules, "unique"); ok {
+			schema.UniqueItems = value.Bool()
+		}
+		if items, ok := ruleValue(typeRules, "items"); ok {
+			applyTypeRules(schema.Items, field, items.Message())
+		}
+	case "map":
+		setCount(&schema.MinProperties, typeRules, "min_pairs")
+		setCount(&schema.MaxProperties, typeRules, "max_pairs")
+		if values, ok := ruleValue(typeRules, "values"); ok && field.Message != nil {
+			applyTypeRules(schema.AdditionalProperties, field.Message.Fields[1], values.Message())
+		}
+	case "float", "double", "int32", "int64", "uint32", "uint64", "sint32", "sint64",
+		"fixed32", "fixed64", "sfixed32", "sfixed64":
+		applyNumberRules(schema, typeRules)
+	}
+}
+
+// applyStringRules adds the constraints of string rules.
+func applyStringRules(schema *Schema, rules protoreflect.Message) {
+	if value, ok := ruleValue(rules, "len"); ok {
+		length := value.Uint()
+		schema.MinLength, schema.MaxLength = &length, &length
+	}
+	setCount(&schema.MinLength, rules, "min_len")
+	setCount(&schema.MaxLength, rules, "max_len")
+	if value, ok := ruleValue(rules, "pattern"); ok {
+		schema.Pattern = value.String()
+	}
+	if value, ok := ruleValue(rules, "const"); ok {
+		schema.Enum = []any{value.String()}
+	}
+	if values := ruleList(rules, "in"); len(values) > 0 {
+		schema.Enum = nil
+		for _, value := range values {
+			schema.Enum = append(schema.Enum, value.String())
+		}
+	}
+	if values := ruleList(rules, "not_in"); len(values) > 0 {
+		not := &Schema{}
+		for _, value := range values {
+			not.Enum = append(not.Enum, value.String())
+		}
+		schema.Not = not
+	}
+	for name, format := range stringFormats {
+		if value, ok := ruleValue(rules, name); ok && value.Bool() {
+			schema.Format = format
+		}
+	}
+}
+
+// applyNumberRules adds the constraints of numeric rules. The bounds of the 64-bit integers
+// protojson writes as strings are extensions, minimum and maximum only apply to numbers.
+func applyNumberRules(schema *Schema, rules protoreflect.Message) {
+	if schema.Type == "string" {
+		schema.XMinimum = stringBound(schema, rules, "gte", "gt")
+		schema.XMaximum = stringBound(schema, rules, "lte", "lt")
+	} else {
+		if value, ok := ruleNumber(rules, "gte"); ok {
+			schema.Minimum = &value
+		}
+		if value, ok := ruleNumber(rules, "gt"); ok {
+			schema.Minimum, schema.ExclusiveMinimum = &value, true
+		}
+		if value, ok := ruleNumber(rules, "lte"); ok {
+			schema.Maximum = &value
+		}
+		if value, ok := ruleNumber(rules, "lt"); ok {
+			schema.Maximum, schema.ExclusiveMaximum = &value, true
+		}
+	}
+	enum := func(values []protoreflect.Value, fd protoreflect.FieldDescriptor) []any {
+		var out []any
+		for _, value := range values {
+			out = append(out, numberValue(schema, value, fd))
+		}
+		return out
+	}
+	if fd := rules.Descriptor().Fields().ByName("const"); fd != nil && rules.Has(fd) {
+		schema.Enum = enum([]protoreflect.Value{rules.Get(fd)}, fd)
+	}
+	if fd := rules.Descriptor().Fields().ByName("in"); fd != nil && rules.Has(fd) {
+		schema.Enum = enum(ruleList(rules, "in"), fd)
+	}
+	if fd := rules.Descriptor().Fields().ByName("not_in"); fd != nil && rules.Has(fd) {
+		schema.Not = &Schema{Enum: enum(ruleList(rules, "not_in"), fd)}
+	}
+}
+
+// applyEnumRules keeps the values of an enum allowed by enum rules.
+func applyEnumRules(schema *Schema, enum *protogen.Enum, rules protoreflect.Message) {
+	allowed := func(number protoreflect.EnumNumber) bool { return true }
+	if value, ok := ruleValue(rules, "const"); ok {
+		allowed = func(number protoreflect.EnumNumber) bool { return int32(number) == int32(value.Int()) }
+	}
+	if in := ruleList(rules, "in"); len(in) > 0 {
+		allowed = func(number protoreflect.EnumNumber) bool { return containsNumber(in, number) }
+	}
+	notIn := ruleList(rules, "not_in")
+	schema.Enum = nil
+	for _, value := range enum.Values {
+		if number := value.Desc.Number(); allowed(number) && !containsNumber(notIn, number) {
+			schema.Enum = append(schema.Enum, string(value.Desc.Name()))
+		}
+	}
+}
+
+func containsNumber(values []protoreflect.Value, number protoreflect.EnumNumber) bool {
+	for _, value := range values {
+		if value.Int() == int64(number) {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleValue returns the value of the rule named name, false if the rules do not set it.
+func ruleValue(rules protoreflect.Message, name protoreflect.Name) (protoreflect.Value, bool) {
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || !rules.Has(fd) {
+		return protoreflect.Value{}, false
+	}
+	return rules.Get(fd), true
+}
+
+// ruleList returns the values of the repeated rule named name.
+func ruleList(rules protoreflect.Message, name protoreflect.Name) []protoreflect.Value {
+	value, ok := ruleValue(rules, name)
+	if !ok || !value.List().IsValid() {
+		return nil
+	}
+	list := value.List()
+	values := make([]protoreflect.Value, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		values = append(values, list.Get(i))
+	}
+	return values
+}
+
+// ruleNumber returns the numeric rule named name as a float64.
+func ruleNumber(rules protoreflect.Message, name protoreflect.Name) (float64, bool) {
+	fd := rules.Descriptor().Fields().ByName(name)
+	if fd == nil || !rules.Has(fd) {
+		return 0, false
+	}
+	return toFloat(rules.Get(fd), fd), true
+}
+
+// stringBound returns the bound of a 64-bit integer written as a string set by the rule
+// named inclusive or exclusive, nil if the rules set neither.
+func stringBound(schema *Schema, rules protoreflect.Message, inclusive, exclusive protoreflect.Name) *Bound {
+	var bound *Bound
+	for _, name := range []protoreflect.Name{inclusive, exclusive} {
+		if fd := rules.Descriptor().Fields().ByName(name); fd != nil && rules.Has(fd) {
+			bound = &Bound{Value: numberValue(schema, rules.Get(fd), fd).(string), Exclusive: name == exclusive}
+		}
+	}
+	return bound
+}
+
+func setCount(count **uint64, rules protoreflect.Message, name protoreflect.Name) {
+	if value, ok := ruleValue(rules, name); ok {
+		n := value.Uint()
+		*count = &n
+	}
+}
+
+func toFloat(value protoreflect.Value, fd protoreflect.FieldDescriptor) float64 {
+	switch fd.Kind() {
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float()
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return float64(value.Uint())
+	default:
+		return float64(value.Int())
+	}
+}
+
+// numberValue returns a value of a numeric rule as the schema holds it, 64-bit integers
+// written as strings by protojson are strings.
+func numberValue(schema *Schema, value protoreflect.Value, fd protoreflect.FieldDescriptor) any {
+	if schema.Type != "string" {
+		return toFloat(value, fd)
+	}
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(value.Uint(), 10)
+	default:
+		return strconv.FormatInt(value.Int(), 10)
+	}
+}
@@ -0,0 +1,308 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// pgvProto is the part of validate/validate.proto of protoc-gen-validate the tests use,
+// with the numbers of the original.
+const pgvProto = `
+name: "validate/validate.proto"
+package: "validate"
+options { go_package: "github.com/envoyproxy/protoc-gen-validate/validate" }
+dependency: "google/protobuf/descriptor.proto"
+message_type {
+  name: "FieldRules"
+  field { name: "message" number: 17 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".validate.MessageRules" }
+  field { name: "int64" number: 4 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".validate.Int64Rules" oneof_index: 0 }
+  field { name: "string" number: 14 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".validate.StringRules" oneof_index: 0 }
+  field { name: "enum" number: 16 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".validate.EnumRules" oneof_index: 0 }
+  field { name: "repeated" number: 18 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".validate.RepeatedRules" oneof_index: 0 }
+  oneof_decl { name: "type" }
+}
+message_type {
+  name: "Int64Rules"
+  field { name: "const" number: 1 label: LABEL_OPTIONAL type: TYPE_INT64 }
+  field { name: "lt" number: 2 label: LABEL_OPTIONAL type: TYPE_INT64 }
+  field { name: "lte" number: 3 label: LABEL_OPTIONAL type: TYPE_INT64 }
+  field { name: "gt" number: 4 label: LABEL_OPTIONAL type: TYPE_INT64 }
+  field { name: "gte" number: 5 label: LABEL_OPTIONAL type: TYPE_INT64 }
+  field { name: "in" number: 6 label: LABEL_REPEATED type: TYPE_INT64 }
+}
+message_type {
+  name: "StringRules"
+  field { name: "const" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING }
+  field { name: "len" number: 19 label: LABEL_OPTIONAL type: TYPE_UINT64 }
+  field { name: "min_len" number: 2 label: LABEL_OPTIONAL type: TYPE_UINT64 }
+  field { name: "max_len" number: 3 label: LABEL_OPTIONAL type: TYPE_UINT64 }
+  field { name: "pattern" number: 6 label: LABEL_OPTIONAL type: TYPE_STRING }
+  field { name: "in" number: 10 label: LABEL_REPEATED type: TYPE_STRING }
+  field { name: "email" number: 12 label: LABEL_OPTIONAL type: TYPE_BOOL oneof_index: 0 }
+  oneof_decl { name: "well_known" }
+}
+message_type {
+  name: "EnumRules"
+  field { name: "const" number: 1 label: LABEL_OPTIONAL type: TYPE_INT32 }
+  field { name: "defined_only" number: 2 label: LABEL_OPTIONAL type: TYPE_BOOL }
+  field { name: "in" number: 3 label: LABEL_REPEATED type: TYPE_INT32 }
+  field { name: "not_in" number: 4 label: LABEL_REPEATED type: TYPE_INT32 }
+}
+message_type {
+  name: "MessageRules"
+  field { name: "skip" number: 1 label: LABEL_OPTIONAL type: TYPE_BOOL }
+  field { name: "required" number: 2 label: LABEL_OPTIONAL type: TYPE_BOOL }
+}
+message_type {
+  name: "RepeatedRules"
+  field { name: "min_items" number: 1 label: LABEL_OPTIONAL type: TYPE_UINT64 }
+  field { name: "max_items" number: 2 label: LABEL_OPTIONAL type: TYPE_UINT64 }
+  field { name: "unique" number: 3 label: LABEL_OPTIONAL type: TYPE_BOOL }
+  field { name: "items" number: 4 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".validate.FieldRules" }
+}
+extension { name: "rules" number: 1071 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".validate.FieldRules" extendee: ".google.protobuf.FieldOptions" }
+`
+
+// protovalidateProto is the part of buf/validate/validate.proto of protovalidate the tests
+// use, with the numbers of the original.
+const protovalidateProto = `
+name: "buf/validate/validate.proto"
+package: "buf.validate"
+options { go_package: "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate" }
+dependency: "google/protobuf/descriptor.proto"
+message_type {
+  name: "FieldRules"
+  field { name: "required" number: 25 label: LABEL_OPTIONAL type: TYPE_BOOL }
+  field { name: "uint32" number: 5 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".buf.validate.UInt32Rules" oneof_index: 0 }
+  oneof_decl { name: "type" }
+}
+message_type {
+  name: "UInt32Rules"
+  field { name: "lt" number: 2 label: LABEL_OPTIONAL type: TYPE_UINT32 oneof_index: 0 }
+  field { name: "lte" number: 3 label: LABEL_OPTIONAL type: TYPE_UINT32 oneof_index: 0 }
+  field { name: "gt" number: 4 label: LABEL_OPTIONAL type: TYPE_UINT32 oneof_index: 1 }
+  field { name: "gte" number: 5 label: LABEL_OPTIONAL type: TYPE_UINT32 oneof_index: 1 }
+  oneof_decl { name: "less_than" }
+  oneof_decl { name: "greater_than" }
+}
+extension { name: "field" number: 1159 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".buf.validate.FieldRules" extendee: ".google.protobuf.FieldOptions" }
+`
+
+// rulesProto is a file whose fields carry validation rules, the options are set by newRulesFile.
+const rulesProto = `
+name: "test/rules.proto"
+package: "test.rules"
+syntax: "proto3"
+dependency: "google/api/annotations.proto"
+dependency: "validate/validate.proto"
+dependency: "buf/validate/validate.proto"
+options { go_package: "example.com/test/rules" }
+enum_type {
+  name: "Status"
+  value { name: "UNKNOWN" number: 0 }
+  value { name: "ACTIVE" number: 1 }
+  value { name: "BLOCKED" number: 2 }
+}
+message_type {
+  name: "Item"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING }
+}
+message_type {
+  name: "Rules"
+  field { name: "name" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING }
+  field { name: "age" number: 2 label: LABEL_OPTIONAL type: TYPE_INT64 }
+  field { name: "status" number: 3 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".test.rules.Status" }
+  field { name: "tags" number: 4 label: LABEL_REPEATED type: TYPE_STRING }
+  field { name: "item" number: 5 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.rules.Item" }
+  field { name: "email" number: 6 label: LABEL_OPTIONAL type: TYPE_STRING }
+  field { name: "level" number: 7 label: LABEL_OPTIONAL type: TYPE_UINT32 }
+  field { name: "owner" number: 8 label: LABEL_OPTIONAL type: TYPE_STRING }
+}
+service {
+  name: "RulesService"
+  method {
+    name: "Get" input_type: ".test.rules.Rules" output_type: ".test.rules.Rules"
+    options { [google.api.http] { post: "/v1/rules" body: "*" } }
+  }
+}
+`
+
+// fieldRulesText are the rules of the fields of test.rules.Rules, in the text format of the
+// FieldRules of the extension.
+var fieldRulesText = map[string]struct {
+	extension protoreflect.FullName
+	text      string
+}{
+	"name":   {pgvRules, `string: {min_len: 1, max_len: 10, pattern: "^[a-z]+$"}`},
+	"age":    {pgvRules, `int64: {gt: 0, lte: 150}`},
+	"status": {pgvRules, `enum: {in: [1]}`},
+	"tags":   {pgvRules, `repeated: {min_items: 1, unique: true, items: {string: {in: ["a", "b"]}}}`},
+	"item":   {pgvRules, `message: {required: true}`},
+	"email":  {pgvRules, `string: {email: true}`},
+	"level":  {protovalidateRules, `uint32: {gte: 1, lt: 10}`},
+	"owner":  {protovalidateRules, `required: true`},
+}
+
+// newRulesFile builds rules.proto, setting the validation rules of its fields.
+func newRulesFile(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+	files := new(protoregistry.Files)
+	if err := files.RegisterFile(descriptorpb.File_google_protobuf_descriptor_proto); err != nil {
+		t.Fatal(err)
+	}
+	extensions := make(map[protoreflect.FullName]protoreflect.ExtensionType)
+	for _, text := range []string{pgvProto, protovalidateProto} {
+		fd := parseFile(t, text, files)
+		if err := files.RegisterFile(fd); err != nil {
+			t.Fatal(err)
+		}
+		xd := fd.Extensions().Get(0)
+		extensions[xd.FullName()] = dynamicpb.NewExtensionType(xd)
+	}
+	types := new(protoregistry.Types)
+	for _, xt := range extensions {
+		if err := types.RegisterMessage(dynamicpb.NewMessageType(xt.TypeDescriptor().Message())); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fdp := &descriptorpb.FileDescriptorProto{}
+	if err := prototext.Unmarshal([]byte(rulesProto), fdp); err != nil {
+		t.Fatalf("failed to parse rules.proto: %v", err)
+	}
+	for _, field := range fdp.MessageType[1].Field {
+		rules, ok := fieldRulesText[field.GetName()]
+		if !ok {
+			continue
+		}
+		xt := extensions[rules.extension]
+		msg := dynamicpb.NewMessage(xt.TypeDescriptor().Message())
+		if err := (prototext.UnmarshalOptions{Resolver: types}).Unmarshal([]byte(rules.text), msg); err != nil {
+			t.Fatalf("failed to parse the rules of %s: %v", field.GetName(), err)
+		}
+		field.Options = &descriptorpb.FieldOptions{}
+		proto.SetExtension(field.Options, xt, msg)
+	}
+	resolver := &fallbackFiles{files}
+	fd, err := protodesc.NewFile(fdp, resolver)
+	if err != nil {
+		t.Fatalf("failed to build rules.proto: %v", err)
+	}
+	return fd
+}
+
+func parseFile(t *testing.T, text string, files *protoregistry.Files) protoreflect.FileDescriptor {
+	t.Helper()
+	fdp := &descriptorpb.FileDescriptorProto{}
+	if err := prototext.Unmarshal([]byte(text), fdp); err != nil {
+		t.Fatalf("failed to parse descriptor: %v", err)
+	}
+	fd, err := protodesc.NewFile(fdp, files)
+	if err != nil {
+		t.Fatalf("failed to build %s: %v", fdp.GetName(), err)
+	}
+	return fd
+}
+
+// fallbackFiles resolves the validate.proto files of the tests, then the linked files.
+type fallbackFiles struct {
+	*protoregistry.Files
+}
+
+func (f *fallbackFiles) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := f.Files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (f *fallbackFiles) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := f.Files.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+// rulesSchema returns the schema of test.rules.Rules.
+func rulesSchema(t *testing.T) *Schema {
+	t.Helper()
+	fd := newRulesFile(t)
+	plugin := newPlugin(t, fd)
+	file := plugin.FilesByPath[fd.Path()]
+	services, err := parser.NewServices(file)
+	if err != nil {
+		t.Fatalf("failed to parse services: %v", err)
+	}
+	return NewSchemaCollector().Collect(services)["test.rules.Rules"]
+}
+
+func TestValidationRules(t *testing.T) {
+	schema := rulesSchema(t)
+	if schema == nil {
+		t.Fatal("expected the schema of test.rules.Rules")
+	}
+	want := map[string]string{
+		"name":   `{"type":"string","pattern":"^[a-z]+$","minLength":1,"maxLength":10}`,
+		"age":    `{"type":"string","format":"int64","pattern":"^-?[0-9]+$","x-goose-minimum":{"value":"0","exclusive":true},"x-goose-maximum":{"value":"150"}}`,
+		"status": `{"type":"string","enum":["ACTIVE"]}`,
+		"tags":   `{"type":"array","items":{"type":"string","enum":["a","b"]},"minItems":1,"uniqueItems":true}`,
+		"email":  `{"type":"string","format":"email"}`,
+		"level":  `{"type":"integer","format":"int64","minimum":1,"maximum":10,"exclusiveMaximum":true}`,
+	}
+	for name, expected := range want {
+		data, err := json.Marshal(schema.Properties[name])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected {
+			t.Errorf("%s = %s, want %s", name, data, expected)
+		}
+	}
+	if len(schema.Required) != 2 || schema.Required[0] != "item" || schema.Required[1] != "owner" {
+		t.Errorf("required = %v, want the message required by PGV and the field required by protovalidate", schema.Required)
+	}
+}
+
+func TestValidationRulesJSONSchema(t *testing.T) {
+	schema := rulesSchema(t)
+	ToJSONSchema(schema)
+	data, err := json.Marshal(schema.Properties["level"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"type":"integer","format":"int64","minimum":1,"exclusiveMaximum":10}`; string(data) != want {
+		t.Errorf("level = %s, want %s", data, want)
+	}
+}
+
+func TestValidationRulesParameters(t *testing.T) {
+	fd := newRulesFile(t)
+	plugin := newPlugin(t, fd)
+	msg := plugin.FilesByPath[fd.Path()].Messages[1]
+	fields := make(map[string]*protogen.Field)
+	for _, field := range msg.Fields {
+		fields[string(field.Desc.Name())] = field
+	}
+	status := protoFieldToParameterSchema(fields["status"])
+	if len(status.Enum) != 1 || status.Enum[0] != int32(1) || status.XEnumVarnames[0] != "ACTIVE" {
+		t.Errorf("status = %v %v, want the numbers of the allowed values", status.Enum, status.XEnumVarnames)
+	}
+	age := protoFieldToParameterSchema(fields["age"])
+	data, err := json.Marshal(age)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"type":"integer","format":"int64","minimum":0,"maximum":150,"exclusiveMinimum":true}`; string(data) != want {
+		t.Errorf("age = %s, want %s", data, want)
+	}
+}
@@ -1,15 +1,20 @@
 package openapi
 
 import (
-	"fmt"
+	"slices"
 
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // SchemaCollector collects and generates OpenAPI schemas for protobuf messages.
 type SchemaCollector struct {
+	// ProtoNames names the properties by the proto names of the fields, matching protojson
+	// with UseProtoNames, instead of their lowerCamelCase JSON names.
+	ProtoNames bool
+
 	schemas map[string]*Schema
 	visited map[string]bool
 }
@@ -46,7 +51,7 @@ func schemaName(msg *protogen.Message) string {
 
 // schemaRef returns the $ref string for a message schema.
 func schemaRef(msg *protogen.Message) string {
-	return fmt.Sprintf("#/components/schemas/%s", schemaName(msg))
+	return componentsPrefix + schemaName(msg)
 }
 
 // collectMessage recursively collects a message and all its nested messages.
@@ -71,25 +76,66 @@ func (c *SchemaCollector) collectMessage(msg *protogen.Message) {
 }
 
 // generateMessageSchema generates an OpenAPI Schema for a single protobuf message.
+// Fields are optional by default as protojson omits fields holding their zero value, only
+// the fields annotated as REQUIRED by google.api.field_behavior or required by the rules of
+// the validators are listed as required. At most one field of each oneof is set, OUTPUT_ONLY
+// fields are read-only and INPUT_ONLY fields write-only.
 func (c *SchemaCollector) generateMessageSchema(msg *protogen.Message) *Schema {
 	schema := &Schema{
-		Type:       "object",
-		Properties: make(map[string]*Schema),
+		Type:        "object",
+		Properties:  make(map[string]*Schema),
+		Description: CommentText(msg.Comments),
 	}
 
 	for _, field := range msg.Fields {
 		fieldSchema := c.fieldToSchema(field)
-		schema.Properties[field.Desc.JSONName()] = fieldSchema
+		name := c.propertyName(field)
+		if isRequired(field) {
+			schema.Required = append(schema.Required, name)
+		}
+		fieldSchema.ReadOnly = hasFieldBehavior(field, annotations.FieldBehavior_OUTPUT_ONLY)
+		fieldSchema.WriteOnly = hasFieldBehavior(field, annotations.FieldBehavior_INPUT_ONLY)
+		schema.Properties[name] = fieldSchema
+	}
 
-		// Add to required if not optional and not a list/map
-		if !field.Desc.HasPresence() && !field.Desc.IsList() && !field.Desc.IsMap() {
-			schema.Required = append(schema.Required, field.Desc.JSONName())
+	var groups []*Schema
+	for _, oneof := range msg.Oneofs {
+		if oneof.Desc.IsSynthetic() {
+			// proto3 optional fields
+			continue
 		}
+		groups = append(groups, c.oneofSchema(oneof))
+	}
+	switch len(groups) {
+	case 0:
+	case 1:
+		schema.OneOf = groups[0].OneOf
+	default:
+		schema.AllOf = groups
 	}
 
 	return schema
 }
 
+// propertyName returns the name of the property of a field.
+func (c *SchemaCollector) propertyName(field *protogen.Field) string {
+	if c.ProtoNames {
+		return string(field.Desc.Name())
+	}
+	return field.Desc.JSONName()
+}
+
+// oneofSchema describes a oneof by one alternative per field of the oneof, requiring that
+// field, and a last alternative for messages setting none of them.
+func (c *SchemaCollector) oneofSchema(oneof *protogen.Oneof) *Schema {
+	var alternatives []*Schema
+	for _, field := range oneof.Fields {
+		alternatives = append(alternatives, &Schema{Required: []string{c.propertyName(field)}})
+	}
+	none := &Schema{Not: &Schema{AnyOf: alternatives}}
+	return &Schema{OneOf: append(append([]*Schema{}, alternatives...), none)}
+}
+
 // fieldToSchema converts a protobuf field to an OpenAPI Schema,
 // recursively collecting nested message types.
 func (c *SchemaCollector) fieldToSchema(field *protogen.Field) *Schema {
@@ -109,6 +155,23 @@ func (c *SchemaCollector) fieldToSchema(field *protogen.Field) *Schema {
 	})
 }
 
+// BodySchema returns the schema of the "*" request body of an endpoint. The fields bound from
+// headers and cookies are left out of the body, the schema of a request holding such fields
+// is the schema of its message without them instead of a reference to it.
+func (c *SchemaCollector) BodySchema(endpoint *parser.Endpoint) *Schema {
+	metadataFields := endpoint.MetadataFields()
+	if len(metadataFields) == 0 {
+		return GetSchema(endpoint.Input())
+	}
+	schema := c.generateMessageSchema(endpoint.Input())
+	for _, metadataField := range metadataFields {
+		name := c.propertyName(metadataField.FieldPath.Leaf())
+		delete(schema.Properties, name)
+		schema.Required = slices.DeleteFunc(schema.Required, func(required string) bool { return required == name })
+	}
+	return schema
+}
+
 // GetSchema returns a Schema reference for a message.
 // If the message is a well-known type, returns the inline schema.
 // Otherwise, returns a $ref to the components/schemas entry.
@@ -0,0 +1,259 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+)
+
+// wireFile returns a proto file with a message exercising the protojson wire format:
+//
+//	enum Status { UNKNOWN = 0; ACTIVE = 1; }
+//	message Wire {
+//	  int64 id = 1;
+//	  uint64 count = 2;
+//	  Status status = 3;
+//	  oneof choice { string name = 4; int32 number = 5; }
+//	  google.protobuf.Struct meta = 6;
+//	  google.protobuf.Duration ttl = 7;
+//	  string display_name = 8;
+//	  string etag = 9 [(google.api.field_behavior) = OUTPUT_ONLY];
+//	  string password = 10 [(google.api.field_behavior) = INPUT_ONLY];
+//	  string parent = 11 [(google.api.field_behavior) = REQUIRED];
+//	}
+//	service WireService { rpc Get(Wire) returns (Wire) { option (google.api.http) = { get: "/v1/wire/{id}" }; } }
+func wireFile(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   typ.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	name := field("name", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")
+	name.OneofIndex = proto.Int32(0)
+	number := field("number", 5, descriptorpb.FieldDescriptorProto_TYPE_INT32, "")
+	number.OneofIndex = proto.Int32(0)
+	behavior := func(f *descriptorpb.FieldDescriptorProto, behavior annotations.FieldBehavior) *descriptorpb.FieldDescriptorProto {
+		f.Options = &descriptorpb.FieldOptions{}
+		proto.SetExtension(f.Options, annotations.E_FieldBehavior, []annotations.FieldBehavior{behavior})
+		return f
+	}
+	methodOptions := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methodOptions, annotations.E_Http, &annotations.HttpRule{
+		Pattern: &annotations.HttpRule_Get{Get: "/v1/wire/{id}"},
+	})
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test/wire.proto"),
+		Package:    proto.String("test.wire"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/api/annotations.proto", "google/api/field_behavior.proto", "google/protobuf/duration.proto", "google/protobuf/struct.proto"},
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/test/wire")},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Status"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("UNKNOWN"), Number: proto.Int32(0)},
+				{Name: proto.String("ACTIVE"), Number: proto.Int32(1)},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Wire"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, ""),
+				field("count", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT64, ""),
+				field("status", 3, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.wire.Status"),
+				name,
+				number,
+				field("meta", 6, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Struct"),
+				field("ttl", 7, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Duration"),
+				field("display_name", 8, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+				behavior(field("etag", 9, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""), annotations.FieldBehavior_OUTPUT_ONLY),
+				behavior(field("password", 10, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""), annotations.FieldBehavior_INPUT_ONLY),
+				behavior(field("parent", 11, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""), annotations.FieldBehavior_REQUIRED),
+			},
+			OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("choice")}},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("WireService"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Get"),
+				InputType:  proto.String(".test.wire.Wire"),
+				OutputType: proto.String(".test.wire.Wire"),
+				Options:    methodOptions,
+			}},
+		}},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("failed to build wire.proto: %v", err)
+	}
+	return fd
+}
+
+// wireDocument generates the document of wire.proto.
+func wireDocument(t *testing.T, gen *Generator) (*Document, protoreflect.MessageDescriptor) {
+	t.Helper()
+	fd := wireFile(t)
+	plugin := newPlugin(t, fd)
+	file := plugin.FilesByPath[fd.Path()]
+	services, err := parser.NewServices(file)
+	if err != nil {
+		t.Fatalf("failed to parse services: %v", err)
+	}
+	return gen.document(file, services), fd.Messages().ByName("Wire")
+}
+
+// checkProtojson checks that every property protojson writes for msg is described by schema
+// with the JSON type protojson writes it as.
+func checkProtojson(t *testing.T, schema *Schema, msg *dynamicpb.Message, options protojson.MarshalOptions) {
+	t.Helper()
+	data, err := options.Marshal(msg)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	var object map[string]any
+	if err := json.Unmarshal(data, &object); err != nil {
+		t.Fatalf("failed to unmarshal %s: %v", data, err)
+	}
+	for name, value := range object {
+		property, ok := schema.Properties[name]
+		if !ok {
+			t.Errorf("protojson wrote %q, which the schema does not describe", name)
+			continue
+		}
+		var typ string
+		switch value.(type) {
+		case string:
+			typ = "string"
+		case float64:
+			typ = "integer"
+		case map[string]any:
+			typ = "object"
+		}
+		if property.Type != typ {
+			t.Errorf("protojson wrote %q as %s %v, the schema describes a %s", name, typ, value, property.Type)
+		}
+		if property.Enum != nil && !contains(property.Enum, value) {
+			t.Errorf("protojson wrote %q as %v, not one of %v", name, value, property.Enum)
+		}
+	}
+}
+
+func contains(values []any, value any) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// newWire returns a Wire message setting every kind of field.
+func newWire(md protoreflect.MessageDescriptor) *dynamicpb.Message {
+	msg := dynamicpb.NewMessage(md)
+	fields := md.Fields()
+	msg.Set(fields.ByName("id"), protoreflect.ValueOfInt64(-9007199254740993))
+	msg.Set(fields.ByName("count"), protoreflect.ValueOfUint64(18446744073709551615))
+	msg.Set(fields.ByName("status"), protoreflect.ValueOfEnum(1))
+	msg.Set(fields.ByName("number"), protoreflect.ValueOfInt32(7))
+	msg.Set(fields.ByName("display_name"), protoreflect.ValueOfString("goose"))
+	return msg
+}
+
+func TestProtojsonSchema(t *testing.T) {
+	doc, md := wireDocument(t, &Generator{})
+	schema := doc.Components.Schemas["test.wire.Wire"]
+	if schema == nil {
+		t.Fatal("expected the schema of test.wire.Wire")
+	}
+	if got := schema.Properties["id"]; got.Type != "string" || got.Format != "int64" || got.Pattern != int64Pattern {
+		t.Errorf("id = %+v, want an int64 string", got)
+	}
+	if got := schema.Properties["count"]; got.Type != "string" || got.Format != "uint64" {
+		t.Errorf("count = %+v, want a uint64 string", got)
+	}
+	if got := schema.Properties["ttl"]; got.Type != "string" || got.Pattern != durationPattern {
+		t.Errorf("ttl = %+v, want a duration string", got)
+	}
+	if got := schema.Properties["meta"]; got.Type != "object" || got.AdditionalProperties == nil {
+		t.Errorf("meta = %+v, want a free-form object", got)
+	}
+	if len(schema.Required) != 1 || schema.Required[0] != "parent" {
+		t.Errorf("required = %v, protojson omits zero values, only REQUIRED fields are required", schema.Required)
+	}
+	if len(schema.OneOf) != 3 || schema.OneOf[0].Required[0] != "name" || schema.OneOf[1].Required[0] != "number" || schema.OneOf[2].Not == nil {
+		t.Errorf("oneOf = %+v, want name, number or none of them", schema.OneOf)
+	}
+	checkProtojson(t, schema, newWire(md), protojson.MarshalOptions{})
+}
+
+func TestProtojsonSchemaProtoNames(t *testing.T) {
+	doc, md := wireDocument(t, &Generator{ProtoNames: true})
+	schema := doc.Components.Schemas["test.wire.Wire"]
+	if _, ok := schema.Properties["display_name"]; !ok {
+		t.Errorf("expected the proto name display_name, got %v", schema.Properties)
+	}
+	checkProtojson(t, schema, newWire(md), protojson.MarshalOptions{UseProtoNames: true})
+}
+
+func TestParameterSchema(t *testing.T) {
+	doc, _ := wireDocument(t, &Generator{})
+	params := make(map[string]*Parameter)
+	for _, param := range doc.Paths["/v1/wire/{id}"].Get.Parameters {
+		params[param.Name] = param
+	}
+	if got := params["id"]; got == nil || got.Schema.Type != "integer" || got.Schema.Format != "int64" {
+		t.Errorf("id = %+v, path parameters are plain integers", got)
+	}
+	if got := params["count"]; got == nil || got.Schema.Type != "integer" {
+		t.Errorf("count = %+v, query parameters are plain integers", got)
+	}
+	status := params["status"]
+	if status == nil || status.Schema.Type != "integer" {
+		t.Fatalf("status = %+v, enums are bound by their numbers", status)
+	}
+	if len(status.Schema.Enum) != 2 || status.Schema.Enum[1] != int32(1) || status.Schema.XEnumVarnames[1] != "ACTIVE" {
+		t.Errorf("status enum = %v %v, want the numbers named by x-enum-varnames", status.Schema.Enum, status.Schema.XEnumVarnames)
+	}
+}
+
+func TestFieldBehaviorSchema(t *testing.T) {
+	doc, _ := wireDocument(t, &Generator{})
+	schema := doc.Components.Schemas["test.wire.Wire"]
+	if !schema.Properties["etag"].ReadOnly || schema.Properties["etag"].WriteOnly {
+		t.Errorf("etag = %+v, want an OUTPUT_ONLY field to be read-only", schema.Properties["etag"])
+	}
+	if !schema.Properties["password"].WriteOnly || schema.Properties["password"].ReadOnly {
+		t.Errorf("password = %+v, want an INPUT_ONLY field to be write-only", schema.Properties["password"])
+	}
+	params := make(map[string]*Parameter)
+	for _, param := range doc.Paths["/v1/wire/{id}"].Get.Parameters {
+		params[param.Name] = param
+	}
+	if params["parent"] == nil || !params["parent"].Required {
+		t.Errorf("parent = %+v, want a REQUIRED field to be a required parameter", params["parent"])
+	}
+	if params["etag"] != nil {
+		t.Error("the server clears OUTPUT_ONLY fields, they are no parameters")
+	}
+	if params["password"] == nil || params["password"].Required {
+		t.Errorf("password = %+v, want an optional parameter", params["password"])
+	}
+}
@@ -0,0 +1,298 @@
+package openapi
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	componentsPrefix  = "#/components/schemas/"
+	definitionsPrefix = "#/definitions/"
+)
+
+// Swagger is the root object of a Swagger 2.0 specification.
+type Swagger struct {
+	Swagger     string                      `json:"swagger"`
+	Info        *Info                       `json:"info"`
+	Tags        []*Tag                      `json:"tags,omitempty"`
+	Consumes    []string                    `json:"consumes,omitempty"`
+	Produces    []string                    `json:"produces,omitempty"`
+	Paths       map[string]*SwaggerPathItem `json:"paths"`
+	Definitions map[string]*Schema          `json:"definitions,omitempty"`
+}
+
+// SwaggerPathItem describes the operations available on a single path.
+type SwaggerPathItem struct {
+	Get     *SwaggerOperation `json:"get,omitempty"`
+	Post    *SwaggerOperation `json:"post,omitempty"`
+	Put     *SwaggerOperation `json:"put,omitempty"`
+	Delete  *SwaggerOperation `json:"delete,omitempty"`
+	Patch   *SwaggerOperation `json:"patch,omitempty"`
+	Head    *SwaggerOperation `json:"head,omitempty"`
+	Options *SwaggerOperation `json:"options,omitempty"`
+}
+
+// SwaggerOperation describes a single API operation on a path.
+type SwaggerOperation struct {
+	Tags        []string                    `json:"tags,omitempty"`
+	OperationID string                      `json:"operationId,omitempty"`
+	Summary     string                      `json:"summary,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Produces    []string                    `json:"produces,omitempty"`
+	Parameters  []*SwaggerParameter         `json:"parameters,omitempty"`
+	Responses   map[string]*SwaggerResponse `json:"responses"`
+	Deprecated  bool                        `json:"deprecated,omitempty"`
+	// XGooseTimeout is the timeout of the goose.method annotation, e.g. 5s.
+	XGooseTimeout string `json:"x-goose-timeout,omitempty"`
+}
+
+// SwaggerParameter describes a single operation parameter. Body parameters are described
+// by Schema, the others by their inline type.
+type SwaggerParameter struct {
+	Name             string   `json:"name"`
+	In               string   `json:"in"`
+	Description      string   `json:"description,omitempty"`
+	Required         bool     `json:"required,omitempty"`
+	Schema           *Schema  `json:"schema,omitempty"`
+	Type             string   `json:"type,omitempty"`
+	Format           string   `json:"format,omitempty"`
+	Pattern          string   `json:"pattern,omitempty"`
+	Enum             []any    `json:"enum,omitempty"`
+	XEnumVarnames    []string `json:"x-enum-varnames,omitempty"`
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum any      `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum any      `json:"exclusiveMaximum,omitempty"`
+	MinLength        *uint64  `json:"minLength,omitempty"`
+	MaxLength        *uint64  `json:"maxLength,omitempty"`
+	MinItems         *uint64  `json:"minItems,omitempty"`
+	MaxItems         *uint64  `json:"maxItems,omitempty"`
+	UniqueItems      bool     `json:"uniqueItems,omitempty"`
+	Items            *Schema  `json:"items,omitempty"`
+	CollectionFormat string   `json:"collectionFormat,omitempty"`
+}
+
+// SwaggerResponse describes a single response from an API operation.
+type SwaggerResponse struct {
+	Description string                    `json:"description"`
+	Schema      *Schema                   `json:"schema,omitempty"`
+	Headers     map[string]*SwaggerHeader `json:"headers,omitempty"`
+}
+
+// SwaggerHeader describes a single header of a response by its inline type.
+type SwaggerHeader struct {
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+}
+
+// toSwagger converts an OpenAPI 3.0 document into a Swagger 2.0 one.
+func toSwagger(doc *Document) *Swagger {
+	swagger := &Swagger{
+		Swagger:  "2.0",
+		Info:     doc.Info,
+		Tags:     doc.Tags,
+		Consumes: []string{"application/json"},
+		Produces: []string{"application/json"},
+		Paths:    make(map[string]*SwaggerPathItem, len(doc.Paths)),
+	}
+	var components map[string]*Schema
+	if doc.Components != nil && len(doc.Components.Schemas) > 0 {
+		components = doc.Components.Schemas
+		swagger.Definitions = make(map[string]*Schema, len(components))
+		for name, schema := range components {
+			swagger.Definitions[name] = swaggerSchema(schema)
+		}
+	}
+	for path, item := range doc.Paths {
+		swaggerItem := &SwaggerPathItem{}
+		eachOperation(item, func(method string, operation *Operation) {
+			setSwaggerOperation(swaggerItem, method, swaggerOperation(operation, components))
+		})
+		swagger.Paths[path] = swaggerItem
+	}
+	return swagger
+}
+
+// swaggerOperation converts an operation, the request body becomes a body parameter.
+func swaggerOperation(operation *Operation, components map[string]*Schema) *SwaggerOperation {
+	swaggerOp := &SwaggerOperation{
+		Tags:          operation.Tags,
+		OperationID:   operation.OperationID,
+		Summary:       operation.Summary,
+		Description:   operation.Description,
+		Responses:     make(map[string]*SwaggerResponse, len(operation.Responses)),
+		Deprecated:    operation.Deprecated,
+		XGooseTimeout: operation.XGooseTimeout,
+	}
+	for _, param := range operation.Parameters {
+		if param.In == "cookie" {
+			// Swagger 2.0 can not describe cookie parameters
+			continue
+		}
+		swaggerOp.Parameters = append(swaggerOp.Parameters, swaggerParameters(param, components)...)
+	}
+	if body := operation.RequestBody; body != nil {
+		for _, mediaType := range body.Content {
+			swaggerOp.Parameters = append(swaggerOp.Parameters, &SwaggerParameter{
+				Name:        "body",
+				In:          "body",
+				Description: body.Description,
+				Required:    body.Required,
+				Schema:      swaggerSchema(mediaType.Schema),
+			})
+			break
+		}
+	}
+	for code, response := range operation.Responses {
+		swaggerResp := &SwaggerResponse{Description: response.Description}
+		for name, header := range response.Headers {
+			if swaggerResp.Headers == nil {
+				swaggerResp.Headers = make(map[string]*SwaggerHeader, len(response.Headers))
+			}
+			swaggerResp.Headers[name] = &SwaggerHeader{Description: header.Description, Type: header.Schema.Type}
+		}
+		for contentType, mediaType := range response.Content {
+			if contentType != "application/json" {
+				swaggerOp.Produces = []string{contentType}
+			}
+			swaggerResp.Schema = swaggerSchema(mediaType.Schema)
+			if swaggerResp.Schema != nil && swaggerResp.Schema.Format == "binary" {
+				swaggerResp.Schema = &Schema{Type: "file"}
+			}
+		}
+		swaggerOp.Responses[code] = swaggerResp
+	}
+	return swaggerOp
+}
+
+// swaggerParameters converts a path or query parameter. Swagger 2.0 parameters can not be
+// objects, the fields of a deepObject message parameter become one parameter per scalar field
+// named by their dotted path, e.g. filter.status.
+func swaggerParameters(param *Parameter, components map[string]*Schema) []*SwaggerParameter {
+	return flattenParameter(param.Name, param, param.Schema, components, map[string]bool{})
+}
+
+func flattenParameter(name string, param *Parameter, schema *Schema, components map[string]*Schema, visited map[string]bool) []*SwaggerParameter {
+	if schema == nil {
+		return nil
+	}
+	message := schema
+	if schema.Ref != "" {
+		ref := strings.TrimPrefix(schema.Ref, componentsPrefix)
+		var ok bool
+		message, ok = components[ref]
+		if !ok || visited[ref] {
+			return nil
+		}
+		visited[ref] = true
+		defer delete(visited, ref)
+	}
+	if schema.Ref != "" || (schema.Type == "object" && schema.Properties != nil) {
+		// the query objects of nested messages are inline
+		names := make([]string, 0, len(message.Properties))
+		for property := range message.Properties {
+			names = append(names, property)
+		}
+		sort.Strings(names)
+		var params []*SwaggerParameter
+		for _, property := range names {
+			params = append(params, flattenParameter(name+"."+property, param, message.Properties[property], components, visited)...)
+		}
+		return params
+	}
+	swaggerParam := &SwaggerParameter{
+		Name:             name,
+		In:               param.In,
+		Description:      schema.Description,
+		Required:         param.Required,
+		Type:             schema.Type,
+		Format:           schema.Format,
+		Pattern:          schema.Pattern,
+		Enum:             schema.Enum,
+		XEnumVarnames:    schema.XEnumVarnames,
+		Minimum:          schema.Minimum,
+		Maximum:          schema.Maximum,
+		ExclusiveMinimum: schema.ExclusiveMinimum,
+		ExclusiveMaximum: schema.ExclusiveMaximum,
+		MinLength:        schema.MinLength,
+		MaxLength:        schema.MaxLength,
+		MinItems:         schema.MinItems,
+		MaxItems:         schema.MaxItems,
+		UniqueItems:      schema.UniqueItems,
+	}
+	if schema.Type == "string" && (schema.Format == "int64" || schema.Format == "uint64") {
+		// nested fields are described by their protojson schema, parameters are plain integers
+		swaggerParam.Type, swaggerParam.Format, swaggerParam.Pattern = "integer", "int64", ""
+		swaggerParam.Enum = append([]any(nil), schema.Enum...)
+		toNumbers(swaggerParam.Enum)
+		bounds := &Schema{XMinimum: schema.XMinimum, XMaximum: schema.XMaximum}
+		toNumberBounds(bounds)
+		swaggerParam.Minimum, swaggerParam.ExclusiveMinimum = bounds.Minimum, bounds.ExclusiveMinimum
+		swaggerParam.Maximum, swaggerParam.ExclusiveMaximum = bounds.Maximum, bounds.ExclusiveMaximum
+	}
+	if name == param.Name && param.Description != "" {
+		swaggerParam.Description = param.Description
+	}
+	switch {
+	case schema.Type == "array":
+		if schema.Items == nil || schema.Items.Ref != "" {
+			// repeated messages can not be sent as parameters
+			return nil
+		}
+		swaggerParam.Items = swaggerSchema(schema.Items)
+		swaggerParam.CollectionFormat = "multi"
+	case schema.AdditionalProperties != nil:
+		// map entries are keyed by the map key, which Swagger 2.0 can not describe
+		swaggerParam.Type = "string"
+	case schema.Type == "object" || schema.Type == "":
+		return nil
+	}
+	return []*SwaggerParameter{swaggerParam}
+}
+
+// swaggerSchema returns a copy of schema referencing definitions instead of components,
+// nullable is written as the x-nullable extension.
+func swaggerSchema(schema *Schema) *Schema {
+	if schema == nil {
+		return nil
+	}
+	converted := *schema
+	if schema.Ref != "" {
+		converted.Ref = definitionsPrefix + strings.TrimPrefix(schema.Ref, componentsPrefix)
+	}
+	converted.XNullable, converted.Nullable = schema.Nullable, false
+	// Swagger 2.0 has readOnly but no writeOnly
+	converted.WriteOnly = false
+	// Swagger 2.0 has no oneOf, anyOf and not, allOf only holds the oneof groups
+	converted.OneOf, converted.AnyOf, converted.AllOf, converted.Not = nil, nil, nil, nil
+	converted.Items = swaggerSchema(schema.Items)
+	converted.AdditionalProperties = swaggerSchema(schema.AdditionalProperties)
+	if schema.Properties != nil {
+		converted.Properties = make(map[string]*Schema, len(schema.Properties))
+		for name, property := range schema.Properties {
+			converted.Properties[name] = swaggerSchema(property)
+		}
+	}
+	return &converted
+}
+
+// setSwaggerOperation sets the operation on the path item based on HTTP method.
+func setSwaggerOperation(item *SwaggerPathItem, method string, operation *SwaggerOperation) {
+	switch method {
+	case http.MethodGet:
+		item.Get = operation
+	case http.MethodPost:
+		item.Post = operation
+	case http.MethodPut:
+		item.Put = operation
+	case http.MethodDelete:
+		item.Delete = operation
+	case http.MethodPatch:
+		item.Patch = operation
+	case http.MethodHead:
+		item.Head = operation
+	case http.MethodOptions:
+		item.Options = operation
+	}
+}
@@ -1,9 +1,10 @@
 package openapi
 
-// Document is the root object of an OpenAPI 3.0 specification.
+// Document is the root object of an OpenAPI 3.0 or 3.1 specification.
 type Document struct {
 	OpenAPI    string               `json:"openapi"`
 	Info       *Info                `json:"info"`
+	Tags       []*Tag               `json:"tags,omitempty"`
 	Paths      map[string]*PathItem `json:"paths,omitempty"`
 	Components *Components          `json:"components,omitempty"`
 }
@@ -15,6 +16,12 @@ type Info struct {
 	Description string `json:"description,omitempty"`
 }
 
+// Tag groups the operations of a service.
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
 // PathItem describes the operations available on a single path.
 type PathItem struct {
 	Get     *Operation `json:"get,omitempty"`
@@ -29,12 +36,16 @@ type PathItem struct {
 
 // Operation describes a single API operation on a path.
 type Operation struct {
+	Tags        []string             `json:"tags,omitempty"`
 	OperationID string               `json:"operationId,omitempty"`
 	Summary     string               `json:"summary,omitempty"`
 	Description string               `json:"description,omitempty"`
 	Parameters  []*Parameter         `json:"parameters,omitempty"`
 	RequestBody *RequestBody         `json:"requestBody,omitempty"`
 	Responses   map[string]*Response `json:"responses"`
+	Deprecated  bool                 `json:"deprecated,omitempty"`
+	// XGooseTimeout is the timeout of the goose.method annotation, e.g. 5s.
+	XGooseTimeout string `json:"x-goose-timeout,omitempty"`
 }
 
 // Parameter describes a single operation parameter.
@@ -43,6 +54,8 @@ type Parameter struct {
 	In          string  `json:"in"`
 	Description string  `json:"description,omitempty"`
 	Required    bool    `json:"required,omitempty"`
+	Style       string  `json:"style,omitempty"`
+	Explode     *bool   `json:"explode,omitempty"`
 	Schema      *Schema `json:"schema,omitempty"`
 }
 
@@ -56,9 +69,16 @@ type RequestBody struct {
 // Response describes a single response from an API operation.
 type Response struct {
 	Description string                `json:"description"`
+	Headers     map[string]*Header    `json:"headers,omitempty"`
 	Content     map[string]*MediaType `json:"content,omitempty"`
 }
 
+// Header describes a single header of a response.
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
 // MediaType provides schema and examples for a media type.
 type MediaType struct {
 	Schema *Schema `json:"schema,omitempty"`
@@ -68,14 +88,50 @@ type MediaType struct {
 type Schema struct {
 	Type                 string            `json:"type,omitempty"`
 	Format               string            `json:"format,omitempty"`
+	Pattern              string            `json:"pattern,omitempty"`
 	Properties           map[string]*Schema `json:"properties,omitempty"`
 	Items                *Schema           `json:"items,omitempty"`
 	Required             []string          `json:"required,omitempty"`
 	Ref                  string            `json:"$ref,omitempty"`
-	Enum                 []string          `json:"enum,omitempty"`
+	Enum                 []any             `json:"enum,omitempty"`
 	Nullable             bool              `json:"nullable,omitempty"`
+	Minimum              *float64          `json:"minimum,omitempty"`
+	Maximum              *float64          `json:"maximum,omitempty"`
+	// ExclusiveMinimum and ExclusiveMaximum are true when the bounds are exclusive,
+	// JSON Schema replaces them by the bounds themselves.
+	ExclusiveMinimum     any               `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     any               `json:"exclusiveMaximum,omitempty"`
+	MinLength            *uint64           `json:"minLength,omitempty"`
+	MaxLength            *uint64           `json:"maxLength,omitempty"`
+	MinItems             *uint64           `json:"minItems,omitempty"`
+	MaxItems             *uint64           `json:"maxItems,omitempty"`
+	UniqueItems          bool              `json:"uniqueItems,omitempty"`
+	MinProperties        *uint64           `json:"minProperties,omitempty"`
+	MaxProperties        *uint64           `json:"maxProperties,omitempty"`
 	AdditionalProperties *Schema           `json:"additionalProperties,omitempty"`
 	Description          string            `json:"description,omitempty"`
+	ReadOnly             bool              `json:"readOnly,omitempty"`
+	WriteOnly            bool              `json:"writeOnly,omitempty"`
+	OneOf                []*Schema         `json:"oneOf,omitempty"`
+	AnyOf                []*Schema         `json:"anyOf,omitempty"`
+	AllOf                []*Schema         `json:"allOf,omitempty"`
+	Not                  *Schema           `json:"not,omitempty"`
+	// XMinimum and XMaximum are the bounds of the 64-bit integers protojson writes as
+	// strings, minimum and maximum only apply to numbers.
+	XMinimum             *Bound            `json:"x-goose-minimum,omitempty"`
+	XMaximum             *Bound            `json:"x-goose-maximum,omitempty"`
+	// XEnumVarnames are the names of the values of an enum described by its numbers.
+	XEnumVarnames        []string          `json:"x-enum-varnames,omitempty"`
+	// XNullable is the Swagger 2.0 extension standing for Nullable.
+	XNullable            bool              `json:"x-nullable,omitempty"`
+	// Types replaces Type when set, OpenAPI 3.1 writes nullable types as a list of types.
+	Types                []string          `json:"-"`
+}
+
+// Bound is a bound of a 64-bit integer written as a string.
+type Bound struct {
+	Value     string `json:"value"`
+	Exclusive bool   `json:"exclusive,omitempty"`
 }
 
 // Components holds a set of reusable objects for different aspects of the API.
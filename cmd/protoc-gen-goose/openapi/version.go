@@ -0,0 +1,139 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Versions of the generated documents.
+const (
+	// Version30 writes OpenAPI 3.0.3 documents.
+	Version30 = "3.0"
+	// Version31 writes OpenAPI 3.1.0 documents, schemas follow JSON Schema 2020-12.
+	Version31 = "3.1"
+	// Version20 writes Swagger 2.0 documents.
+	Version20 = "2.0"
+)
+
+// ParseVersion returns the version of the documents selected by the openapi_version parameter.
+// An empty value selects OpenAPI 3.0.
+func ParseVersion(value string) (string, error) {
+	switch value {
+	case "", "3", "3.0", "3.0.3":
+		return Version30, nil
+	case "3.1", "3.1.0":
+		return Version31, nil
+	case "2", "2.0", "swagger":
+		return Version20, nil
+	}
+	return "", fmt.Errorf("openapi: unsupported openapi_version %q, expected 3.0, 3.1 or 2.0", value)
+}
+
+// MarshalJSON writes Types as the type of the schema when set.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	type schema Schema
+	if len(s.Types) == 0 {
+		return json.Marshal((*schema)(s))
+	}
+	return json.Marshal(struct {
+		Type []string `json:"type"`
+		*schema
+	}{Type: s.Types, schema: (*schema)(s)})
+}
+
+// toV31 turns an OpenAPI 3.0 document into an OpenAPI 3.1 one, nullable schemas become
+// schemas whose types include "null" and exclusive bounds become numbers.
+func toV31(doc *Document) {
+	doc.OpenAPI = "3.1.0"
+	walkDocument(doc, jsonSchemaKeywords)
+}
+
+// ToJSONSchema turns an OpenAPI 3.0 schema and its nested schemas into JSON Schema ones,
+// nullable schemas become schemas whose types include "null" and exclusive bounds numbers.
+func ToJSONSchema(schema *Schema) {
+	walkSchema(schema, jsonSchemaKeywords)
+}
+
+func jsonSchemaKeywords(schema *Schema) {
+	// exclusive bounds are numbers in JSON Schema
+	if schema.ExclusiveMinimum == true {
+		schema.ExclusiveMinimum, schema.Minimum = *schema.Minimum, nil
+	}
+	if schema.ExclusiveMaximum == true {
+		schema.ExclusiveMaximum, schema.Maximum = *schema.Maximum, nil
+	}
+	if !schema.Nullable {
+		return
+	}
+	schema.Nullable = false
+	if schema.Type != "" {
+		schema.Types = []string{schema.Type, "null"}
+	}
+}
+
+// walkDocument calls fn for every schema of doc, nested schemas included.
+func walkDocument(doc *Document, fn func(*Schema)) {
+	for _, item := range doc.Paths {
+		eachOperation(item, func(_ string, operation *Operation) {
+			for _, param := range operation.Parameters {
+				walkSchema(param.Schema, fn)
+			}
+			if operation.RequestBody != nil {
+				for _, mediaType := range operation.RequestBody.Content {
+					walkSchema(mediaType.Schema, fn)
+				}
+			}
+			for _, response := range operation.Responses {
+				for _, mediaType := range response.Content {
+					walkSchema(mediaType.Schema, fn)
+				}
+			}
+		})
+	}
+	if doc.Components != nil {
+		for _, schema := range doc.Components.Schemas {
+			walkSchema(schema, fn)
+		}
+	}
+}
+
+// walkSchema calls fn for schema and its nested schemas.
+func walkSchema(schema *Schema, fn func(*Schema)) {
+	if schema == nil {
+		return
+	}
+	fn(schema)
+	for _, property := range schema.Properties {
+		walkSchema(property, fn)
+	}
+	walkSchema(schema.Items, fn)
+	walkSchema(schema.AdditionalProperties, fn)
+	for _, nested := range [][]*Schema{schema.OneOf, schema.AnyOf, schema.AllOf} {
+		for _, n := range nested {
+			walkSchema(n, fn)
+		}
+	}
+	walkSchema(schema.Not, fn)
+}
+
+// eachOperation calls fn for every operation of item with its HTTP method.
+func eachOperation(item *PathItem, fn func(method string, operation *Operation)) {
+	for _, op := range []struct {
+		method    string
+		operation *Operation
+	}{
+		{http.MethodGet, item.Get},
+		{http.MethodPost, item.Post},
+		{http.MethodPut, item.Put},
+		{http.MethodDelete, item.Delete},
+		{http.MethodPatch, item.Patch},
+		{http.MethodHead, item.Head},
+		{http.MethodOptions, item.Options},
+		{http.MethodTrace, item.Trace},
+	} {
+		if op.operation != nil {
+			fn(op.method, op.operation)
+		}
+	}
+}
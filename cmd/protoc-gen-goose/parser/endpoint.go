@@ -17,6 +17,9 @@ type Endpoint struct {
 	protoMethod *protogen.Method
 	httpRule    *annotations.HttpRule
 	pattern     *Pattern
+	// bindingIndex is 0 for the primary http rule and n for the n-th additional binding.
+	bindingIndex       int
+	additionalBindings []*Endpoint
 }
 
 func (e *Endpoint) Name() string {
@@ -32,9 +35,50 @@ func (e *Endpoint) FullName() string {
 }
 
 func (e *Endpoint) DescName() string {
+	if e.bindingIndex > 0 {
+		return fmt.Sprintf("_%s_%s_%d_Desc", strconvx.GoSanitized(string(e.protoMethod.Parent.Desc.FullName())), e.protoMethod.Desc.Name(), e.bindingIndex)
+	}
 	return fmt.Sprintf("_%s_%s_Desc", strconvx.GoSanitized(string(e.protoMethod.Parent.Desc.FullName())), e.protoMethod.Desc.Name())
 }
 
+// BindingName returns the name of the generated handler, decoder and encoder methods of this binding.
+// The primary binding uses the method name, additional bindings are suffixed with their index.
+func (e *Endpoint) BindingName() string {
+	if e.bindingIndex > 0 {
+		return fmt.Sprintf("%s_%d", e.Name(), e.bindingIndex)
+	}
+	return e.Name()
+}
+
+func (e *Endpoint) IsAdditionalBinding() bool {
+	return e.bindingIndex > 0
+}
+
+func (e *Endpoint) AdditionalBindings() []*Endpoint {
+	return e.additionalBindings
+}
+
+// SetAdditionalBindings creates an endpoint for every additional binding of the http rule.
+func (e *Endpoint) SetAdditionalBindings() error {
+	for i, httpRule := range e.httpRule.GetAdditionalBindings() {
+		if len(httpRule.GetAdditionalBindings()) > 0 {
+			return fmt.Errorf("%s, additional_bindings must not contain additional_bindings", e.FullName())
+		}
+		binding := &Endpoint{
+			protoMethod:  e.protoMethod,
+			httpRule:     httpRule,
+			bindingIndex: i + 1,
+		}
+		pattern, err := ParsePattern(binding.Path())
+		if err != nil {
+			return err
+		}
+		binding.SetPattern(pattern)
+		e.additionalBindings = append(e.additionalBindings, binding)
+	}
+	return nil
+}
+
 func (e *Endpoint) IsStreaming() bool {
 	return e.protoMethod.Desc.IsStreamingServer() || e.protoMethod.Desc.IsStreamingClient()
 }
@@ -67,10 +111,14 @@ func (e *Endpoint) OutputGoIdent() protogen.GoIdent {
 	return e.Output().GoIdent
 }
 
-func (e *Endpoint) ParseParameters() (*protogen.Message, *protogen.Field, []*protogen.Field, []*protogen.Field, error) {
+// ParseParameters splits the request message into the body, path and query parameters.
+// Body and path parameters may select nested fields with dotted paths such as "item.id",
+// query parameters bind the remaining fields, including fields of nested messages, except
+// the fields bound from headers and cookies.
+func (e *Endpoint) ParseParameters() (*protogen.Message, FieldPath, []FieldPath, []FieldPath, error) {
 	// body arguments
 	var bodyMessage *protogen.Message
-	var bodyField *protogen.Field
+	var bodyField FieldPath
 	bodyParameter := e.Body()
 	switch bodyParameter {
 	case "":
@@ -78,19 +126,23 @@ func (e *Endpoint) ParseParameters() (*protogen.Message, *protogen.Field, []*pro
 	case "*":
 		bodyMessage = e.Input()
 	default:
-		bodyField = FindField(bodyParameter, e.Input())
+		bodyField = FindFieldPath(bodyParameter, e.Input())
 		if bodyField == nil {
 			return nil, nil, nil, nil, fmt.Errorf("%s, failed to find body field %s", e.FullName(), bodyParameter)
 		}
 	}
 
-	var pathFields []*protogen.Field
+	var pathFields []FieldPath
 	pathParameters, _ := e.PathParameters()
-	for _, pathParameter := range pathParameters {
-		field := FindField(pathParameter, e.Input())
-		if field == nil {
+	for i, pathParameter := range pathParameters {
+		fieldPath := FindFieldPath(pathParameter, e.Input())
+		if fieldPath == nil {
 			return nil, nil, nil, nil, fmt.Errorf("%s, failed to find path field %s", e.FullName(), pathParameter)
 		}
+		field := fieldPath.Leaf()
+		if !e.pattern.variables[i].IsWildcard() && !isStringField(field) {
+			return nil, nil, nil, nil, fmt.Errorf("%s, path field %s with template %s must be a string", e.FullName(), pathParameter, e.pattern.variables[i].Template())
+		}
 		if field.Desc.IsList() || field.Desc.IsMap() {
 			return nil, nil, nil, nil, fmt.Errorf("%s, path parameters do not support list or map", e.FullName())
 		}
@@ -116,6 +168,9 @@ func (e *Endpoint) ParseParameters() (*protogen.Message, *protogen.Field, []*pro
 			case "google.protobuf.UInt32Value":
 			case "google.protobuf.BoolValue":
 			case "google.protobuf.StringValue":
+			case "google.protobuf.Timestamp":
+			case "google.protobuf.Duration":
+			case "google.protobuf.FieldMask":
 			default:
 				return nil, nil, nil, nil, fmt.Errorf("%s, path parameters do not support %s", e.FullName(), message.Desc.FullName())
 			}
@@ -123,21 +178,48 @@ func (e *Endpoint) ParseParameters() (*protogen.Message, *protogen.Field, []*pro
 			return nil, nil, nil, nil, fmt.Errorf("%s, path parameters do not support %s", e.FullName(), field.Desc.Kind())
 		}
 
-		pathFields = append(pathFields, field)
+		pathFields = append(pathFields, fieldPath)
+	}
+
+	// header and cookie fields are bound by HeaderFields and CookieFields
+	var metadataFields []FieldPath
+	for _, metadataField := range append(e.HeaderFields(), e.CookieFields()...) {
+		fieldPath := metadataField.FieldPath
+		if slices.ContainsFunc(pathFields, func(pathField FieldPath) bool { return pathField.HasPrefix(fieldPath) }) {
+			return nil, nil, nil, nil, fmt.Errorf("%s, field %s of goose.field %s is also bound from the path", e.FullName(), fieldPath.Name(), metadataField.Name)
+		}
+		if bodyField.HasPrefix(fieldPath) {
+			return nil, nil, nil, nil, fmt.Errorf("%s, field %s of goose.field %s is also bound from the body", e.FullName(), fieldPath.Name(), metadataField.Name)
+		}
+		metadataFields = append(metadataFields, fieldPath)
 	}
 
-	var queryFields []*protogen.Field
+	var queryFields []FieldPath
 	if bodyMessage != nil {
 		return bodyMessage, bodyField, pathFields, queryFields, nil
 	}
-	for _, field := range e.Input().Fields {
-		if field == bodyField {
-			continue
-		}
-		if slices.Contains(pathFields, field) {
+	bound := append(slices.Clone(pathFields), metadataFields...)
+	if bodyField != nil {
+		bound = append(bound, bodyField)
+	}
+	queryFields = collectQueryFields(e.Input(), nil, bound)
+	return bodyMessage, bodyField, pathFields, queryFields, nil
+}
+
+// collectQueryFields collects the fields bound from the query string. Fields of nested messages
+// are collected recursively and bound with dotted keys such as "filter.owner.id", fields under
+// a path or body field are skipped.
+func collectQueryFields(message *protogen.Message, parents FieldPath, bound []FieldPath) []FieldPath {
+	var queryFields []FieldPath
+	for _, field := range message.Fields {
+		fieldPath := append(slices.Clone(parents), field)
+		if slices.ContainsFunc(bound, func(boundField FieldPath) bool { return fieldPath.HasPrefix(boundField) }) {
 			continue
 		}
 		if field.Desc.IsMap() {
+			if isQueryMapField(field) {
+				queryFields = append(queryFields, fieldPath)
+			}
 			continue
 		}
 		switch field.Desc.Kind() {
@@ -161,27 +243,74 @@ func (e *Endpoint) ParseParameters() (*protogen.Message, *protogen.Field, []*pro
 			case "google.protobuf.UInt32Value":
 			case "google.protobuf.BoolValue":
 			case "google.protobuf.StringValue":
+			case "google.protobuf.Timestamp":
+			case "google.protobuf.Duration":
+			case "google.protobuf.FieldMask":
 			default:
+				if isNestedQueryMessage(field, fieldPath) {
+					queryFields = append(queryFields, collectQueryFields(message, fieldPath, bound)...)
+				}
 				continue
 			}
 		default:
 			continue
 		}
-		queryFields = append(queryFields, field)
+		queryFields = append(queryFields, fieldPath)
 	}
-	return bodyMessage, bodyField, pathFields, queryFields, nil
+	return queryFields
 }
 
-func (e *Endpoint) PathParameters() ([]string, error) {
-	values := make([]string, 0, len(e.pattern.segments))
-	for _, segment := range e.pattern.segments {
-		if segment.wild {
-			values = append(values, segment.s)
+// isQueryMapField reports whether a map field can be bound from the query string,
+// entries are keyed as "labels.env" and the values must be scalars or enums.
+func isQueryMapField(field *protogen.Field) bool {
+	switch field.Message.Fields[1].Desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind, protoreflect.BytesKind:
+		return false
+	}
+	return true
+}
+
+// isNestedQueryMessage reports whether the fields of a message field can be bound from the query string.
+// Repeated, oneof, well-known and recursive messages are not supported.
+func isNestedQueryMessage(field *protogen.Field, fieldPath FieldPath) bool {
+	if field.Desc.IsList() {
+		return false
+	}
+	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+		return false
+	}
+	if field.Message.Desc.ParentFile().Package() == "google.protobuf" {
+		return false
+	}
+	if field.Message == field.Parent {
+		return false
+	}
+	for _, parent := range fieldPath.Parents() {
+		if parent.Message == field.Message || parent.Parent == field.Message {
+			return false
 		}
 	}
+	return true
+}
+
+func (e *Endpoint) PathParameters() ([]string, error) {
+	values := make([]string, 0, len(e.pattern.variables))
+	for _, variable := range e.pattern.variables {
+		values = append(values, variable.name)
+	}
 	return values, nil
 }
 
+// PathVariables returns the path variables, in the same order as the path fields of ParseParameters.
+func (e *Endpoint) PathVariables() []*Variable {
+	return e.pattern.Variables()
+}
+
+// RoutePath returns the path registered on the router, see Pattern.MuxPath.
+func (e *Endpoint) RoutePath() string {
+	return e.pattern.MuxPath()
+}
+
 func (e *Endpoint) SetPattern(pattern *Pattern) {
 	e.pattern = pattern
 }
@@ -252,3 +381,18 @@ func (e *Endpoint) Body() string {
 func (e *Endpoint) ResponseBody() string {
 	return e.httpRule.GetResponseBody()
 }
+
+func isStringField(field *protogen.Field) bool {
+	if field.Desc.IsList() || field.Desc.IsMap() {
+		return false
+	}
+	if field.Desc.Kind() == protoreflect.StringKind {
+		return true
+	}
+	return field.Message != nil && field.Message.Desc.FullName() == "google.protobuf.StringValue"
+}
+
+// Comments returns the comments of the method.
+func (e *Endpoint) Comments() protogen.CommentSet {
+	return e.protoMethod.Comments
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"slices"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
@@ -19,6 +20,80 @@ func FindField(name string, inMessage *protogen.Message) *protogen.Field {
 	return nil
 }
 
+// FieldPath is the chain of fields selected by a dotted field path such as "item.id",
+// starting with a field of the request message and ending with the bound field.
+type FieldPath []*protogen.Field
+
+// FindFieldPath resolves a dotted field path through nested messages.
+// Every field but the last one must be a singular message field outside a oneof.
+// It returns nil if the path can not be resolved.
+func FindFieldPath(path string, inMessage *protogen.Message) FieldPath {
+	var fieldPath FieldPath
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		field := FindField(name, inMessage)
+		if field == nil {
+			return nil
+		}
+		fieldPath = append(fieldPath, field)
+		if i == len(names)-1 {
+			break
+		}
+		if field.Message == nil || field.Desc.IsList() || field.Desc.IsMap() {
+			return nil
+		}
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			return nil
+		}
+		inMessage = field.Message
+	}
+	return fieldPath
+}
+
+// Leaf returns the bound field, the last field of the path.
+func (p FieldPath) Leaf() *protogen.Field {
+	if len(p) <= 0 {
+		return nil
+	}
+	return p[len(p)-1]
+}
+
+// Parents returns the message fields leading to the bound field.
+func (p FieldPath) Parents() FieldPath {
+	if len(p) <= 0 {
+		return nil
+	}
+	return p[:len(p)-1]
+}
+
+// Name returns the proto names of the path joined with ".", e.g. "item.id".
+func (p FieldPath) Name() string {
+	names := make([]string, 0, len(p))
+	for _, field := range p {
+		names = append(names, string(field.Desc.Name()))
+	}
+	return strings.Join(names, ".")
+}
+
+// GoName returns the go field selector of the path, e.g. "Item.Id".
+func (p FieldPath) GoName() string {
+	return FullFieldName(p)
+}
+
+// Getter returns the getter chain of the path, e.g. "GetItem().GetId()", it is safe to call on nil messages.
+func (p FieldPath) Getter() string {
+	getters := make([]string, 0, len(p))
+	for _, field := range p {
+		getters = append(getters, "Get"+field.GoName+"()")
+	}
+	return strings.Join(getters, ".")
+}
+
+// HasPrefix reports whether the path starts with the fields of prefix.
+func (p FieldPath) HasPrefix(prefix FieldPath) bool {
+	return len(prefix) > 0 && len(p) >= len(prefix) && slices.Equal(p[:len(prefix)], prefix)
+}
+
 func FullFieldName(fields []*protogen.Field) string {
 	var fieldNames []string
 	for _, p := range fields {
@@ -69,7 +144,7 @@ func FieldGoType(g *protogen.GeneratedFile, field *protogen.Field) (goType []any
 	case field.Desc.IsMap():
 		keyType, _ := FieldGoType(g, field.Message.Fields[0])
 		valType, _ := FieldGoType(g, field.Message.Fields[1])
-		return []any{"map[", keyType, "]", valType}, false
+		return append(append(append([]any{"map["}, keyType...), "]"), valType...), false
 	}
 	return goType, pointer
 }
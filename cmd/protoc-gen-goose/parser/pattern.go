@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"regexp"
 	"strings"
 	"unicode"
 
@@ -29,10 +30,112 @@ type Pattern struct {
 	// by a literal segment "/".
 	segments []Segment
 	loc      string // source location of registering call, for helpful messages
+	// muxPath is the path registered on the router, wildcards of nested fields such as
+	// "{item.id}" are renamed to valid ServeMux wildcard names such as "{item_id}" and
+	// templates such as "{name=shelves/*}" are expanded to "shelves/{name_1}".
+	muxPath string
+	// variables are the path variables in order, each of them binds a request field.
+	variables []*Variable
+	// verb is the custom verb following the last variable, e.g. "publish" for
+	// "/v1/{name=shelves/*}:publish". The router can not match it, the generated
+	// handler checks and trims it off the last wildcard.
+	verb string
 }
 
 func (p *Pattern) String() string { return p.str }
 
+// MuxPath returns the path in net/http ServeMux syntax.
+func (p *Pattern) MuxPath() string { return p.muxPath }
+
+// Variables returns the path variables in order.
+func (p *Pattern) Variables() []*Variable { return p.variables }
+
+// Verb returns the custom verb following the last variable, if any.
+func (p *Pattern) Verb() string { return p.verb }
+
+// VerbWildcard returns the name of the wildcard the custom verb is appended to.
+func (p *Pattern) VerbWildcard() string {
+	if p.verb == "" || !p.lastSegment().wild {
+		return ""
+	}
+	return p.lastSegment().s
+}
+
+// Template returns the path with every variable written as "{name}" or "{name...}",
+// followed by the custom verb. It is used to build request urls, see goose.URLPath.
+func (p *Pattern) Template() string {
+	var b strings.Builder
+	b.WriteString(p.host)
+	vars := 0
+	for i := 0; i < len(p.segments); i++ {
+		b.WriteByte('/')
+		if vars < len(p.variables) && p.variables[vars].index == i {
+			variable := p.variables[vars]
+			b.WriteString("{" + variable.name)
+			if variable.template == "**" {
+				b.WriteString("...")
+			}
+			b.WriteString("}")
+			i += len(variable.segments) - 1
+			vars++
+			continue
+		}
+		b.WriteString(p.segments[i].muxString())
+	}
+	if p.verb != "" {
+		b.WriteString(":" + p.verb)
+	}
+	return b.String()
+}
+
+// A Variable is a path variable bound to a request field. Its template is matched
+// by one or more segments of the pattern.
+//
+//	"{id}" => Variable{name: "id", template: "*"}, segments {id}
+//	"{path...}" => Variable{name: "path", template: "**"}, segments {path...}
+//	"{name=shelves/*/books/*}" => Variable{name: "name", template: "shelves/*/books/*"},
+//	segments shelves/{name_1}/books/{name_2}
+type Variable struct {
+	name     string // dotted field path
+	template string
+	index    int // index of the first segment
+	segments []Segment
+}
+
+// Name returns the dotted field path bound by the variable.
+func (v *Variable) Name() string { return v.name }
+
+// Template returns the segment template of the variable, "*" for "{id}" and "**" for "{path...}".
+func (v *Variable) Template() string { return v.template }
+
+// Segments returns the segments of the pattern matched by the variable.
+func (v *Variable) Segments() []Segment { return v.segments }
+
+// IsWildcard reports whether the variable matches a single wildcard, so that
+// its value is the wildcard value without reconstruction.
+func (v *Variable) IsWildcard() bool { return v.template == "*" || v.template == "**" }
+
+// Regexp returns a regular expression matching the values of the variable.
+func (v *Variable) Regexp() string {
+	var b strings.Builder
+	b.WriteString("^")
+	for i, part := range strings.Split(v.template, "/") {
+		if i > 0 {
+			b.WriteString("/")
+		}
+		switch part {
+		case "*":
+			b.WriteString("[^/]+")
+		case "**":
+			b.WriteString(".+")
+		default:
+			b.WriteString(regexp.QuoteMeta(part))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
 func (p *Pattern) lastSegment() Segment {
 	return p.segments[len(p.segments)-1]
 }
@@ -61,6 +164,30 @@ type Segment struct {
 	multi bool // "..." wildcard
 }
 
+// Value returns the literal or the wildcard name of the segment.
+func (s Segment) Value() string { return s.s }
+
+// IsWild reports whether the segment is a wildcard.
+func (s Segment) IsWild() bool { return s.wild }
+
+// IsMulti reports whether the segment is a "..." wildcard matching all remaining segments.
+func (s Segment) IsMulti() bool { return s.multi }
+
+func (s Segment) muxString() string {
+	switch {
+	case s.wild && s.multi && s.s == "":
+		return ""
+	case s.wild && s.multi:
+		return "{" + s.s + "...}"
+	case s.wild:
+		return "{" + s.s + "}"
+	case s.s == "/":
+		return "{$}"
+	default:
+		return s.s
+	}
+}
+
 // parsePattern parses a string into a Pattern.
 // The string's syntax is
 //
@@ -71,11 +198,15 @@ type Segment struct {
 //   - HOST is a hostname
 //   - PATH consists of slash-separated segments, where each segment is either
 //     a literal or a wildcard of the form "{name}", "{name...}", or "{$}".
+//     A wildcard name may be a dotted field path such as "{item.id}".
+//     Following google.api.http, a wildcard may also carry a segment template
+//     "{name=shelves/*/books/*}" of literals, "*" and a final "**", and the
+//     last wildcard may be followed by a custom verb such as ":publish".
 //
 // METHOD, HOST and PATH are all optional; that is, the string can be "/".
 // If METHOD is present, it must be followed by a single space.
-// Wildcard names must be valid Go identifiers.
-// The "{$}" and "{name...}" wildcard must occur at the end of PATH.
+// Wildcard names must be valid Go identifiers or dotted paths of them.
+// The "{$}", "{name...}" and "**" wildcards must occur at the end of PATH.
 // PATH may end with a '/'.
 // Wildcard names in a path must be distinct.
 func ParsePattern(s string) (_ *Pattern, err error) {
@@ -121,62 +252,169 @@ func ParsePattern(s string) (_ *Pattern, err error) {
 		return nil, errors.New("non-CONNECT pattern with unclean path can never match")
 	}
 
+	segs, verb, err := splitPath(rest)
+	if err != nil {
+		return nil, err
+	}
 	seenNames := map[string]bool{} // remember wildcard names to catch dups
-	for len(rest) > 0 {
-		// Invariant: rest[0] == '/'.
-		rest = rest[1:]
-		off = len(s) - len(rest)
-		if len(rest) == 0 {
+	addWildcard := func(name string, multi bool) error {
+		if seenNames[name] {
+			return fmt.Errorf("duplicate wildcard name %q", name)
+		}
+		seenNames[name] = true
+		p.segments = append(p.segments, Segment{s: name, wild: true, multi: multi})
+		return nil
+	}
+	var muxPath strings.Builder
+	muxPath.WriteString(p.host)
+	for j, seg := range segs {
+		last := j == len(segs)-1
+		muxPath.WriteByte('/')
+		if seg == "" {
+			if !last {
+				return nil, errors.New("empty segment")
+			}
 			// Trailing slash.
 			p.segments = append(p.segments, Segment{wild: true, multi: true})
 			break
 		}
-		i := strings.IndexByte(rest, '/')
-		if i < 0 {
-			i = len(rest)
-		}
-		var seg string
-		seg, rest = rest[:i], rest[i:]
 		if i := strings.IndexByte(seg, '{'); i < 0 {
 			// Literal.
-			seg = pathUnescape(seg)
-			p.segments = append(p.segments, Segment{s: seg})
-		} else {
-			// Wildcard.
-			if i != 0 {
-				return nil, errors.New("bad wildcard segment (must start with '{')")
-			}
-			if seg[len(seg)-1] != '}' {
-				return nil, errors.New("bad wildcard segment (must end with '}')")
-			}
-			name := seg[1 : len(seg)-1]
-			if name == "$" {
-				if len(rest) != 0 {
-					return nil, errors.New("{$} not at end")
-				}
-				p.segments = append(p.segments, Segment{s: "/"})
-				break
+			muxPath.WriteString(seg)
+			p.segments = append(p.segments, Segment{s: pathUnescape(seg)})
+			continue
+		} else if i != 0 {
+			return nil, errors.New("bad wildcard segment (must start with '{')")
+		}
+		if seg[len(seg)-1] != '}' {
+			return nil, errors.New("bad wildcard segment (must end with '}')")
+		}
+		// Variable.
+		name := seg[1 : len(seg)-1]
+		if name == "$" {
+			if !last {
+				return nil, errors.New("{$} not at end")
 			}
-			name, multi := strings.CutSuffix(name, "...")
-			if multi && len(rest) != 0 {
+			muxPath.WriteString("{$}")
+			p.segments = append(p.segments, Segment{s: "/"})
+			break
+		}
+		name, template, found := strings.Cut(name, "=")
+		name, multi := strings.CutSuffix(name, "...")
+		if multi && found {
+			return nil, errors.New("{...} wildcard must not have a template")
+		}
+		if name == "" {
+			return nil, errors.New("empty wildcard")
+		}
+		if !isValidWildcardName(name) {
+			return nil, fmt.Errorf("bad wildcard name %q", name)
+		}
+		switch {
+		case multi:
+			template = "**"
+		case !found:
+			template = "*"
+		case template == "":
+			return nil, fmt.Errorf("empty template of wildcard %q", name)
+		}
+		variable := &Variable{name: name, template: template, index: len(p.segments)}
+		varName := wildcardVarName(name)
+		parts := strings.Split(template, "/")
+		if len(parts) == 1 && (template == "*" || template == "**") {
+			// The whole variable is a single wildcard.
+			if template == "**" && !last {
 				return nil, errors.New("{...} wildcard not at end")
 			}
-			if name == "" {
-				return nil, errors.New("empty wildcard")
+			if err := addWildcard(varName, template == "**"); err != nil {
+				return nil, err
 			}
-			if !isValidWildcardName(name) {
-				return nil, fmt.Errorf("bad wildcard name %q", name)
+			muxPath.WriteString(p.segments[len(p.segments)-1].muxString())
+		} else {
+			wildcards := 0
+			for k, part := range parts {
+				if k > 0 {
+					muxPath.WriteByte('/')
+				}
+				switch part {
+				case "":
+					return nil, fmt.Errorf("empty segment in template of wildcard %q", name)
+				case "*", "**":
+					if part == "**" && (!last || k != len(parts)-1) {
+						return nil, fmt.Errorf("'**' not at end of template of wildcard %q", name)
+					}
+					wildcards++
+					if err := addWildcard(fmt.Sprintf("%s_%d", varName, wildcards), part == "**"); err != nil {
+						return nil, err
+					}
+					muxPath.WriteString(p.segments[len(p.segments)-1].muxString())
+				default:
+					if strings.ContainsAny(part, "{}=") {
+						return nil, fmt.Errorf("bad template of wildcard %q", name)
+					}
+					muxPath.WriteString(part)
+					p.segments = append(p.segments, Segment{s: pathUnescape(part)})
+				}
 			}
-			if seenNames[name] {
+		}
+		variable.segments = p.segments[variable.index:]
+		for _, other := range p.variables {
+			if other.name == name {
 				return nil, fmt.Errorf("duplicate wildcard name %q", name)
 			}
-			seenNames[name] = true
-			p.segments = append(p.segments, Segment{s: name, wild: true, multi: multi})
 		}
+		p.variables = append(p.variables, variable)
+	}
+	if verb != "" && !p.lastSegment().wild {
+		// The template of the last variable ends with a literal, the router matches the verb.
+		muxPath.WriteString(":" + verb)
 	}
+	p.verb = verb
+	p.muxPath = muxPath.String()
 	return p, nil
 }
 
+// splitPath splits a path into its segments, slashes inside of variable templates do not split.
+// A custom verb following a variable in the last segment is cut off and returned,
+// a custom verb following a literal stays part of the literal.
+func splitPath(path string) (segs []string, verb string, err error) {
+	depth := 0
+	start := 1
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '{':
+			depth++
+			if depth > 1 {
+				return nil, "", errors.New("nested wildcard")
+			}
+		case '}':
+			depth--
+			if depth < 0 {
+				return nil, "", errors.New("unbalanced '}'")
+			}
+		case '/':
+			if depth == 0 && i > 0 {
+				segs = append(segs, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, "", errors.New("unbalanced '{'")
+	}
+	last := path[start:]
+	if j := strings.LastIndexByte(last, '}'); strings.HasPrefix(last, "{") && j >= 0 && j < len(last)-1 {
+		var found bool
+		verb, found = strings.CutPrefix(last[j+1:], ":")
+		if !found || verb == "" || strings.ContainsAny(verb, "{}") {
+			return nil, "", errors.New("bad custom verb (must be ':verb')")
+		}
+		last = last[:j+1]
+	}
+	segs = append(segs, last)
+	return segs, verb, nil
+}
+
 func validMethod(method string) bool {
 	/*
 	     Method         = "OPTIONS"                ; Section 9.2
@@ -230,6 +468,20 @@ func pathUnescape(path string) string {
 }
 
 func isValidWildcardName(s string) bool {
+	for _, name := range strings.Split(s, ".") {
+		if !isValidIdentifier(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// wildcardVarName converts a dotted wildcard name into a valid ServeMux wildcard name.
+func wildcardVarName(name string) string {
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+func isValidIdentifier(s string) bool {
 	if s == "" {
 		return false
 	}
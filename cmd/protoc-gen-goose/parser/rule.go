@@ -0,0 +1,172 @@
+package parser
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	goose "github.com/soyacen/goose/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// MethodRule returns the goose.method annotation of the method, an empty rule if it has none.
+func (e *Endpoint) MethodRule() *goose.MethodRule {
+	if rule, ok := proto.GetExtension(e.protoMethod.Desc.Options(), goose.E_Method).(*goose.MethodRule); ok && rule != nil {
+		return rule
+	}
+	return &goose.MethodRule{}
+}
+
+// CheckMethodRule reports the goose.method annotations that can not be honoured.
+func (e *Endpoint) CheckMethodRule() error {
+	rule := e.MethodRule()
+	if e.IsStreaming() && (rule.GetStatus() != 0 || rule.GetTimeout() != nil || rule.GetCacheControl() != "") {
+		// streams are not answered by a single response and outlive any timeout of the call
+		return fmt.Errorf("%s, goose.method status, timeout and cache_control are not supported by streaming methods", e.FullName())
+	}
+	if status := rule.GetStatus(); status != 0 && (status < 200 || status > 299) {
+		return fmt.Errorf("%s, goose.method status %d is not a success status code", e.FullName(), status)
+	}
+	if rule.GetStatus() == http.StatusNoContent {
+		return fmt.Errorf("%s, goose.method status 204 does not allow a response body", e.FullName())
+	}
+	if rule.GetStatus() != 0 && e.Output().Desc.FullName() == "google.rpc.HttpResponse" {
+		return fmt.Errorf("%s, goose.method status conflicts with the status of google.rpc.HttpResponse", e.FullName())
+	}
+	if timeout := rule.GetTimeout(); timeout != nil && (timeout.CheckValid() != nil || timeout.AsDuration() < 0) {
+		return fmt.Errorf("%s, goose.method timeout %s is invalid", e.FullName(), timeout)
+	}
+	return nil
+}
+
+// SuccessStatus returns the status code of successful responses, 200 unless the
+// goose.method annotation sets it.
+func (e *Endpoint) SuccessStatus() int {
+	if status := e.MethodRule().GetStatus(); status != 0 {
+		return int(status)
+	}
+	return http.StatusOK
+}
+
+// Timeout returns the timeout of the method, 0 if it has none.
+func (e *Endpoint) Timeout() time.Duration {
+	if timeout := e.MethodRule().GetTimeout(); timeout != nil {
+		return timeout.AsDuration()
+	}
+	return 0
+}
+
+// CacheControl returns the Cache-Control header of successful responses, "" if it has none.
+func (e *Endpoint) CacheControl() string {
+	return e.MethodRule().GetCacheControl()
+}
+
+// Deprecated reports whether the method is marked deprecated, by the goose.method
+// annotation or the deprecated method option.
+func (e *Endpoint) Deprecated() bool {
+	if e.MethodRule().GetDeprecated() {
+		return true
+	}
+	options, ok := e.protoMethod.Desc.Options().(*descriptorpb.MethodOptions)
+	return ok && options.GetDeprecated()
+}
+
+// FieldRule returns the goose.field annotation of a field, nil if it has none.
+func FieldRule(field *protogen.Field) *goose.FieldRule {
+	if rule, ok := proto.GetExtension(field.Desc.Options(), goose.E_Field).(*goose.FieldRule); ok && rule.GetSource() != nil {
+		return rule
+	}
+	return nil
+}
+
+// MetadataField is a field of the request bound from a header or a cookie by the goose.field annotation.
+type MetadataField struct {
+	FieldPath FieldPath
+	// Name is the name of the header or the cookie.
+	Name string
+}
+
+// HeaderFields returns the fields of the request bound from headers.
+func (e *Endpoint) HeaderFields() []*MetadataField {
+	return e.metadataFields((*goose.FieldRule).GetHeader)
+}
+
+// CookieFields returns the fields of the request bound from cookies.
+func (e *Endpoint) CookieFields() []*MetadataField {
+	return e.metadataFields((*goose.FieldRule).GetCookie)
+}
+
+// MetadataFields returns the fields of the request bound from headers and cookies, the
+// fields a "*" body leaves out.
+func (e *Endpoint) MetadataFields() []*MetadataField {
+	return append(e.HeaderFields(), e.CookieFields()...)
+}
+
+// metadataFields returns the top level fields of the request named by the name of their rule.
+func (e *Endpoint) metadataFields(name func(rule *goose.FieldRule) string) []*MetadataField {
+	var fields []*MetadataField
+	for _, field := range e.Input().Fields {
+		if rule := FieldRule(field); name(rule) != "" {
+			fields = append(fields, &MetadataField{FieldPath: FieldPath{field}, Name: name(rule)})
+		}
+	}
+	return fields
+}
+
+// CheckFieldRules reports the goose.field annotations of the request that can not be honoured.
+// Only singular scalar, enum and well-known value fields can be bound from a header or a cookie,
+// the annotations of the fields of nested messages are ignored.
+func (e *Endpoint) CheckFieldRules() error {
+	names := make(map[string]bool)
+	for _, field := range e.Input().Fields {
+		rule := FieldRule(field)
+		if rule == nil {
+			continue
+		}
+		if e.IsStreaming() {
+			// the requests of streams are read from the messages, not from the upgrade request
+			return fmt.Errorf("%s, goose.field of field %s is not supported by streaming methods", e.FullName(), field.Desc.Name())
+		}
+		name, key := rule.GetHeader(), "header "+http.CanonicalHeaderKey(rule.GetHeader())
+		if rule.GetCookie() != "" {
+			name, key = rule.GetCookie(), "cookie "+rule.GetCookie()
+		}
+		if name == "" || strings.IndexFunc(name, isNotToken) >= 0 {
+			return fmt.Errorf("%s, goose.field %q of field %s is not a valid name", e.FullName(), name, field.Desc.Name())
+		}
+		if names[key] {
+			return fmt.Errorf("%s, goose.field %s is bound to more than one field", e.FullName(), key)
+		}
+		names[key] = true
+		if !isMetadataField(field) {
+			return fmt.Errorf("%s, goose.field does not support field %s", e.FullName(), field.Desc.Name())
+		}
+	}
+	return nil
+}
+
+// isMetadataField reports whether a field can be bound from a header or a cookie.
+func isMetadataField(field *protogen.Field) bool {
+	if field.Desc.IsList() || field.Desc.IsMap() {
+		return false
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.BytesKind, protoreflect.GroupKind:
+		return false
+	case protoreflect.MessageKind:
+		switch field.Message.Desc.FullName() {
+		case "google.protobuf.DoubleValue", "google.protobuf.FloatValue",
+			"google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+			"google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+			"google.protobuf.BoolValue", "google.protobuf.StringValue",
+			"google.protobuf.Timestamp", "google.protobuf.Duration", "google.protobuf.FieldMask":
+			return true
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,167 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	goose "github.com/soyacen/goose/annotations"
+	"github.com/soyacen/goose/example/user"
+	"github.com/soyacen/goose/example/websocket"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// newPlugin builds a plugin that generates file, the edits are applied to the descriptor of file.
+func newPlugin(t *testing.T, file protoreflect.FileDescriptor, edits ...func(fdp *descriptorpb.FileDescriptorProto)) *protogen.Plugin {
+	t.Helper()
+	var files []*descriptorpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(file)
+	for _, edit := range edits {
+		edit(files[len(files)-1])
+	}
+	parameter := "paths=source_relative"
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.Path()},
+		Parameter:      &parameter,
+		ProtoFile:      files,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	return plugin
+}
+
+func TestCheckFieldRules(t *testing.T) {
+	// setRule sets the goose.field annotation of a field of a request message of user.proto
+	setRule := func(message, field string, rule *goose.FieldRule) func(fdp *descriptorpb.FileDescriptorProto) {
+		return func(fdp *descriptorpb.FileDescriptorProto) {
+			for _, m := range fdp.GetMessageType() {
+				for _, f := range m.GetField() {
+					if m.GetName() == message && f.GetName() == field {
+						if f.Options == nil {
+							f.Options = &descriptorpb.FieldOptions{}
+						}
+						proto.SetExtension(f.Options, goose.E_Field, rule)
+					}
+				}
+			}
+		}
+	}
+	tests := map[string]struct {
+		edit func(fdp *descriptorpb.FileDescriptorProto)
+		want string
+	}{
+		"invalid name": {
+			edit: setRule("ListUserRequest", "tenant", &goose.FieldRule{Source: &goose.FieldRule_Header{Header: "X Tenant"}}),
+			want: "/leo.goose.example.user.v1.User/ListUser, goose.field \"X Tenant\" of field tenant is not a valid name",
+		},
+		"duplicate": {
+			edit: setRule("ListUserRequest", "page_num", &goose.FieldRule{Source: &goose.FieldRule_Header{Header: "x-tenant-id"}}),
+			want: "/leo.goose.example.user.v1.User/ListUser, goose.field header X-Tenant-Id is bound to more than one field",
+		},
+		"message field": {
+			edit: setRule("UpdateUserRequest", "item", &goose.FieldRule{Source: &goose.FieldRule_Header{Header: "X-Item"}}),
+			want: "/leo.goose.example.user.v1.User/UpdateUser, goose.field does not support field item",
+		},
+		"path field": {
+			edit: setRule("GetUserRequest", "id", &goose.FieldRule{Source: &goose.FieldRule_Cookie{Cookie: "id"}}),
+			want: "/leo.goose.example.user.v1.User/GetUser, field id of goose.field id is also bound from the path",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plugin := newPlugin(t, user.File_example_user_user_proto, test.edit)
+			file := plugin.FilesByPath[user.File_example_user_user_proto.Path()]
+			services, err := NewServices(file)
+			if err == nil {
+				for _, endpoint := range services[0].Bindings() {
+					if _, _, _, _, err = endpoint.ParseParameters(); err != nil {
+						break
+					}
+				}
+			}
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("expected the error %q, got %v", test.want, err)
+			}
+		})
+	}
+}
+
+func TestCheckMethodRuleStreaming(t *testing.T) {
+	// setRule sets the goose.method annotation of the Talk method of websocket.proto
+	setRule := func(rule *goose.MethodRule) func(fdp *descriptorpb.FileDescriptorProto) {
+		return func(fdp *descriptorpb.FileDescriptorProto) {
+			for _, service := range fdp.GetService() {
+				for _, method := range service.GetMethod() {
+					if method.GetName() == "Talk" {
+						proto.SetExtension(method.Options, goose.E_Method, rule)
+					}
+				}
+			}
+		}
+	}
+	const want = "Talk, goose.method status, timeout and cache_control are not supported by streaming methods"
+	tests := map[string]struct {
+		rule  *goose.MethodRule
+		valid bool
+	}{
+		"status":        {rule: &goose.MethodRule{Status: 201}},
+		"timeout":       {rule: &goose.MethodRule{Timeout: durationpb.New(time.Second)}},
+		"cache_control": {rule: &goose.MethodRule{CacheControl: "no-store"}},
+		"deprecated":    {rule: &goose.MethodRule{Deprecated: true}, valid: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plugin := newPlugin(t, websocket.File_example_websocket_websocket_proto, setRule(test.rule))
+			file := plugin.FilesByPath[websocket.File_example_websocket_websocket_proto.Path()]
+			_, err := NewServices(file)
+			if test.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !test.valid && (err == nil || !strings.Contains(err.Error(), want)) {
+				t.Errorf("expected the error %q, got %v", want, err)
+			}
+		})
+	}
+}
+
+func TestCheckFieldRulesStreaming(t *testing.T) {
+	// the Request message of websocket.proto is the request of streaming methods
+	edit := func(fdp *descriptorpb.FileDescriptorProto) {
+		for _, message := range fdp.GetMessageType() {
+			if message.GetName() != "Request" {
+				continue
+			}
+			field := message.GetField()[0]
+			if field.Options == nil {
+				field.Options = &descriptorpb.FieldOptions{}
+			}
+			proto.SetExtension(field.Options, goose.E_Field, &goose.FieldRule{Source: &goose.FieldRule_Header{Header: "X-Message"}})
+		}
+	}
+	const want = "goose.field of field name is not supported by streaming methods"
+	plugin := newPlugin(t, websocket.File_example_websocket_websocket_proto, edit)
+	file := plugin.FilesByPath[websocket.File_example_websocket_websocket_proto.Path()]
+	if _, err := NewServices(file); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("expected the error %q, got %v", want, err)
+	}
+}
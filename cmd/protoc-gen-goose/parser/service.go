@@ -60,6 +60,17 @@ func (s *Service) ResponseDecoderName() string {
 	return s.Name() + "ResponseDecoder"
 }
 
+// Bindings returns the endpoints of all http rules, the primary rule of each method
+// followed by its additional bindings.
+func (s *Service) Bindings() []*Endpoint {
+	var bindings []*Endpoint
+	for _, endpoint := range s.Endpoints {
+		bindings = append(bindings, endpoint)
+		bindings = append(bindings, endpoint.AdditionalBindings()...)
+	}
+	return bindings
+}
+
 func (s *Service) IsStreamingService() bool {
 	for _, endpoint := range s.Endpoints {
 		if endpoint.IsStreaming() {
@@ -69,6 +80,64 @@ func (s *Service) IsStreamingService() bool {
 	return false
 }
 
+// UnaryService returns a view of the service holding only its unary methods.
+func (s *Service) UnaryService() *Service {
+	unary := &Service{ProtoService: s.ProtoService}
+	for _, endpoint := range s.Endpoints {
+		if !endpoint.IsStreaming() {
+			unary.Endpoints = append(unary.Endpoints, endpoint)
+		}
+	}
+	return unary
+}
+
+// StreamingService returns a view of the service holding only its streaming methods.
+func (s *Service) StreamingService() *Service {
+	streaming := &Service{ProtoService: s.ProtoService}
+	for _, endpoint := range s.Endpoints {
+		if endpoint.IsStreaming() {
+			streaming.Endpoints = append(streaming.Endpoints, endpoint)
+		}
+	}
+	return streaming
+}
+
+// HttpService returns a view of the service holding the methods whose requests are bound
+// from plain http requests, the unary methods and, when served as server-sent events,
+// the server-streaming methods.
+func (s *Service) HttpService(sse bool) *Service {
+	view := &Service{ProtoService: s.ProtoService}
+	for _, endpoint := range s.Endpoints {
+		if !endpoint.IsStreaming() || (sse && endpoint.IsServerStreaming()) {
+			view.Endpoints = append(view.Endpoints, endpoint)
+		}
+	}
+	return view
+}
+
+// IsMixedService reports whether the service has both unary and streaming methods.
+func (s *Service) IsMixedService() bool {
+	return len(s.UnaryService().Endpoints) > 0 && len(s.StreamingService().Endpoints) > 0
+}
+
+// NewHandlerName returns the name of the function returning a standalone http.Handler
+// serving all the routes of the service.
+func (s *Service) NewHandlerName() string {
+	return "New" + s.Name() + "Handler"
+}
+
+// ServerName returns the name of the interface embedding the unary and streaming
+// interfaces of a mixed service.
+func (s *Service) ServerName() string {
+	return s.Name() + "Server"
+}
+
+// AppendAllRouteName returns the name of the function registering both the http
+// and the websocket routes of a mixed service.
+func (s *Service) AppendAllRouteName() string {
+	return "Append" + s.Name() + "Route"
+}
+
 func (s *Service) StreamServerName() string {
 	return s.Name() + "StreamServer"
 }
@@ -93,6 +162,22 @@ func (s *Service) NewStreamClientName() string {
 	return "New" + s.Name() + "StreamClient"
 }
 
+func (s *Service) MockServiceName() string {
+	return "Mock" + s.ServiceName()
+}
+
+func (s *Service) MockStreamServerName() string {
+	return "Mock" + s.StreamServerName()
+}
+
+func (s *Service) MockStreamClientName() string {
+	return "Mock" + s.StreamClientName()
+}
+
+func (s *Service) MockServerName() string {
+	return "Mock" + s.ServerName()
+}
+
 func NewServices(file *protogen.File) ([]*Service, error) {
 	var services []*Service
 	for _, pbService := range file.Services {
@@ -110,6 +195,15 @@ func NewServices(file *protogen.File) ([]*Service, error) {
 				return nil, fmt.Errorf("goose: %s", err)
 			}
 			endpoint.SetPattern(pattern)
+			if err := endpoint.CheckMethodRule(); err != nil {
+				return nil, fmt.Errorf("goose: %s", err)
+			}
+			if err := endpoint.CheckFieldRules(); err != nil {
+				return nil, fmt.Errorf("goose: %s", err)
+			}
+			if err := endpoint.SetAdditionalBindings(); err != nil {
+				return nil, fmt.Errorf("goose: %s", err)
+			}
 			endpoints = append(endpoints, endpoint)
 		}
 		service.Endpoints = endpoints
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/constant"
@@ -11,10 +13,7 @@ import (
 type Generator struct{}
 
 func (generator *Generator) GenerateAppendServerFunc(service *parser.Service, g *protogen.GeneratedFile) error {
-	g.P("func ", service.AppendRouteName(), "(router *", constant.RouterIdent, ", service ", service.ServiceName(), ", opts ...", constant.ServerOptionIdent, ") ", "*", constant.RouterIdent, " {")
-	g.P("if router == nil {")
-	g.P("router = ", constant.NewServeMuxIndent, "()")
-	g.P("}")
+	g.P("func ", service.AppendRouteName(), "[R ", constant.RouterIfaceIdent, "](router R, service ", service.ServiceName(), ", opts ...", constant.ServerOptionIdent, ") R {")
 	g.P("options := ", constant.ServerNewOptionsIdent, "(opts...)")
 	g.P("handler :=  ", service.Unexported(service.HandlerName()), "{")
 	g.P("service: service,")
@@ -29,9 +28,27 @@ func (generator *Generator) GenerateAppendServerFunc(service *parser.Service, g
 	g.P("shouldFailFast: options.ShouldFailFast(),")
 	g.P("onValidationErrCallback: options.OnValidationErrCallback(),")
 	g.P("middleware: ", constant.ServerChainIdent, "(options.Middlewares()...),")
+	g.P("prefix: options.Prefix(),")
 	g.P("}")
-	for _, endpoint := range service.Endpoints {
-		g.P("router.Handle(", strconv.Quote(endpoint.Method()+" "+endpoint.Path()), ", ", constant.HttpHandlerFuncIdent, "(handler.", endpoint.Name(), "))")
+	// bindings with custom verbs may share a route, they are dispatched by their verb.
+	var routes []string
+	bindings := make(map[string][]*parser.Endpoint)
+	for _, endpoint := range service.Bindings() {
+		route := endpoint.Method() + " " + endpoint.RoutePath()
+		if _, ok := bindings[route]; !ok {
+			routes = append(routes, route)
+		}
+		bindings[route] = append(bindings[route], endpoint)
+	}
+	for _, route := range routes {
+		endpoints := bindings[route]
+		if len(endpoints) == 1 && endpoints[0].Pattern().VerbWildcard() == "" {
+			g.P("router.Handle(", routePattern(endpoints[0]), ", ", constant.HttpHandlerFuncIdent, "(handler.", endpoints[0].BindingName(), "))")
+			continue
+		}
+		if err := generator.PrintVerbRoute(g, route, endpoints); err != nil {
+			return err
+		}
 	}
 	g.P("return router")
 	g.P("}")
@@ -39,6 +56,51 @@ func (generator *Generator) GenerateAppendServerFunc(service *parser.Service, g
 	return nil
 }
 
+// PrintVerbRoute registers a route whose bindings are told apart by their custom verbs,
+// a binding without custom verb handles the requests matching no verb.
+func (generator *Generator) PrintVerbRoute(g *protogen.GeneratedFile, route string, endpoints []*parser.Endpoint) error {
+	var fallback *parser.Endpoint
+	g.P("router.Handle(", routePattern(endpoints[0]), ", ", constant.HttpHandlerFuncIdent, "(func(response ", constant.ResponseWriterIdent, ", request *", constant.RequestIdent, ") {")
+	g.P("switch {")
+	for _, endpoint := range endpoints {
+		pattern := endpoint.Pattern()
+		if pattern.VerbWildcard() == "" {
+			if fallback != nil {
+				return fmt.Errorf("%s, route %s conflicts with %s", endpoint.FullName(), route, fallback.FullName())
+			}
+			fallback = endpoint
+			continue
+		}
+		g.P("case ", constant.MatchVerbIdent, "(request, ", strconv.Quote(pattern.VerbWildcard()), ", ", strconv.Quote(pattern.Verb()), "):")
+		g.P("handler.", endpoint.BindingName(), "(response, request)")
+	}
+	g.P("default:")
+	if fallback != nil {
+		g.P("handler.", fallback.BindingName(), "(response, request)")
+	} else {
+		g.P(constant.HttpNotFoundIdent, "(response, request)")
+	}
+	g.P("}")
+	g.P("}))")
+	return nil
+}
+
+// routePattern returns the expression of the pattern registering the route of endpoint
+// under the prefix of the server options.
+func routePattern(endpoint *parser.Endpoint) string {
+	return strconv.Quote(endpoint.Method()+" ") + "+options.Prefix()+" + strconv.Quote(endpoint.RoutePath())
+}
+
+// GenerateNewHandlerFunc generates the function returning a standalone http.Handler serving
+// the routes of the service.
+func (generator *Generator) GenerateNewHandlerFunc(service *parser.Service, g *protogen.GeneratedFile) error {
+	g.P("func ", service.NewHandlerName(), "(service ", service.ServiceName(), ", opts ...", constant.ServerOptionIdent, ") ", constant.HttpHandlerIdent, " {")
+	g.P("return ", service.AppendRouteName(), "(", constant.NewServeMuxIndent, "(), service, opts...)")
+	g.P("}")
+	g.P()
+	return nil
+}
+
 func (generator *Generator) GenerateHandlers(service *parser.Service, g *protogen.GeneratedFile) error {
 	g.P("type ", service.Unexported(service.HandlerName()), " struct {")
 	g.P("service ", service.ServiceName())
@@ -48,17 +110,19 @@ func (generator *Generator) GenerateHandlers(service *parser.Service, g *protoge
 	g.P("shouldFailFast bool")
 	g.P("onValidationErrCallback ", constant.OnErrCallbackIdent)
 	g.P("middleware ", constant.ServerMiddlewareIdent)
+	g.P("prefix string")
 	g.P("}")
 	g.P()
-	for _, endpoint := range service.Endpoints {
-		g.P("func (h ", service.Unexported(service.HandlerName()), ")", endpoint.Name(), "(response ", constant.ResponseWriterIdent, ", request *", constant.RequestIdent, ") {")
+	for _, endpoint := range service.Bindings() {
+		g.P("func (h ", service.Unexported(service.HandlerName()), ")", endpoint.BindingName(), "(response ", constant.ResponseWriterIdent, ", request *", constant.RequestIdent, ") {")
 		g.P("invoke := func(response ", constant.ResponseWriterIdent, ", request *", constant.RequestIdent, ") {")
-		g.P("ctx := request.Context()")
-		g.P("req, err := h.decoder.", endpoint.Name(), "(ctx, request)")
+		generator.PrintHandlerContext(g, endpoint)
+		g.P("req, err := h.decoder.", endpoint.BindingName(), "(ctx, request)")
 		g.P("if err != nil {")
 		g.P("h.errorEncoder(ctx, err, response)")
 		g.P("return")
 		g.P("}")
+		g.P(constant.ClearOutputOnlyIdent, "(req)")
 		g.P("if err := ", constant.ValidateRequestIdent, "(ctx, req, h.shouldFailFast, h.onValidationErrCallback)", "; err != nil {")
 		g.P("h.errorEncoder(ctx, err, response)")
 		g.P("return")
@@ -68,14 +132,39 @@ func (generator *Generator) GenerateHandlers(service *parser.Service, g *protoge
 		g.P("h.errorEncoder(ctx, err, response)")
 		g.P("return")
 		g.P("}")
-		g.P("if err := h.encoder.", endpoint.Name(), "(ctx, response, resp); err != nil {")
+		g.P("if err := h.encoder.", endpoint.BindingName(), "(ctx, response, resp); err != nil {")
 		g.P("h.errorEncoder(ctx, err, response)")
 		g.P("return")
 		g.P("}")
 		g.P("}")
-		g.P(constant.ServerInvokeIdent, "(h.middleware, response, request, invoke, ", endpoint.DescName(), ".RouteInfo)")
+		g.P(constant.ServerInvokeIdent, "(h.middleware, response, request, invoke, ", endpoint.DescName(), ".RouteInfo.WithPrefix(h.prefix))")
 		g.P("}")
 		g.P()
 	}
 	return nil
 }
+
+// PrintHandlerContext prints the context of the call of the service, canceled after the
+// timeout of the method. The service sets the headers and the status code of the response
+// through the context, they default to the ones of the goose.method annotation.
+func (generator *Generator) PrintHandlerContext(g *protogen.GeneratedFile, endpoint *parser.Endpoint) {
+	assign := []any{"ctx := ", constant.InjectResponseMetadataIdent, "(request.Context(), "}
+	if timeout := endpoint.Timeout(); timeout > 0 {
+		g.P(append(append([]any{"ctx, cancel := ", constant.ContextWithTimeoutIdent, "(request.Context(), "}, constant.DurationExpr(timeout)...), ")")...)
+		g.P("defer cancel()")
+		assign = []any{"ctx = ", constant.InjectResponseMetadataIdent, "(ctx, "}
+	}
+	status, cacheControl := endpoint.SuccessStatus(), endpoint.CacheControl()
+	if status == http.StatusOK && cacheControl == "" {
+		g.P(append(assign, "new(", constant.ResponseMetadataIdent, "))")...)
+		return
+	}
+	g.P(append(assign, "&", constant.ResponseMetadataIdent, "{")...)
+	if cacheControl != "" {
+		g.P("Header: ", constant.Header, "{", strconv.Quote("Cache-Control"), ": {", strconv.Quote(cacheControl), "}},")
+	}
+	if status != http.StatusOK {
+		g.P("StatusCode: ", status, ",")
+	}
+	g.P("})")
+}
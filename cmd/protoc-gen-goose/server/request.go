@@ -14,8 +14,8 @@ func (generator *Generator) GenerateDecodeRequest(service *parser.Service, g *pr
 	g.P("type ", service.Unexported(service.RequestDecoderName()), " struct {")
 	g.P("unmarshalOptions ", constant.ProtoJsonUnmarshalOptionsIdent)
 	g.P("}")
-	for _, endpoint := range service.Endpoints {
-		g.P("func (decoder ", service.Unexported(service.RequestDecoderName()), ")", endpoint.Name(), "(ctx ", constant.ContextIdent, ", request *", constant.RequestIdent, ") (*", endpoint.InputGoIdent(), ", error){")
+	for _, endpoint := range service.Bindings() {
+		g.P("func (decoder ", service.Unexported(service.RequestDecoderName()), ")", endpoint.BindingName(), "(ctx ", constant.ContextIdent, ", request *", constant.RequestIdent, ") (*", endpoint.InputGoIdent(), ", error){")
 		g.P("req := &", endpoint.InputGoIdent(), "{}")
 		g.P("ok, err := ", constant.CustomDecodeRequestIdent, "(ctx, request, req)")
 		g.P("if err != nil {")
@@ -30,6 +30,8 @@ func (generator *Generator) GenerateDecodeRequest(service *parser.Service, g *pr
 			return err
 		}
 
+		// parent messages of nested fields allocated so far
+		allocated := map[string]bool{}
 		if bodyMessage != nil {
 			switch bodyMessage.Desc.FullName() {
 			case "google.api.HttpBody":
@@ -40,32 +42,46 @@ func (generator *Generator) GenerateDecodeRequest(service *parser.Service, g *pr
 				generator.PrintRequestDecodeBlock(g, []any{"req"})
 			}
 		} else if bodyField != nil {
-			tgtValue := []any{"req.", bodyField.GoName}
+			generator.PrintAllocParents(g, bodyField.Parents(), allocated)
+			field := bodyField.Leaf()
+			tgtValue := []any{"req.", bodyField.GoName()}
 			g.P(append(append([]any{"if "}, tgtValue...), " == nil {")...)
-			g.P(append(tgtValue, " = &", bodyField.Message.GoIdent, "{}")...)
+			g.P(append(tgtValue, " = &", field.Message.GoIdent, "{}")...)
 			g.P("}")
-			switch bodyField.Desc.Kind() {
+			switch field.Desc.Kind() {
 			case protoreflect.MessageKind:
-				switch bodyField.Message.Desc.FullName() {
+				switch field.Message.Desc.FullName() {
 				case "google.api.HttpBody":
 					generator.PrintHttpBodyDecodeBlock(g, tgtValue)
 				default:
-					generator.PrintRequestDecodeBlock(g, []any{"req.", bodyField.GoName})
+					generator.PrintRequestDecodeBlock(g, tgtValue)
 				}
 			}
 		}
 
 		if len(pathFields) > 0 {
-			fields := make([]string, 0, len(pathFields))
-			for _, field := range pathFields {
-				fields = append(fields, strconv.Quote(string(field.Desc.Name())))
+			variables := endpoint.PathVariables()
+			var keys []string
+			for _, variable := range variables {
+				if variable.IsWildcard() {
+					keys = append(keys, strconv.Quote(PathVariableKey(variable)))
+				}
+			}
+			if len(keys) > 0 {
+				g.P("vars := ", constant.FormFromPathIdent, "(request, ", strings.Join(keys, ", "), ")")
+			} else {
+				g.P("vars := ", constant.URLValuesIndent, "{}")
 			}
-			g.P("vars := ", constant.FormFromPathIdent, "(request, ", strings.Join(fields, ", "), ")")
-			generator.PrintPathField(g, pathFields)
+			for _, variable := range variables {
+				if !variable.IsWildcard() {
+					generator.PrintPathTemplateValue(g, variable)
+				}
+			}
+			generator.PrintPathField(g, pathFields, variables, allocated)
 		}
 
 		if len(queryFields) > 0 {
-			g.P("queries := request.URL.Query()")
+			g.P("queries := ", constant.FormFromQueryIdent, "(request)")
 			g.P("var queryErr error")
 			generator.PrintQueryField(g, queryFields)
 			g.P("if queryErr != nil {")
@@ -73,6 +89,9 @@ func (generator *Generator) GenerateDecodeRequest(service *parser.Service, g *pr
 			g.P("}")
 		}
 
+		generator.PrintMetadataField(g, endpoint.HeaderFields(), constant.FormFromHeaderIdent, "headers", "headerErr")
+		generator.PrintMetadataField(g, endpoint.CookieFields(), constant.FormFromCookieIdent, "cookies", "cookieErr")
+
 		g.P("return req, nil")
 		g.P("}")
 	}
@@ -98,18 +117,70 @@ func (generator *Generator) PrintRequestDecodeBlock(g *protogen.GeneratedFile, t
 	g.P("}")
 }
 
-func (generator *Generator) PrintPathField(g *protogen.GeneratedFile, pathFields []*protogen.Field) {
+// PrintAllocParents allocates the nil parent messages of a nested field, parents in allocated are skipped.
+func (generator *Generator) PrintAllocParents(g *protogen.GeneratedFile, parents parser.FieldPath, allocated map[string]bool) {
+	for i := range parents {
+		parent := parents[:i+1]
+		if allocated[parent.GoName()] {
+			continue
+		}
+		allocated[parent.GoName()] = true
+		g.P("if req.", parent.GoName(), " == nil {")
+		g.P("req.", parent.GoName(), " = &", parent.Leaf().Message.GoIdent, "{}")
+		g.P("}")
+	}
+}
+
+// PrintPathTemplateValue rebuilds the value of a variable with a segment template,
+// e.g. "shelves/"+request.PathValue("name_1") for "{name=shelves/*}".
+func (generator *Generator) PrintPathTemplateValue(g *protogen.GeneratedFile, variable *parser.Variable) {
+	value := []any{"vars.Set(", strconv.Quote(PathVariableKey(variable)), ", "}
+	literal := ""
+	for i, segment := range variable.Segments() {
+		if i > 0 {
+			literal += "/"
+		}
+		if !segment.IsWild() {
+			literal += segment.Value()
+			continue
+		}
+		if literal != "" {
+			value = append(value, strconv.Quote(literal), "+")
+			literal = ""
+		}
+		value = append(value, "request.PathValue(", strconv.Quote(segment.Value()), ")", "+")
+	}
+	if literal != "" {
+		value = append(value, strconv.Quote(literal))
+	} else {
+		value = value[:len(value)-1]
+	}
+	g.P(append(value, ")")...)
+}
+
+// PathVariableKey returns the key of the path variable in the vars form, wildcard variables
+// are keyed by their router wildcard and variables with a segment template by their field path.
+func PathVariableKey(variable *parser.Variable) string {
+	if variable.IsWildcard() {
+		return variable.Segments()[0].Value()
+	}
+	return strings.ReplaceAll(variable.Name(), ".", "_")
+}
+
+func (generator *Generator) PrintPathField(g *protogen.GeneratedFile, pathFields []parser.FieldPath, variables []*parser.Variable, allocated map[string]bool) {
 	if len(pathFields) <= 0 {
 		return
 	}
 	form := "vars"
 	errName := "varErr"
 	g.P("var ", errName, " error")
-	for _, field := range pathFields {
-		fieldName := string(field.Desc.Name())
+	for i, fieldPath := range pathFields {
+		field := fieldPath.Leaf()
+		fieldName := PathVariableKey(variables[i])
+		generator.PrintAllocParents(g, fieldPath.Parents(), allocated)
 
-		tgtValue := []any{"req.", field.GoName, " = "}
-		tgtErrValue := []any{"req.", field.GoName, ", ", errName, " = "}
+		tgtValue := []any{"req.", fieldPath.GoName(), " = "}
+		tgtErrValue := []any{"req.", fieldPath.GoName(), ", ", errName, " = "}
 		srcValue := []any{"vars.Get(", strconv.Quote(fieldName), ")"}
 
 		goType, pointer := parser.FieldGoType(g, field)
@@ -186,6 +257,12 @@ func (generator *Generator) PrintPathField(g *protogen.GeneratedFile, pathFields
 				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat64ValueIdent, fieldName, form, errName)
 			case "google.protobuf.StringValue":
 				generator.PrintWrapStringValueAssign(g, tgtValue, srcValue)
+			case "google.protobuf.Timestamp":
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetTimestampIdent, fieldName, form, errName)
+			case "google.protobuf.Duration":
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetDurationIdent, fieldName, form, errName)
+			case "google.protobuf.FieldMask":
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFieldMaskIdent, fieldName, form, errName)
 			}
 		}
 	}
@@ -194,168 +271,246 @@ func (generator *Generator) PrintPathField(g *protogen.GeneratedFile, pathFields
 	g.P("}")
 }
 
-func (generator *Generator) PrintQueryField(g *protogen.GeneratedFile, queryFields []*protogen.Field) {
-	for _, field := range queryFields {
-		fieldName := string(field.Desc.Name())
-
-		tgtValue := []any{"req.", field.GoName, " = "}
-		tgtErrValue := []any{"req.", field.GoName, ", queryErr = "}
-		srcValue := []any{"queries.Get(", strconv.Quote(fieldName), ")"}
-		if field.Desc.IsList() {
-			srcValue = []any{"queries[", strconv.Quote(fieldName), "]"}
+func (generator *Generator) PrintQueryField(g *protogen.GeneratedFile, queryFields []parser.FieldPath) {
+	for _, fieldPath := range queryFields {
+		fieldName := fieldPath.Name()
+		if len(fieldPath) > 1 {
+			// nested messages are only allocated when one of their fields is present,
+			// map fields are present by the keys of their entries.
+			if fieldPath.Leaf().Desc.IsMap() {
+				g.P("if ", constant.HasMapFormIdent, "(queries, ", strconv.Quote(fieldName), ") {")
+			} else {
+				g.P("if queries.Has(", strconv.Quote(fieldName), ") {")
+			}
+			generator.PrintAllocParents(g, fieldPath.Parents(), map[string]bool{})
 		}
-
-		goType, pointer := parser.FieldGoType(g, field)
-		if pointer {
-			goType = append([]any{"*"}, goType...)
+		generator.PrintFormField(g, fieldPath, fieldName, "queries", "queryErr")
+		if len(fieldPath) > 1 {
+			g.P("}")
 		}
+	}
+}
 
-		form := "queries"
-		errName := "queryErr"
+// PrintMetadataField assigns the fields bound from headers or cookies, a field is only
+// assigned when its header or cookie is present so that it keeps the value of the body.
+func (generator *Generator) PrintMetadataField(g *protogen.GeneratedFile, metadataFields []*parser.MetadataField, formFunc protogen.GoIdent, form string, errName string) {
+	if len(metadataFields) <= 0 {
+		return
+	}
+	var names []string
+	for _, metadataField := range metadataFields {
+		names = append(names, strconv.Quote(metadataField.Name))
+	}
+	g.P(form, " := ", formFunc, "(request, ", strings.Join(names, ", "), ")")
+	g.P("var ", errName, " error")
+	for _, metadataField := range metadataFields {
+		g.P("if ", form, ".Has(", strconv.Quote(metadataField.Name), ") {")
+		generator.PrintFormField(g, metadataField.FieldPath, metadataField.Name, form, errName)
+		g.P("}")
+	}
+	g.P("if ", errName, " != nil {")
+	g.P("return nil, ", errName)
+	g.P("}")
+}
 
-		switch field.Desc.Kind() {
-		case protoreflect.BoolKind: // bool
+// PrintFormField assigns a field from the values of key in form, the first decoding error
+// is kept in errName.
+func (generator *Generator) PrintFormField(g *protogen.GeneratedFile, fieldPath parser.FieldPath, fieldName string, form string, errName string) {
+	field := fieldPath.Leaf()
+	tgtValue := []any{"req.", fieldPath.GoName(), " = "}
+	tgtErrValue := []any{"req.", fieldPath.GoName(), ", ", errName, " = "}
+	srcValue := []any{form, ".Get(", strconv.Quote(fieldName), ")"}
+	if field.Desc.IsList() {
+		srcValue = []any{form, "[", strconv.Quote(fieldName), "]"}
+	}
+
+	goType, pointer := parser.FieldGoType(g, field)
+	if pointer {
+		goType = append([]any{"*"}, goType...)
+	}
+
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind: // bool
+		if field.Desc.IsList() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolSliceIdent, fieldName, form, errName)
+		} else {
+			if pointer {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolPtrIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolIdent, fieldName, form, errName)
+			}
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind: // int32
+		if field.Desc.IsList() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntSliceIdent, fieldName, form, errName)
+		} else {
+			if pointer {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntPtrIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntIdent, fieldName, form, errName)
+			}
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind: // uint32
+		if field.Desc.IsList() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintSliceIdent, fieldName, form, errName)
+		} else {
+			if pointer {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintPtrIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintIdent, fieldName, form, errName)
+			}
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind: // int64
+		if field.Desc.IsList() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntSliceIdent, fieldName, form, errName)
+		} else {
+			if pointer {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntPtrIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntIdent, fieldName, form, errName)
+			}
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind: // uint64
+		if field.Desc.IsList() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintSliceIdent, fieldName, form, errName)
+		} else {
+			if pointer {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintPtrIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintIdent, fieldName, form, errName)
+			}
+		}
+	case protoreflect.FloatKind: // float32
+		if field.Desc.IsList() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatSliceIdent, fieldName, form, errName)
+		} else {
+			if pointer {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatPtrIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatIdent, fieldName, form, errName)
+			}
+		}
+	case protoreflect.DoubleKind: // float64
+		if field.Desc.IsList() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatSliceIdent, fieldName, form, errName)
+		} else {
+			if pointer {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatPtrIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatIdent, fieldName, form, errName)
+			}
+		}
+	case protoreflect.StringKind: // string
+		if field.Desc.IsList() {
+			generator.PrintStringListAssign(g, tgtValue, srcValue)
+		} else {
+			generator.PrintStringValueAssign(g, tgtValue, srcValue, pointer)
+		}
+	case protoreflect.EnumKind: // enum int32
+		if field.Desc.IsList() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetEnumSliceIdent(g, goType[1].(protogen.GoIdent)), fieldName, form, errName)
+		} else {
+			if pointer {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetEnumPtrIdent(g, goType[1].(protogen.GoIdent)), fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetEnumIdent(g, goType[0].(protogen.GoIdent)), fieldName, form, errName)
+			}
+		}
+	case protoreflect.MessageKind:
+		if field.Desc.IsMap() {
+			generator.PrintFieldAssign(g, tgtErrValue, goType, generator.MapGetter(field), fieldName, form, errName)
+			break
+		}
+		switch field.Message.Desc.FullName() {
+		case "google.protobuf.BoolValue":
 			if field.Desc.IsList() {
-				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolSliceIdent, fieldName, form, errName)
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolValueSliceIdent, fieldName, form, errName)
 			} else {
-				if pointer {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolPtrIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolIdent, fieldName, form, errName)
-				}
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolValueIdent, fieldName, form, errName)
 			}
-		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind: // int32
+		case "google.protobuf.Int32Value":
 			if field.Desc.IsList() {
-				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntSliceIdent, fieldName, form, errName)
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetInt32ValueSliceIdent, fieldName, form, errName)
 			} else {
-				if pointer {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntPtrIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntIdent, fieldName, form, errName)
-				}
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetInt32ValueIdent, fieldName, form, errName)
 			}
-		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind: // uint32
+		case "google.protobuf.UInt32Value":
 			if field.Desc.IsList() {
-				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintSliceIdent, fieldName, form, errName)
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUint32ValueSliceIdent, fieldName, form, errName)
 			} else {
-				if pointer {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintPtrIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintIdent, fieldName, form, errName)
-				}
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUint32ValueIdent, fieldName, form, errName)
 			}
-		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind: // int64
+		case "google.protobuf.Int64Value":
 			if field.Desc.IsList() {
-				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntSliceIdent, fieldName, form, errName)
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetInt64ValueSliceIdent, fieldName, form, errName)
 			} else {
-				if pointer {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntPtrIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetIntIdent, fieldName, form, errName)
-				}
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetInt64ValueIdent, fieldName, form, errName)
 			}
-		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind: // uint64
+		case "google.protobuf.UInt64Value":
 			if field.Desc.IsList() {
-				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintSliceIdent, fieldName, form, errName)
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUint64ValueSliceIdent, fieldName, form, errName)
 			} else {
-				if pointer {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintPtrIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUintIdent, fieldName, form, errName)
-				}
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUint64ValueIdent, fieldName, form, errName)
 			}
-		case protoreflect.FloatKind: // float32
+		case "google.protobuf.FloatValue":
 			if field.Desc.IsList() {
-				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatSliceIdent, fieldName, form, errName)
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat32ValueSliceIdent, fieldName, form, errName)
 			} else {
-				if pointer {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatPtrIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatIdent, fieldName, form, errName)
-				}
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat32ValueIdent, fieldName, form, errName)
 			}
-		case protoreflect.DoubleKind: // float64
+
+		case "google.protobuf.DoubleValue":
 			if field.Desc.IsList() {
-				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatSliceIdent, fieldName, form, errName)
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat64ValueSliceIdent, fieldName, form, errName)
 			} else {
-				if pointer {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatPtrIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloatIdent, fieldName, form, errName)
-				}
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat64ValueIdent, fieldName, form, errName)
 			}
-		case protoreflect.StringKind: // string
+		case "google.protobuf.StringValue":
 			if field.Desc.IsList() {
-				generator.PrintStringListAssign(g, tgtValue, srcValue)
+				generator.PrintWrapStringListAssign(g, tgtValue, srcValue)
 			} else {
-				generator.PrintStringValueAssign(g, tgtValue, srcValue, pointer)
+				generator.PrintWrapStringValueAssign(g, tgtValue, srcValue)
 			}
-		case protoreflect.EnumKind: // enum int32
+		case "google.protobuf.Timestamp":
 			if field.Desc.IsList() {
-				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetEnumSliceIdent(g, goType[1].(protogen.GoIdent)), fieldName, form, errName)
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetTimestampSliceIdent, fieldName, form, errName)
 			} else {
-				if pointer {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetEnumPtrIdent(g, goType[1].(protogen.GoIdent)), fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetEnumIdent(g, goType[0].(protogen.GoIdent)), fieldName, form, errName)
-				}
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetTimestampIdent, fieldName, form, errName)
 			}
-		case protoreflect.MessageKind:
-			switch field.Message.Desc.FullName() {
-			case "google.protobuf.BoolValue":
-				if field.Desc.IsList() {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolValueSliceIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetBoolValueIdent, fieldName, form, errName)
-				}
-			case "google.protobuf.Int32Value":
-				if field.Desc.IsList() {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetInt32ValueSliceIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetInt32ValueIdent, fieldName, form, errName)
-				}
-			case "google.protobuf.UInt32Value":
-				if field.Desc.IsList() {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUint32ValueSliceIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUint32ValueIdent, fieldName, form, errName)
-				}
-			case "google.protobuf.Int64Value":
-				if field.Desc.IsList() {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetInt64ValueSliceIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetInt64ValueIdent, fieldName, form, errName)
-				}
-			case "google.protobuf.UInt64Value":
-				if field.Desc.IsList() {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUint64ValueSliceIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetUint64ValueIdent, fieldName, form, errName)
-				}
-			case "google.protobuf.FloatValue":
-				if field.Desc.IsList() {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat32ValueSliceIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat32ValueIdent, fieldName, form, errName)
-				}
-
-			case "google.protobuf.DoubleValue":
-				if field.Desc.IsList() {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat64ValueSliceIdent, fieldName, form, errName)
-				} else {
-					generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFloat64ValueIdent, fieldName, form, errName)
-				}
-			case "google.protobuf.StringValue":
-				if field.Desc.IsList() {
-					generator.PrintWrapStringListAssign(g, tgtValue, srcValue)
-				} else {
-					generator.PrintWrapStringValueAssign(g, tgtValue, srcValue)
-				}
+		case "google.protobuf.Duration":
+			if field.Desc.IsList() {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetDurationSliceIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetDurationIdent, fieldName, form, errName)
+			}
+		case "google.protobuf.FieldMask":
+			if field.Desc.IsList() {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFieldMaskSliceIdent, fieldName, form, errName)
+			} else {
+				generator.PrintFieldAssign(g, tgtErrValue, goType, constant.GetFieldMaskIdent, fieldName, form, errName)
 			}
 		}
 	}
 }
 
+// MapGetter returns the form getter of a map field by the kind of its values.
+func (generator *Generator) MapGetter(field *protogen.Field) protogen.GoIdent {
+	switch field.Message.Fields[1].Desc.Kind() {
+	case protoreflect.BoolKind:
+		return constant.GetBoolMapIdent
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.EnumKind:
+		return constant.GetIntMapIdent
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return constant.GetUintMapIdent
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return constant.GetFloatMapIdent
+	default:
+		return constant.GetStringMapIdent
+	}
+}
+
 func (generator *Generator) PrintFieldAssign(g *protogen.GeneratedFile, tgtValue []any, goType []any, getter protogen.GoIdent, key string, form string, errName string) {
 	g.P(append(append([]any{}, tgtValue...), append(append([]any{constant.GetFormIdent, "["}, goType...), append([]any{"](", errName, ", ", form, ", ", strconv.Quote(key), ", ", getter}, ")")...)...)...)
 }
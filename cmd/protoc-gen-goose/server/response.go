@@ -14,8 +14,8 @@ func (generator *Generator) GenerateEncodeResponse(service *parser.Service, g *p
 	g.P("marshalOptions ", constant.ProtoJsonMarshalOptionsIdent)
 	g.P("unmarshalOptions ", constant.ProtoJsonUnmarshalOptionsIdent)
 	g.P("}")
-	for _, endpoint := range service.Endpoints {
-		g.P("func (encoder ", service.Unexported(service.ResponseEncoderName()), ")", endpoint.Name(), "(ctx ", constant.ContextIdent, ", w ", constant.ResponseWriterIdent, ", resp *", endpoint.OutputGoIdent(), ") error {")
+	for _, endpoint := range service.Bindings() {
+		g.P("func (encoder ", service.Unexported(service.ResponseEncoderName()), ")", endpoint.BindingName(), "(ctx ", constant.ContextIdent, ", w ", constant.ResponseWriterIdent, ", resp *", endpoint.OutputGoIdent(), ") error {")
 		bodyParameter := endpoint.ResponseBody()
 		switch bodyParameter {
 		case "", "*":
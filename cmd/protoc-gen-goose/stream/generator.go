@@ -1,16 +1,38 @@
 package stream
 
 import (
+	"fmt"
+
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/constant"
 	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
-type Generator struct{}
+type Generator struct {
+	// SSE serves the server-streaming methods as server-sent events as well as over websocket,
+	// their clients consume the server-sent events.
+	SSE bool
+}
+
+// eventStream reports whether the service has server-streaming methods served as server-sent events.
+func (gen *Generator) eventStream(service *parser.Service) bool {
+	if !gen.SSE {
+		return false
+	}
+	for _, endpoint := range service.Endpoints {
+		if endpoint.IsServerStreaming() {
+			return true
+		}
+	}
+	return false
+}
 
 func (gen *Generator) GenerateStreamServerInterface(service *parser.Service, g *protogen.GeneratedFile) error {
 	g.P("type ", service.StreamServerName(), " interface {")
 	for _, endpoint := range service.Endpoints {
+		if endpoint.Deprecated() {
+			g.P(constant.DeprecationComment)
+		}
 		if endpoint.IsClientStreaming() {
 			g.P(endpoint.Name(), "(", constant.WsClientStreamingServerIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "]) error")
 		} else if endpoint.IsServerStreaming() {
@@ -27,6 +49,9 @@ func (gen *Generator) GenerateStreamServerInterface(service *parser.Service, g *
 func (gen *Generator) GenerateStreamClientInterface(service *parser.Service, g *protogen.GeneratedFile) error {
 	g.P("type ", service.StreamClientName(), " interface {")
 	for _, endpoint := range service.Endpoints {
+		if endpoint.Deprecated() {
+			g.P(constant.DeprecationComment)
+		}
 		if endpoint.IsClientStreaming() {
 			g.P(endpoint.Name(), "(ctx ", constant.ContextIdent, ") (", constant.WsClientStreamingClientIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "], error)")
 		} else if endpoint.IsServerStreaming() {
@@ -41,30 +66,32 @@ func (gen *Generator) GenerateStreamClientInterface(service *parser.Service, g *
 }
 
 func (gen *Generator) GenerateAppendStreamRouteFunc(service *parser.Service, g *protogen.GeneratedFile) error {
-	g.P("func ", service.AppendStreamRouteName(), "(")
-	g.P("router *", constant.RouterIdent, ",")
-	g.P("service ", service.StreamServerName(), ",")
-	g.P("middleware ", constant.ServerMiddlewareIdent, ",")
-	g.P("marshalOpts ", constant.ProtoJsonMarshalOptionsIdent, ",")
-	g.P("unmarshalOpts ", constant.ProtoJsonUnmarshalOptionsIdent, ",")
-	g.P("acptOpts *", constant.WebsocketAcceptOptionsIdent, ",")
-	g.P("cfg *", constant.WsConnConfigIdent, ",")
-	g.P("logger *", constant.SlogLoggerIdent, ",")
-	g.P(") *", constant.RouterIdent, " {")
-	g.P("if router == nil {")
-	g.P("router = ", constant.NewServeMuxIndent, "()")
-	g.P("}")
+	g.P("func ", service.AppendStreamRouteName(), "[R ", constant.RouterIfaceIdent, "](router R, service ", service.StreamServerName(), ", opts ...", constant.WsOptionIdent, ") R {")
+	g.P("options := ", constant.WsNewOptionsIdent, "(opts...)")
 	g.P("handler := &", service.StreamHandlerName(), "{")
 	g.P("service: service,")
-	g.P("middleware: middleware,")
-	g.P("marshalOptions: marshalOpts,")
-	g.P("unmarshalOptions: unmarshalOpts,")
-	g.P("acptOpts: acptOpts,")
-	g.P("cfg: cfg,")
-	g.P("logger: logger,")
+	g.P("middleware: ", constant.ServerChainIdent, "(options.Middlewares()...),")
+	g.P("marshalOptions: options.MarshalOptions(),")
+	g.P("unmarshalOptions: options.UnmarshalOptions(),")
+	g.P("acptOpts: options.AcceptOptions(),")
+	g.P("cfg: options.ConnConfig(),")
+	g.P("logger: options.Logger(),")
+	g.P("prefix: options.Prefix(),")
+	if gen.eventStream(service) {
+		g.P("decoder: ", service.Unexported(service.RequestDecoderName()), "{")
+		g.P("unmarshalOptions: options.UnmarshalOptions(),")
+		g.P("},")
+		g.P("eventID: options.EventIDFunc(),")
+		g.P("errorEncoder: options.ErrorEncoder(),")
+		g.P("shouldFailFast: options.ShouldFailFast(),")
+		g.P("onValidationErrCallback: options.OnValidationErrCallback(),")
+	}
 	g.P("}")
-	for _, endpoint := range service.Endpoints {
-		g.P("router.Handle(", endpoint.DescName(), ".RouteInfo.Pattern, ", constant.HttpHandlerFuncIdent, "(handler.", endpoint.Name(), "))")
+	for _, endpoint := range service.Bindings() {
+		if endpoint.Pattern().VerbWildcard() != "" {
+			return fmt.Errorf("%s, streaming methods do not support custom verbs after wildcards", endpoint.FullName())
+		}
+		g.P("router.Handle(options.Prefix()+", endpoint.DescName(), ".RouteInfo.Pattern, ", constant.HttpHandlerFuncIdent, "(handler.", endpoint.BindingName(), "))")
 	}
 	g.P("return router")
 	g.P("}")
@@ -72,6 +99,16 @@ func (gen *Generator) GenerateAppendStreamRouteFunc(service *parser.Service, g *
 	return nil
 }
 
+// GenerateNewStreamHandlerFunc generates the function returning a standalone http.Handler
+// serving the websocket routes of the service.
+func (gen *Generator) GenerateNewStreamHandlerFunc(service *parser.Service, g *protogen.GeneratedFile) error {
+	g.P("func ", service.NewHandlerName(), "(service ", service.StreamServerName(), ", opts ...", constant.WsOptionIdent, ") ", constant.HttpHandlerIdent, " {")
+	g.P("return ", service.AppendStreamRouteName(), "(", constant.NewServeMuxIndent, "(), service, opts...)")
+	g.P("}")
+	g.P()
+	return nil
+}
+
 func (gen *Generator) GenerateStreamHandlerStruct(service *parser.Service, g *protogen.GeneratedFile) error {
 	g.P("type ", service.StreamHandlerName(), " struct {")
 	g.P("service ", service.StreamServerName())
@@ -81,6 +118,14 @@ func (gen *Generator) GenerateStreamHandlerStruct(service *parser.Service, g *pr
 	g.P("acptOpts *", constant.WebsocketAcceptOptionsIdent)
 	g.P("cfg *", constant.WsConnConfigIdent)
 	g.P("logger *", constant.SlogLoggerIdent)
+	g.P("prefix string")
+	if gen.eventStream(service) {
+		g.P("decoder ", service.Unexported(service.RequestDecoderName()))
+		g.P("eventID ", constant.WsEventIDFuncIdent)
+		g.P("errorEncoder ", constant.ErrorEncoderIdent)
+		g.P("shouldFailFast bool")
+		g.P("onValidationErrCallback ", constant.OnErrCallbackIdent)
+	}
 	g.P("}")
 	g.P()
 	return nil
@@ -88,9 +133,12 @@ func (gen *Generator) GenerateStreamHandlerStruct(service *parser.Service, g *pr
 
 func (gen *Generator) GenerateStreamHandlerMethods(service *parser.Service, g *protogen.GeneratedFile) error {
 	serviceName := service.Name()
-	for _, endpoint := range service.Endpoints {
-		g.P("func (h ", service.StreamHandlerName(), ") ", endpoint.Name(), "(response ", constant.ResponseWriterIdent, ", request *", constant.RequestIdent, ") {")
+	for _, endpoint := range service.Bindings() {
+		g.P("func (h ", service.StreamHandlerName(), ") ", endpoint.BindingName(), "(response ", constant.ResponseWriterIdent, ", request *", constant.RequestIdent, ") {")
 		g.P("invoke := func(response ", constant.ResponseWriterIdent, ", request *", constant.RequestIdent, ") {")
+		if gen.SSE && endpoint.IsServerStreaming() {
+			gen.printEventStreamHandler(serviceName, endpoint, g)
+		}
 		g.P("ctx, conn, cancel, err := ", constant.WsAcceptConnIdent, "(response, request, h.acptOpts, h.cfg, h.logger)")
 		g.P("if err != nil {")
 		g.P("h.logger.Error(\"failed to accept websocket connection\",")
@@ -141,13 +189,46 @@ func (gen *Generator) GenerateStreamHandlerMethods(service *parser.Service, g *p
 		g.P("}")
 
 		g.P("}")
-		g.P(constant.ServerInvokeIdent, "(h.middleware, response, request, invoke, ", endpoint.DescName(), ".RouteInfo)")
+		g.P(constant.ServerInvokeIdent, "(h.middleware, response, request, invoke, ", endpoint.DescName(), ".RouteInfo.WithPrefix(h.prefix))")
 		g.P("}")
 		g.P()
 	}
 	return nil
 }
 
+// printEventStreamHandler serves the requests that are not websocket upgrades as server-sent events,
+// the request message is bound and validated from the http request like the ones of unary methods.
+func (gen *Generator) printEventStreamHandler(serviceName string, endpoint *parser.Endpoint, g *protogen.GeneratedFile) {
+	g.P("if !", constant.WsIsWebsocketRequestIdent, "(request) {")
+	g.P("ctx := request.Context()")
+	g.P("req, err := h.decoder.", endpoint.BindingName(), "(ctx, request)")
+	g.P("if err != nil {")
+	g.P("h.errorEncoder(ctx, err, response)")
+	g.P("return")
+	g.P("}")
+	g.P(constant.ClearOutputOnlyIdent, "(req)")
+	g.P("if err := ", constant.ValidateRequestIdent, "(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {")
+	g.P("h.errorEncoder(ctx, err, response)")
+	g.P("return")
+	g.P("}")
+	g.P("stream, err := ", constant.WsNewSSEServerStreamIdent, "[*", endpoint.OutputGoIdent(), "](ctx, response, request, h.marshalOptions, h.eventID)")
+	g.P("if err != nil {")
+	g.P("h.errorEncoder(ctx, err, response)")
+	g.P("return")
+	g.P("}")
+	g.P("err = h.service.", endpoint.Name(), "(req, stream)")
+	g.P("if err != nil && !", constant.WsIsNormalCloseIdent, "(err) {")
+	g.P("h.logger.Error(\"failed to handle server stream\",")
+	g.P("\"service\", ", strconvQuote(serviceName), ", \"method\", ", strconvQuote(endpoint.Name()), ", \"error\", err)")
+	g.P("}")
+	g.P("if err := stream.CloseWithError(err); err != nil && !", constant.WsIsNormalCloseIdent, "(err) {")
+	g.P("h.logger.Error(\"failed to close event stream\",")
+	g.P("\"service\", ", strconvQuote(serviceName), ", \"method\", ", strconvQuote(endpoint.Name()), ", \"error\", err)")
+	g.P("}")
+	g.P("return")
+	g.P("}")
+}
+
 func (gen *Generator) GenerateStreamClientStruct(service *parser.Service, g *protogen.GeneratedFile) error {
 	g.P("var _ ", service.StreamClientName(), " = (*", service.StreamClientStructName(), ")(nil)")
 	g.P()
@@ -158,28 +239,35 @@ func (gen *Generator) GenerateStreamClientStruct(service *parser.Service, g *pro
 	g.P("logger *", constant.SlogLoggerIdent)
 	g.P("marshalOptions ", constant.ProtoJsonMarshalOptionsIdent)
 	g.P("unmarshalOptions ", constant.ProtoJsonUnmarshalOptionsIdent)
+	g.P("resolver ", constant.ResolverIdent)
+	if gen.eventStream(service) {
+		g.P("client *", constant.ClientIdent)
+		g.P("encoder ", service.Unexported(service.RequestEncoderName()))
+	}
 	g.P("}")
 	g.P()
 	return nil
 }
 
 func (gen *Generator) GenerateNewStreamClientFunc(service *parser.Service, g *protogen.GeneratedFile) error {
-	g.P("func ", service.NewStreamClientName(), "(url string,")
-	g.P("logger *", constant.SlogLoggerIdent, ",")
-	g.P("marshalOpts ", constant.ProtoJsonMarshalOptionsIdent, ",")
-	g.P("unmarshalOpts ", constant.ProtoJsonUnmarshalOptionsIdent, ",")
-	g.P("dialOpts *", constant.WebsocketDialOptionsIdent, ",")
-	g.P(") ", service.StreamClientName(), " {")
-	g.P("if logger == nil {")
-	g.P("logger = ", constant.SlogDefaultIdent, "()")
-	g.P("}")
+	g.P("func ", service.NewStreamClientName(), "(url string, opts ...", constant.WsOptionIdent, ") ", service.StreamClientName(), " {")
+	g.P("options := ", constant.WsNewOptionsIdent, "(opts...)")
 	g.P("return &", service.StreamClientStructName(), "{")
 	g.P("url: url,")
-	g.P("logger: logger,")
-	g.P("connCfg: ", constant.WsDefaultConnConfigIdent, "(),")
-	g.P("marshalOptions: marshalOpts,")
-	g.P("unmarshalOptions: unmarshalOpts,")
-	g.P("dialOpts: dialOpts,")
+	g.P("dialOpts: options.DialOptions(),")
+	g.P("connCfg: options.ConnConfig(),")
+	g.P("logger: options.Logger(),")
+	g.P("marshalOptions: options.MarshalOptions(),")
+	g.P("unmarshalOptions: options.UnmarshalOptions(),")
+	g.P("resolver: options.Resolver(),")
+	if gen.eventStream(service) {
+		g.P("client: options.Client(),")
+		g.P("encoder: ", service.Unexported(service.RequestEncoderName()), "{")
+		g.P("target: ", constant.WsEventStreamTargetIdent, "(url),")
+		g.P("marshalOptions: options.MarshalOptions(),")
+		g.P("resolver: options.Resolver(),")
+		g.P("},")
+	}
 	g.P("}")
 	g.P("}")
 	g.P()
@@ -190,6 +278,8 @@ func (gen *Generator) GenerateStreamClientMethods(service *parser.Service, g *pr
 	for _, endpoint := range service.Endpoints {
 		if endpoint.IsClientStreaming() {
 			gen.generateClientStreamingMethod(service, endpoint, g)
+		} else if endpoint.IsServerStreaming() && gen.SSE {
+			gen.generateEventStreamMethod(service, endpoint, g)
 		} else if endpoint.IsServerStreaming() {
 			gen.generateServerStreamingMethod(service, endpoint, g)
 		} else if endpoint.IsBidiStreaming() {
@@ -201,10 +291,7 @@ func (gen *Generator) GenerateStreamClientMethods(service *parser.Service, g *pr
 
 func (gen *Generator) generateClientStreamingMethod(service *parser.Service, endpoint *parser.Endpoint, g *protogen.GeneratedFile) {
 	g.P("func (c *", service.StreamClientStructName(), ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ") (", constant.WsClientStreamingClientIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "], error) {")
-	g.P("u, err := ", constant.JoinPathIndent, "(c.url, ", endpoint.DescName(), ".RouteInfo.Pattern)")
-	g.P("if err != nil {")
-	g.P("return nil, err")
-	g.P("}")
+	gen.printStreamURL(endpoint, g)
 	g.P("conn, err := ", constant.WsDialAndConnectIdent, "(ctx, u, c.dialOpts, c.connCfg, c.logger)")
 	g.P("if err != nil {")
 	g.P("return nil, err")
@@ -216,10 +303,7 @@ func (gen *Generator) generateClientStreamingMethod(service *parser.Service, end
 
 func (gen *Generator) generateServerStreamingMethod(service *parser.Service, endpoint *parser.Endpoint, g *protogen.GeneratedFile) {
 	g.P("func (c *", service.StreamClientStructName(), ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ", in *", endpoint.InputGoIdent(), ") (", constant.WsServerStreamingClientIdent, "[*", endpoint.OutputGoIdent(), "], error) {")
-	g.P("u, err := ", constant.JoinPathIndent, "(c.url, ", endpoint.DescName(), ".RouteInfo.Pattern)")
-	g.P("if err != nil {")
-	g.P("return nil, err")
-	g.P("}")
+	gen.printStreamURL(endpoint, g)
 	g.P("conn, err := ", constant.WsDialAndConnectIdent, "(ctx, u, c.dialOpts, c.connCfg, c.logger)")
 	g.P("if err != nil {")
 	g.P("return nil, err")
@@ -234,12 +318,26 @@ func (gen *Generator) generateServerStreamingMethod(service *parser.Service, end
 	g.P()
 }
 
-func (gen *Generator) generateBidiStreamingMethod(service *parser.Service, endpoint *parser.Endpoint, g *protogen.GeneratedFile) {
-	g.P("func (c *", service.StreamClientStructName(), ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ") (", constant.WsBidiStreamingClientIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "], error) {")
-	g.P("u, err := ", constant.JoinPathIndent, "(c.url, ", endpoint.DescName(), ".RouteInfo.Pattern)")
+// generateEventStreamMethod consumes the server-sent events of a server-streaming method,
+// the request message is encoded into the http request like the ones of unary methods.
+func (gen *Generator) generateEventStreamMethod(service *parser.Service, endpoint *parser.Endpoint, g *protogen.GeneratedFile) {
+	g.P("func (c *", service.StreamClientStructName(), ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ", in *", endpoint.InputGoIdent(), ") (", constant.WsServerStreamingClientIdent, "[*", endpoint.OutputGoIdent(), "], error) {")
+	g.P("request, err := c.encoder.", endpoint.Name(), "(ctx, in)")
 	g.P("if err != nil {")
 	g.P("return nil, err")
 	g.P("}")
+	g.P("stream, err := ", constant.WsNewSSEClientStreamIdent, "[*", endpoint.OutputGoIdent(), "](ctx, c.client, request, c.unmarshalOptions)")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("return stream, nil")
+	g.P("}")
+	g.P()
+}
+
+func (gen *Generator) generateBidiStreamingMethod(service *parser.Service, endpoint *parser.Endpoint, g *protogen.GeneratedFile) {
+	g.P("func (c *", service.StreamClientStructName(), ") ", endpoint.Name(), "(ctx ", constant.ContextIdent, ") (", constant.WsBidiStreamingClientIdent, "[*", endpoint.InputGoIdent(), ", *", endpoint.OutputGoIdent(), "], error) {")
+	gen.printStreamURL(endpoint, g)
 	g.P("conn, err := ", constant.WsDialAndConnectIdent, "(ctx, u, c.dialOpts, c.connCfg, c.logger)")
 	g.P("if err != nil {")
 	g.P("return nil, err")
@@ -249,6 +347,18 @@ func (gen *Generator) generateBidiStreamingMethod(service *parser.Service, endpo
 	g.P()
 }
 
+// printStreamURL resolves the target of the client and joins it with the route of the endpoint.
+func (gen *Generator) printStreamURL(endpoint *parser.Endpoint, g *protogen.GeneratedFile) {
+	g.P("target, err := ", constant.WsResolveTargetIdent, "(ctx, c.resolver, c.url)")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("u, err := ", constant.JoinPathIndent, "(target, ", endpoint.DescName(), ".RouteInfo.Pattern)")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+}
+
 func strconvQuote(s string) string {
 	return `"` + s + `"`
 }
@@ -0,0 +1,197 @@
+package ts
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/openapi"
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// printClient prints the client of a service, a class with a method per endpoint calling
+// its primary binding, as the Go client does.
+func (p *printer) printClient(service *parser.Service) error {
+	p.printComment("", service.ProtoService.Comments)
+	p.P("export class ", service.Name(), "Client {")
+	p.P("  private readonly baseUrl: string;")
+	p.P("  private readonly options: ClientOptions;")
+	p.P()
+	p.P("  /** baseUrl is the target of the requests, including the prefix of the routes, e.g. http://localhost:8080/api. */")
+	p.P("  constructor(baseUrl: string, options: ClientOptions = {}) {")
+	p.P("    this.baseUrl = baseUrl;")
+	p.P("    this.options = options;")
+	p.P("  }")
+	for _, endpoint := range service.Endpoints {
+		p.P()
+		if err := p.printMethod(endpoint); err != nil {
+			return err
+		}
+	}
+	p.P("}")
+	p.P()
+	return nil
+}
+
+// printMethod prints the method calling an endpoint.
+func (p *printer) printMethod(endpoint *parser.Endpoint) error {
+	bodyMessage, bodyField, pathFields, queryFields, err := endpoint.ParseParameters()
+	if err != nil {
+		return err
+	}
+	input := p.messageType(endpoint.Input())
+	output := p.messageType(endpoint.Output())
+	doc := openapi.CommentText(endpoint.Comments())
+	if endpoint.Deprecated() {
+		doc = strings.TrimSpace(doc + "\n\n@deprecated")
+	}
+	p.printDoc("  ", doc)
+	p.P("  async ", lowerFirst(endpoint.Name()), "(req: ", input, ", init?: RequestInit): Promise<", output, "> {")
+	if timeout := endpoint.Timeout(); timeout > 0 {
+		// the request is aborted after the timeout of the method, as the Go client cancels it
+		p.P("    init = { ...init, signal: init?.signal ?? AbortSignal.timeout(", max(timeout.Milliseconds(), 1), ") };")
+	}
+
+	path := strconv.Quote(endpoint.Pattern().Template())
+	if len(pathFields) > 0 {
+		variables := endpoint.PathVariables()
+		p.P("    const path = ", p.use("urlPath"), "(", path, ", {")
+		for i, fieldPath := range pathFields {
+			p.P("      ", objectKey(variables[i].Name()), ": ", p.value(fieldPath.Leaf(), p.propertyAccess("req", fieldPath)), ",")
+		}
+		p.P("    });")
+		path = "path"
+	}
+
+	query := "undefined"
+	if len(queryFields) > 0 {
+		p.P("    const query = new URLSearchParams();")
+		for _, fieldPath := range queryFields {
+			p.printQuery(fieldPath)
+		}
+		query = "query"
+	}
+
+	// the fields bound from headers, cookies are left to the browser which does not let
+	// fetch set them
+	if headerFields := endpoint.HeaderFields(); len(headerFields) > 0 {
+		p.P("    const headers = new Headers(init?.headers);")
+		for _, headerField := range headerFields {
+			p.P("    ", p.use("appendHeader"), "(headers, ", strconv.Quote(headerField.Name), ", ", p.value(headerField.FieldPath.Leaf(), p.propertyAccess("req", headerField.FieldPath)), ");")
+		}
+		p.P("    init = { ...init, headers };")
+	}
+
+	body := "{}"
+	switch {
+	case bodyMessage != nil:
+		switch bodyMessage.Desc.FullName() {
+		case "google.api.HttpBody":
+			body = p.use("httpBody") + "(req)"
+		case "google.rpc.HttpRequest":
+			body = p.use("httpRequest") + "(req)"
+		default:
+			value := "req"
+			if metadataFields := endpoint.MetadataFields(); len(metadataFields) > 0 {
+				// the fields bound to headers and cookies are left out of the body
+				value = "{ ...req"
+				for _, metadataField := range metadataFields {
+					value += ", " + objectKey(p.propertyName(metadataField.FieldPath.Leaf())) + ": undefined"
+				}
+				value += " }"
+			}
+			body = p.use("jsonBody") + "(" + value + ")"
+		}
+	case bodyField != nil && bodyField.Leaf().Desc.Kind() == protoreflect.MessageKind:
+		access := p.propertyAccess("req", bodyField)
+		switch bodyField.Leaf().Message.Desc.FullName() {
+		case "google.api.HttpBody":
+			body = p.use("httpBody") + "(" + access + ")"
+		default:
+			body = p.use("jsonBody") + "(" + access + ")"
+		}
+	}
+
+	// google.rpc.HttpResponse holds responses of any status
+	anyStatus := endpoint.Output().Desc.FullName() == "google.rpc.HttpResponse"
+	call := fmt.Sprintf("invoke(this.baseUrl, this.options, %s, %s, %s, %s, init, %t);", strconv.Quote(endpoint.Method()), path, query, body, anyStatus)
+
+	switch responseBody := endpoint.ResponseBody(); responseBody {
+	case "", "*":
+		p.P("    const response = await ", call)
+		switch endpoint.Output().Desc.FullName() {
+		case "google.api.HttpBody":
+			p.P("    return ", p.use("decodeHttpBody"), "(response);")
+		case "google.rpc.HttpResponse":
+			p.P("    return ", p.use("decodeHttpResponse"), "(response);")
+		default:
+			p.P("    return ", p.use("decodeJson"), "<", output, ">(response);")
+		}
+	default:
+		field := parser.FindField(responseBody, endpoint.Output())
+		if field == nil {
+			return fmt.Errorf("%s, failed to find body response field %s", endpoint.FullName(), responseBody)
+		}
+		if field.Desc.Kind() != protoreflect.MessageKind {
+			p.P("    await ", call)
+			p.P("    return {};")
+			break
+		}
+		p.P("    const response = await ", call)
+		switch field.Message.Desc.FullName() {
+		case "google.api.HttpBody":
+			p.P("    return { ", p.propertyName(field), ": await ", p.use("decodeHttpBody"), "(response) };")
+		default:
+			p.P("    return { ", p.propertyName(field), ": await ", p.use("decodeJson"), "<", p.kindType(field), ">(response) };")
+		}
+	}
+	p.P("  }")
+	return nil
+}
+
+// printQuery prints the statement adding a query field to the query, keyed by the proto
+// names of its path as the server binds it.
+func (p *printer) printQuery(fieldPath parser.FieldPath) {
+	field := fieldPath.Leaf()
+	name := strconv.Quote(fieldPath.Name())
+	access := p.propertyAccess("req", fieldPath)
+	if field.Desc.IsMap() {
+		p.P("    ", p.use("appendMapQuery"), "(query, ", name, ", ", access, ", (v) => ", p.value(field.Message.Fields[1], "v"), ");")
+		return
+	}
+	value := p.value(field, access)
+	if field.Desc.IsList() && value != access {
+		value = access + "?.map((v) => " + p.value(field, "v") + ")"
+	}
+	p.P("    ", p.use("appendQuery"), "(query, ", name, ", ", value, ");")
+}
+
+// value returns the expression of the parameter value of a singular field read by access.
+// The JSON values are the parameter values but for enums, bound by their numbers, and
+// field masks, bound by the proto names of their paths.
+func (p *printer) value(field *protogen.Field, access string) string {
+	switch {
+	case field.Enum != nil && field.Enum.Desc.FullName() != "google.protobuf.NullValue":
+		return p.use("enumNumber") + "(" + enumNumbers(field.Enum) + ", " + access + ")"
+	case field.Message != nil && field.Message.Desc.FullName() == "google.protobuf.FieldMask":
+		return p.use("fieldMaskPaths") + "(" + access + ")"
+	}
+	return access
+}
+
+// objectKey returns name as the key of an object literal, quoted unless it is an identifier.
+func objectKey(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
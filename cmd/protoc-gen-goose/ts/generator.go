@@ -0,0 +1,196 @@
+// Package ts generates TypeScript clients of the unary methods of services. A generated file
+// holds the interfaces of the messages the methods send and receive, shaped as protojson
+// writes them, and a fetch-based client per service encoding the requests as the Go clients
+// do: path and query parameters are bound as the server binds them, the body is protojson
+// and the errors written by goose.DefaultEncodeError are thrown as GooseError.
+package ts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/openapi"
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Generator generates the TypeScript clients of proto files.
+type Generator struct {
+	// ProtoNames names the properties of the interfaces by the proto names of the fields,
+	// for servers marshaling with protojson UseProtoNames.
+	ProtoNames bool
+}
+
+// Generate writes the TypeScript client of the unary methods of the given services into
+// _goose.ts. Files without unary methods get no client.
+func (gen *Generator) Generate(plugin *protogen.Plugin, file *protogen.File, services []*parser.Service) error {
+	var unaries []*parser.Service
+	for _, service := range services {
+		if unary := service.UnaryService(); len(unary.Endpoints) > 0 {
+			unaries = append(unaries, unary)
+		}
+	}
+	if len(unaries) == 0 {
+		return nil
+	}
+	types, err := collectTypes(unaries)
+	if err != nil {
+		return err
+	}
+	p := &printer{
+		g:          plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+"_goose.ts", ""),
+		protoNames: gen.ProtoNames,
+		names:      types.names,
+	}
+	p.P("// Code generated by protoc-gen-goose. DO NOT EDIT.")
+	p.P("// source: ", file.Desc.Path())
+	p.P()
+	p.P("/* eslint-disable */")
+	p.P()
+	for _, enum := range types.enums {
+		p.printEnum(enum)
+	}
+	for _, message := range types.messages {
+		p.printMessage(message)
+	}
+	for _, service := range unaries {
+		if err := p.printClient(service); err != nil {
+			return err
+		}
+	}
+	// the runtime follows the clients, which mark the helpers they use
+	p.printRuntime()
+	return nil
+}
+
+// printer writes TypeScript to a generated file.
+type printer struct {
+	g          *protogen.GeneratedFile
+	protoNames bool
+	// names are the names of the types, see collectTypes.
+	names map[protoreflect.FullName]string
+	// used are the names of the helpers of the runtime the clients use.
+	used map[string]bool
+}
+
+// P prints a line of TypeScript.
+func (p *printer) P(v ...any) {
+	p.g.P(v...)
+}
+
+// printComment prints the comments of a proto element as a JSDoc block, indented by indent.
+func (p *printer) printComment(indent string, comments protogen.CommentSet) {
+	p.printDoc(indent, openapi.CommentText(comments))
+}
+
+// printDoc prints text as a JSDoc block, indented by indent.
+func (p *printer) printDoc(indent string, text string) {
+	if text == "" {
+		return
+	}
+	text = strings.ReplaceAll(text, "*/", "*\\/")
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		p.P(indent, "/** ", lines[0], " */")
+		return
+	}
+	p.P(indent, "/**")
+	for _, line := range lines {
+		p.P(strings.TrimRight(indent+" * "+line, " "))
+	}
+	p.P(indent, " */")
+}
+
+// reserved are the global names the runtime refers to and the names it declares. Messages
+// and enums named so get the suffix Message or Enum, so that they do not shadow them.
+var reserved = map[string]bool{
+	"Array": true, "BodyInit": true, "Error": true, "Headers": true, "HeadersInit": true,
+	"JSON": true, "Object": true, "Promise": true, "Record": true, "RequestInit": true,
+	"Response": true, "String": true, "URLSearchParams": true, "Uint8Array": true,
+	"ClientOptions": true, "GooseError": true, "RequestBody": true, "Value": true,
+}
+
+// types are the messages and enums the methods of the services send and receive.
+type types struct {
+	messages []*protogen.Message
+	enums    []*protogen.Enum
+	// names are the names of the interfaces and unions of the messages and enums.
+	names map[protoreflect.FullName]string
+}
+
+// collectTypes collects the messages reachable from the requests and responses of the
+// services, in the order they are reached. Well-known types are written by their JSON
+// forms and get no interface. Types are named by their Go names, which must be unique.
+func collectTypes(services []*parser.Service) (*types, error) {
+	collected := &types{names: make(map[protoreflect.FullName]string)}
+	owners := make(map[string]protoreflect.FullName)
+	claim := func(name string, owner protoreflect.FullName) error {
+		if other, ok := owners[name]; ok && other != owner {
+			return fmt.Errorf("ts: %s and %s are both named %s", other, owner, name)
+		}
+		owners[name] = owner
+		return nil
+	}
+	for _, service := range services {
+		if err := claim(service.Name()+"Client", service.ProtoService.Desc.FullName()); err != nil {
+			return nil, err
+		}
+	}
+	name := func(goName string, suffix string, fullName protoreflect.FullName) error {
+		if reserved[goName] {
+			goName += suffix
+		}
+		collected.names[fullName] = goName
+		return claim(goName, fullName)
+	}
+	var addMessage func(message *protogen.Message) error
+	addEnum := func(enum *protogen.Enum) error {
+		fullName := enum.Desc.FullName()
+		if _, ok := collected.names[fullName]; ok || fullName == "google.protobuf.NullValue" {
+			return nil
+		}
+		collected.enums = append(collected.enums, enum)
+		if err := name(enum.GoIdent.GoName, "Enum", fullName); err != nil {
+			return err
+		}
+		return claim(enum.GoIdent.GoName+"Numbers", fullName)
+	}
+	seen := make(map[*protogen.Message]bool)
+	addMessage = func(message *protogen.Message) error {
+		if seen[message] || isWellKnownType(message) {
+			return nil
+		}
+		seen[message] = true
+		if !message.Desc.IsMapEntry() {
+			collected.messages = append(collected.messages, message)
+			if err := name(message.GoIdent.GoName, "Message", message.Desc.FullName()); err != nil {
+				return err
+			}
+		}
+		for _, field := range message.Fields {
+			if field.Enum != nil {
+				if err := addEnum(field.Enum); err != nil {
+					return err
+				}
+			}
+			if field.Message != nil {
+				if err := addMessage(field.Message); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for _, service := range services {
+		for _, endpoint := range service.Endpoints {
+			if err := addMessage(endpoint.Input()); err != nil {
+				return nil, err
+			}
+			if err := addMessage(endpoint.Output()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return collected, nil
+}
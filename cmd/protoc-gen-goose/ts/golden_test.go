@@ -0,0 +1,140 @@
+package ts
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/soyacen/goose/cmd/protoc-gen-goose/parser"
+	"github.com/soyacen/goose/example/body"
+	"github.com/soyacen/goose/example/path"
+	"github.com/soyacen/goose/example/query"
+	"github.com/soyacen/goose/example/response_body"
+	"github.com/soyacen/goose/example/user"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// generate runs the generator on file as protoc would run it and returns the generated client.
+func generate(t *testing.T, file protoreflect.FileDescriptor, gen *Generator) string {
+	t.Helper()
+	var files []*descriptorpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(file)
+	parameter := "paths=source_relative"
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.Path()},
+		Parameter:      &parameter,
+		ProtoFile:      files,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	services, err := parser.NewServices(plugin.FilesByPath[file.Path()])
+	if err != nil {
+		t.Fatalf("failed to parse services: %v", err)
+	}
+	if err := gen.Generate(plugin, plugin.FilesByPath[file.Path()], services); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	resp := plugin.Response()
+	if resp.Error != nil {
+		t.Fatalf("plugin error: %s", resp.GetError())
+	}
+	if len(resp.File) != 1 {
+		t.Fatalf("expected 1 generated file, got %d", len(resp.File))
+	}
+	if name, want := resp.File[0].GetName(), strings.TrimSuffix(file.Path(), ".proto")+"_goose.ts"; name != want {
+		t.Fatalf("unexpected file name %s, want %s", name, want)
+	}
+	return resp.File[0].GetContent()
+}
+
+func TestGolden(t *testing.T) {
+	tests := map[string]protoreflect.FileDescriptor{
+		"user_goose.ts":          user.File_example_user_user_proto,
+		"body_goose.ts":          body.File_example_body_body_proto,
+		"path_goose.ts":          path.File_example_path_path_proto,
+		"query_goose.ts":         query.File_example_query_query_proto,
+		"response_body_goose.ts": response_body.File_example_response_body_response_body_proto,
+	}
+	for name, file := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := generate(t, file, &Generator{})
+			golden := filepath.Join("testdata", name)
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("%s does not match the generated client, run go test -update to update it", golden)
+			}
+		})
+	}
+}
+
+func TestProtoNames(t *testing.T) {
+	got := generate(t, user.File_example_user_user_proto, &Generator{ProtoNames: true})
+	for _, want := range []string{
+		"  page_num?: string;",
+		`    appendQuery(query, "page_num", req.page_num);`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated client does not contain %q", want)
+		}
+	}
+}
+
+func TestReservedNames(t *testing.T) {
+	got := generate(t, body.File_example_body_body_proto, &Generator{})
+	// the message Response must not shadow the Response of fetch
+	for _, want := range []string{
+		"export interface ResponseMessage {",
+		"Promise<ResponseMessage>",
+		"async function decodeJson<T>(response: Response): Promise<T> {",
+		// google.protobuf.Empty is written by its JSON form
+		"async nonBody(req: {}, init?: RequestInit): Promise<ResponseMessage> {",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated client does not contain %q", want)
+		}
+	}
+}
+
+func TestUsedHelpers(t *testing.T) {
+	got := generate(t, user.File_example_user_user_proto, &Generator{})
+	for _, unused := range []string{"function appendMapQuery", "function enumNumber", "function base64Decode", "function decodeHttpBody"} {
+		if strings.Contains(got, unused) {
+			t.Errorf("generated client contains the unused helper %q", unused)
+		}
+	}
+	for _, used := range []string{"type Value =", "function urlPath", "function appendQuery", "function jsonBody", "function decodeJson"} {
+		if !strings.Contains(got, used) {
+			t.Errorf("generated client does not contain the helper %q", used)
+		}
+	}
+}
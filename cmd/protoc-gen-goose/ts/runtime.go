@@ -0,0 +1,217 @@
+package ts
+
+import "strings"
+
+// runtime is the code every client uses. It mirrors the Go client: the errors written by
+// goose.DefaultEncodeError are decoded as goose.DefaultDecodeError decodes them.
+const runtime = `/** ClientOptions configures a client. */
+export interface ClientOptions {
+  /** fetch sends the requests, globalThis.fetch by default. */
+  fetch?: typeof fetch;
+  /** headers are added to every request. */
+  headers?: HeadersInit;
+}
+
+/**
+ * GooseError is thrown for the errors written by goose.DefaultEncodeError, which are marked
+ * by the X-Goose-Error header, and for other responses with an error status.
+ */
+export class GooseError extends Error {
+  /** status is the HTTP status code of the response. */
+  readonly status: number;
+  /** headers are the headers the error carries, listed by the X-Goose-Error header. */
+  readonly headers: Headers;
+  /** body is the JSON body of the error, or its text if it is not JSON. */
+  readonly body: unknown;
+
+  constructor(status: number, headers: Headers, body: unknown) {
+    super("goose: http error, status code: " + status + ", body: " + (typeof body === "string" ? body : JSON.stringify(body)));
+    this.name = "GooseError";
+    this.status = status;
+    this.headers = headers;
+    this.body = body;
+  }
+}
+
+interface RequestBody {
+  body?: BodyInit;
+  contentType?: string;
+  headers?: { key?: string; value?: string }[];
+}
+
+async function invoke(
+  baseUrl: string,
+  options: ClientOptions,
+  method: string,
+  path: string,
+  query: URLSearchParams | undefined,
+  body: RequestBody,
+  init: RequestInit | undefined,
+  anyStatus: boolean,
+): Promise<Response> {
+  let url = baseUrl.replace(/\/+$/, "") + path;
+  const search = query?.toString();
+  if (search) {
+    url += "?" + search;
+  }
+  const headers = new Headers(options.headers);
+  new Headers(init?.headers).forEach((value, key) => headers.set(key, value));
+  if (body.contentType) {
+    headers.set("Content-Type", body.contentType);
+  }
+  for (const header of body.headers ?? []) {
+    headers.append(header.key ?? "", header.value ?? "");
+  }
+  const send = options.fetch ?? globalThis.fetch;
+  const response = await send(url, { ...init, method, headers, body: body.body });
+  const keys = response.headers.get("X-Goose-Error");
+  if (keys === null && (response.ok || anyStatus)) {
+    return response;
+  }
+  const text = await response.text();
+  let errorBody: unknown = text;
+  try {
+    errorBody = JSON.parse(text);
+  } catch {
+    // plain text
+  }
+  let errorHeaders = response.headers;
+  if (keys !== null) {
+    errorHeaders = new Headers();
+    try {
+      for (const key of JSON.parse(keys) as string[]) {
+        const value = response.headers.get(key);
+        if (value !== null && !errorHeaders.has(key)) {
+          errorHeaders.set(key, value);
+        }
+      }
+    } catch {
+      // malformed key list
+    }
+  }
+  throw new GooseError(response.status, errorHeaders, errorBody);
+}`
+
+// helper is a function of the runtime printed when a client uses it.
+type helper struct {
+	name string
+	// deps are the helpers the helper calls.
+	deps []string
+	code string
+}
+
+// helpers are the encoding and decoding functions of the clients, in the order they are
+// printed. Query values are formatted as the goose.Format* functions format them and path
+// values are substituted as goose.URLPath does.
+var helpers = []helper{
+	{name: "Value", code: `type Value = string | number | boolean | null | undefined;`},
+	{name: "urlPath", deps: []string{"Value"}, code: `function urlPath(template: string, pairs: { [name: string]: Value }): string {
+  return template.replace(/\{([^}]+?)(\.\.\.)?\}/g, (_, name: string) => {
+    const value = pairs[name];
+    if (value === undefined || value === null) {
+      return "";
+    }
+    return String(value).split("/").map(encodeURIComponent).join("/");
+  });
+}`},
+	{name: "appendQuery", deps: []string{"Value"}, code: `function appendQuery(query: URLSearchParams, key: string, value: Value | Value[]): void {
+  for (const v of Array.isArray(value) ? value : [value]) {
+    if (v !== undefined && v !== null) {
+      query.append(key, String(v));
+    }
+  }
+}`},
+	{name: "appendHeader", deps: []string{"Value"}, code: `function appendHeader(headers: Headers, key: string, value: Value): void {
+  if (value !== undefined && value !== null && value !== "") {
+    headers.append(key, String(value));
+  }
+}`},
+	{name: "appendMapQuery", deps: []string{"Value", "appendQuery"}, code: `function appendMapQuery<V>(
+  query: URLSearchParams,
+  key: string,
+  map: { [key: string]: V } | undefined,
+  format: (value: V) => Value,
+): void {
+  for (const [k, v] of Object.entries(map ?? {})) {
+    appendQuery(query, key + "." + k, format(v));
+  }
+}`},
+	{name: "enumNumber", code: `function enumNumber<E extends string>(numbers: Record<E, number>, value: E | number | undefined): number | undefined {
+  return typeof value === "string" ? numbers[value] : value;
+}`},
+	{name: "fieldMaskPaths", code: `function fieldMaskPaths(mask: string | undefined): string | undefined {
+  return mask?.replace(/[A-Z]/g, (c) => "_" + c.toLowerCase());
+}`},
+	{name: "jsonBody", code: `function jsonBody(value: unknown): RequestBody {
+  return { body: JSON.stringify(value ?? {}), contentType: "application/json" };
+}`},
+	{name: "base64Decode", code: `function base64Decode(data: string | undefined) {
+  const binary = atob((data ?? "").replace(/-/g, "+").replace(/_/g, "/"));
+  const bytes = new Uint8Array(binary.length);
+  for (let i = 0; i < binary.length; i++) {
+    bytes[i] = binary.charCodeAt(i);
+  }
+  return bytes;
+}`},
+	{name: "base64Encode", code: `function base64Encode(bytes: Uint8Array): string {
+  let binary = "";
+  for (let i = 0; i < bytes.length; i++) {
+    binary += String.fromCharCode(bytes[i]);
+  }
+  return btoa(binary);
+}`},
+	{name: "httpBody", deps: []string{"base64Decode"}, code: `function httpBody(value: { contentType?: string; data?: string } | undefined): RequestBody {
+  return { body: base64Decode(value?.data), contentType: value?.contentType };
+}`},
+	{name: "httpRequest", deps: []string{"base64Decode"}, code: `function httpRequest(value: { headers?: { key?: string; value?: string }[]; body?: string }): RequestBody {
+  return { body: base64Decode(value.body), headers: value.headers };
+}`},
+	{name: "decodeJson", code: `async function decodeJson<T>(response: Response): Promise<T> {
+  const text = await response.text();
+  return (text ? JSON.parse(text) : {}) as T;
+}`},
+	{name: "decodeHttpBody", deps: []string{"base64Encode"}, code: `async function decodeHttpBody(response: Response): Promise<{ contentType: string; data: string }> {
+  const data = new Uint8Array(await response.arrayBuffer());
+  return { contentType: response.headers.get("Content-Type") ?? "", data: base64Encode(data) };
+}`},
+	{name: "decodeHttpResponse", deps: []string{"base64Encode"}, code: `async function decodeHttpResponse(
+  response: Response,
+): Promise<{ status: number; reason: string; headers: { key: string; value: string }[]; body: string }> {
+  const headers: { key: string; value: string }[] = [];
+  response.headers.forEach((value, key) => headers.push({ key, value }));
+  const body = new Uint8Array(await response.arrayBuffer());
+  return { status: response.status, reason: response.statusText, headers, body: base64Encode(body) };
+}`},
+}
+
+// use marks a helper and the helpers it calls as used and returns its name.
+func (p *printer) use(name string) string {
+	if p.used == nil {
+		p.used = make(map[string]bool)
+	}
+	if p.used[name] {
+		return name
+	}
+	p.used[name] = true
+	for _, h := range helpers {
+		if h.name == name {
+			for _, dep := range h.deps {
+				p.use(dep)
+			}
+		}
+	}
+	return name
+}
+
+// printRuntime prints the runtime and the helpers used by the clients, the unused ones
+// are left out so that the file compiles with noUnusedLocals.
+func (p *printer) printRuntime() {
+	p.P(strings.TrimSpace(runtime))
+	p.P()
+	for _, h := range helpers {
+		if p.used[h.name] {
+			p.P(h.code)
+			p.P()
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package ts
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wellKnownTypes are the TypeScript types of the JSON forms of the well-known types.
+var wellKnownTypes = map[protoreflect.FullName]string{
+	"google.protobuf.Timestamp":   "string",
+	"google.protobuf.Duration":    "string",
+	"google.protobuf.FieldMask":   "string",
+	"google.protobuf.Empty":       "{}",
+	"google.protobuf.Struct":      "{ [key: string]: unknown }",
+	"google.protobuf.Value":       "unknown",
+	"google.protobuf.ListValue":   "unknown[]",
+	"google.protobuf.Any":         `{ "@type": string; [key: string]: unknown }`,
+	"google.protobuf.StringValue": "string | null",
+	"google.protobuf.BytesValue":  "string | null",
+	"google.protobuf.BoolValue":   "boolean | null",
+	"google.protobuf.Int32Value":  "number | null",
+	"google.protobuf.UInt32Value": "number | null",
+	"google.protobuf.Int64Value":  "string | null",
+	"google.protobuf.UInt64Value": "string | null",
+	"google.protobuf.FloatValue":  "number | null",
+	"google.protobuf.DoubleValue": "number | null",
+}
+
+func isWellKnownType(message *protogen.Message) bool {
+	_, ok := wellKnownTypes[message.Desc.FullName()]
+	return ok
+}
+
+// enumNumbers returns the name of the constant mapping the value names of an enum to their
+// numbers, path and query parameters bind enums by their numbers.
+func enumNumbers(enum *protogen.Enum) string {
+	return enum.GoIdent.GoName + "Numbers"
+}
+
+// messageType returns the TypeScript type of the JSON form of a message.
+func (p *printer) messageType(message *protogen.Message) string {
+	if wkt, ok := wellKnownTypes[message.Desc.FullName()]; ok {
+		return wkt
+	}
+	return p.names[message.Desc.FullName()]
+}
+
+// printEnum prints an enum as the union of its value names, as protojson writes it, and the
+// constant mapping the names to their numbers.
+func (p *printer) printEnum(enum *protogen.Enum) {
+	p.printComment("", enum.Comments)
+	name := p.names[enum.Desc.FullName()]
+	p.P("export type ", name, " =")
+	for i, value := range enum.Values {
+		end := ""
+		if i == len(enum.Values)-1 {
+			end = ";"
+		}
+		p.P("  | ", strconv.Quote(string(value.Desc.Name())), end)
+	}
+	p.P()
+	p.P("export const ", enumNumbers(enum), ": Record<", name, ", number> = {")
+	for _, value := range enum.Values {
+		p.P("  ", value.Desc.Name(), ": ", value.Desc.Number(), ",")
+	}
+	p.P("};")
+	p.P()
+}
+
+// printMessage prints a message as an interface of its JSON form. protojson omits fields
+// holding their zero values, so every property is optional.
+func (p *printer) printMessage(message *protogen.Message) {
+	p.printComment("", message.Comments)
+	p.P("export interface ", p.names[message.Desc.FullName()], " {")
+	for _, field := range message.Fields {
+		p.printComment("  ", field.Comments)
+		p.P("  ", p.propertyName(field), "?: ", p.fieldType(field), ";")
+	}
+	p.P("}")
+	p.P()
+}
+
+// propertyName returns the name of the property of a field, its JSON name unless the
+// properties are named by the proto names.
+func (p *printer) propertyName(field *protogen.Field) string {
+	if p.protoNames {
+		return string(field.Desc.Name())
+	}
+	return field.Desc.JSONName()
+}
+
+// propertyAccess returns the expression reading the field path from the variable name,
+// absent messages along the path read as undefined.
+func (p *printer) propertyAccess(name string, fieldPath []*protogen.Field) string {
+	for i, field := range fieldPath {
+		if i > 0 {
+			name += "?"
+		}
+		name += "." + p.propertyName(field)
+	}
+	return name
+}
+
+// fieldType returns the TypeScript type of the JSON value of a field.
+func (p *printer) fieldType(field *protogen.Field) string {
+	switch {
+	case field.Desc.IsMap():
+		return "{ [key: string]: " + p.kindType(field.Message.Fields[1]) + " }"
+	case field.Desc.IsList():
+		elem := p.kindType(field)
+		if strings.Contains(elem, " | ") {
+			elem = "(" + elem + ")"
+		}
+		return elem + "[]"
+	}
+	return p.kindType(field)
+}
+
+// kindType returns the TypeScript type of the JSON value of a singular field. 64-bit
+// integers and bytes are strings, as protojson writes them.
+func (p *printer) kindType(field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "boolean"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "number"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+		protoreflect.StringKind, protoreflect.BytesKind:
+		return "string"
+	case protoreflect.EnumKind:
+		if field.Enum.Desc.FullName() == "google.protobuf.NullValue" {
+			return "null"
+		}
+		return p.names[field.Enum.Desc.FullName()]
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return p.messageType(field.Message)
+	}
+	return "unknown"
+}
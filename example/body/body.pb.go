@@ -113,6 +113,50 @@ func (x *NamedBodyRequest) GetBody() *NamedBodyRequest_Body {
 	return nil
 }
 
+type NestedBodyRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Item          *NestedBodyRequest_Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedBodyRequest) Reset() {
+	*x = NestedBodyRequest{}
+	mi := &file_example_body_body_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedBodyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedBodyRequest) ProtoMessage() {}
+
+func (x *NestedBodyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_body_body_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedBodyRequest.ProtoReflect.Descriptor instead.
+func (*NestedBodyRequest) Descriptor() ([]byte, []int) {
+	return file_example_body_body_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NestedBodyRequest) GetItem() *NestedBodyRequest_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
 type HttpBodyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Body          *httpbody.HttpBody     `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
@@ -122,7 +166,7 @@ type HttpBodyRequest struct {
 
 func (x *HttpBodyRequest) Reset() {
 	*x = HttpBodyRequest{}
-	mi := &file_example_body_body_proto_msgTypes[2]
+	mi := &file_example_body_body_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -134,7 +178,7 @@ func (x *HttpBodyRequest) String() string {
 func (*HttpBodyRequest) ProtoMessage() {}
 
 func (x *HttpBodyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_example_body_body_proto_msgTypes[2]
+	mi := &file_example_body_body_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -147,7 +191,7 @@ func (x *HttpBodyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpBodyRequest.ProtoReflect.Descriptor instead.
 func (*HttpBodyRequest) Descriptor() ([]byte, []int) {
-	return file_example_body_body_proto_rawDescGZIP(), []int{2}
+	return file_example_body_body_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *HttpBodyRequest) GetBody() *httpbody.HttpBody {
@@ -166,7 +210,7 @@ type Response struct {
 
 func (x *Response) Reset() {
 	*x = Response{}
-	mi := &file_example_body_body_proto_msgTypes[3]
+	mi := &file_example_body_body_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -178,7 +222,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_example_body_body_proto_msgTypes[3]
+	mi := &file_example_body_body_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +235,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_example_body_body_proto_rawDescGZIP(), []int{3}
+	return file_example_body_body_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Response) GetMessage() string {
@@ -210,7 +254,7 @@ type NamedBodyRequest_Body struct {
 
 func (x *NamedBodyRequest_Body) Reset() {
 	*x = NamedBodyRequest_Body{}
-	mi := &file_example_body_body_proto_msgTypes[4]
+	mi := &file_example_body_body_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +266,7 @@ func (x *NamedBodyRequest_Body) String() string {
 func (*NamedBodyRequest_Body) ProtoMessage() {}
 
 func (x *NamedBodyRequest_Body) ProtoReflect() protoreflect.Message {
-	mi := &file_example_body_body_proto_msgTypes[4]
+	mi := &file_example_body_body_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,6 +289,58 @@ func (x *NamedBodyRequest_Body) GetMessage() string {
 	return ""
 }
 
+type NestedBodyRequest_Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          *NamedBodyRequest_Body `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedBodyRequest_Item) Reset() {
+	*x = NestedBodyRequest_Item{}
+	mi := &file_example_body_body_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedBodyRequest_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedBodyRequest_Item) ProtoMessage() {}
+
+func (x *NestedBodyRequest_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_example_body_body_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedBodyRequest_Item.ProtoReflect.Descriptor instead.
+func (*NestedBodyRequest_Item) Descriptor() ([]byte, []int) {
+	return file_example_body_body_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *NestedBodyRequest_Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NestedBodyRequest_Item) GetBody() *NamedBodyRequest_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
 var File_example_body_body_proto protoreflect.FileDescriptor
 
 const file_example_body_body_proto_rawDesc = "" +
@@ -255,14 +351,21 @@ const file_example_body_body_proto_rawDesc = "" +
 	"\x10NamedBodyRequest\x12D\n" +
 	"\x04body\x18\x01 \x01(\v20.leo.goose.example.body.v1.NamedBodyRequest.BodyR\x04body\x1a \n" +
 	"\x04Body\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\";\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xb8\x01\n" +
+	"\x11NestedBodyRequest\x12E\n" +
+	"\x04item\x18\x01 \x01(\v21.leo.goose.example.body.v1.NestedBodyRequest.ItemR\x04item\x1a\\\n" +
+	"\x04Item\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12D\n" +
+	"\x04body\x18\x02 \x01(\v20.leo.goose.example.body.v1.NamedBodyRequest.BodyR\x04body\";\n" +
 	"\x0fHttpBodyRequest\x12(\n" +
 	"\x04body\x18\x01 \x01(\v2\x14.google.api.HttpBodyR\x04body\"$\n" +
 	"\bResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xc1\x05\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xd1\x06\n" +
 	"\x04Body\x12q\n" +
 	"\bStarBody\x12&.leo.goose.example.body.v1.BodyRequest\x1a#.leo.goose.example.body.v1.Response\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/star/body\x12{\n" +
-	"\tNamedBody\x12+.leo.goose.example.body.v1.NamedBodyRequest\x1a#.leo.goose.example.body.v1.Response\"\x1c\x82\xd3\xe4\x93\x02\x16:\x04body\"\x0e/v1/named/body\x12]\n" +
+	"\tNamedBody\x12+.leo.goose.example.body.v1.NamedBodyRequest\x1a#.leo.goose.example.body.v1.Response\"\x1c\x82\xd3\xe4\x93\x02\x16:\x04body\"\x0e/v1/named/body\x12\x8d\x01\n" +
+	"\n" +
+	"NestedBody\x12,.leo.goose.example.body.v1.NestedBodyRequest\x1a#.leo.goose.example.body.v1.Response\",\x82\xd3\xe4\x93\x02&:\titem.body\"\x19/v1/nested/{item.id}/body\x12]\n" +
 	"\aNonBody\x12\x16.google.protobuf.Empty\x1a#.leo.goose.example.body.v1.Response\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/user_body\x12q\n" +
 	"\x10HttpBodyStarBody\x12\x14.google.api.HttpBody\x1a#.leo.goose.example.body.v1.Response\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/http/body/star/body\x12\x8c\x01\n" +
 	"\x11HttpBodyNamedBody\x12*.leo.goose.example.body.v1.HttpBodyRequest\x1a#.leo.goose.example.body.v1.Response\"&\x82\xd3\xe4\x93\x02 :\x04body\x1a\x18/v1/http/body/named/body\x12h\n" +
@@ -280,37 +383,43 @@ func file_example_body_body_proto_rawDescGZIP() []byte {
 	return file_example_body_body_proto_rawDescData
 }
 
-var file_example_body_body_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_example_body_body_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_example_body_body_proto_goTypes = []any{
-	(*BodyRequest)(nil),           // 0: leo.goose.example.body.v1.BodyRequest
-	(*NamedBodyRequest)(nil),      // 1: leo.goose.example.body.v1.NamedBodyRequest
-	(*HttpBodyRequest)(nil),       // 2: leo.goose.example.body.v1.HttpBodyRequest
-	(*Response)(nil),              // 3: leo.goose.example.body.v1.Response
-	(*NamedBodyRequest_Body)(nil), // 4: leo.goose.example.body.v1.NamedBodyRequest.Body
-	(*httpbody.HttpBody)(nil),     // 5: google.api.HttpBody
-	(*emptypb.Empty)(nil),         // 6: google.protobuf.Empty
-	(*http.HttpRequest)(nil),      // 7: google.rpc.HttpRequest
+	(*BodyRequest)(nil),            // 0: leo.goose.example.body.v1.BodyRequest
+	(*NamedBodyRequest)(nil),       // 1: leo.goose.example.body.v1.NamedBodyRequest
+	(*NestedBodyRequest)(nil),      // 2: leo.goose.example.body.v1.NestedBodyRequest
+	(*HttpBodyRequest)(nil),        // 3: leo.goose.example.body.v1.HttpBodyRequest
+	(*Response)(nil),               // 4: leo.goose.example.body.v1.Response
+	(*NamedBodyRequest_Body)(nil),  // 5: leo.goose.example.body.v1.NamedBodyRequest.Body
+	(*NestedBodyRequest_Item)(nil), // 6: leo.goose.example.body.v1.NestedBodyRequest.Item
+	(*httpbody.HttpBody)(nil),      // 7: google.api.HttpBody
+	(*emptypb.Empty)(nil),          // 8: google.protobuf.Empty
+	(*http.HttpRequest)(nil),       // 9: google.rpc.HttpRequest
 }
 var file_example_body_body_proto_depIdxs = []int32{
-	4, // 0: leo.goose.example.body.v1.NamedBodyRequest.body:type_name -> leo.goose.example.body.v1.NamedBodyRequest.Body
-	5, // 1: leo.goose.example.body.v1.HttpBodyRequest.body:type_name -> google.api.HttpBody
-	0, // 2: leo.goose.example.body.v1.Body.StarBody:input_type -> leo.goose.example.body.v1.BodyRequest
-	1, // 3: leo.goose.example.body.v1.Body.NamedBody:input_type -> leo.goose.example.body.v1.NamedBodyRequest
-	6, // 4: leo.goose.example.body.v1.Body.NonBody:input_type -> google.protobuf.Empty
-	5, // 5: leo.goose.example.body.v1.Body.HttpBodyStarBody:input_type -> google.api.HttpBody
-	2, // 6: leo.goose.example.body.v1.Body.HttpBodyNamedBody:input_type -> leo.goose.example.body.v1.HttpBodyRequest
-	7, // 7: leo.goose.example.body.v1.Body.HttpRequest:input_type -> google.rpc.HttpRequest
-	3, // 8: leo.goose.example.body.v1.Body.StarBody:output_type -> leo.goose.example.body.v1.Response
-	3, // 9: leo.goose.example.body.v1.Body.NamedBody:output_type -> leo.goose.example.body.v1.Response
-	3, // 10: leo.goose.example.body.v1.Body.NonBody:output_type -> leo.goose.example.body.v1.Response
-	3, // 11: leo.goose.example.body.v1.Body.HttpBodyStarBody:output_type -> leo.goose.example.body.v1.Response
-	3, // 12: leo.goose.example.body.v1.Body.HttpBodyNamedBody:output_type -> leo.goose.example.body.v1.Response
-	3, // 13: leo.goose.example.body.v1.Body.HttpRequest:output_type -> leo.goose.example.body.v1.Response
-	8, // [8:14] is the sub-list for method output_type
-	2, // [2:8] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5,  // 0: leo.goose.example.body.v1.NamedBodyRequest.body:type_name -> leo.goose.example.body.v1.NamedBodyRequest.Body
+	6,  // 1: leo.goose.example.body.v1.NestedBodyRequest.item:type_name -> leo.goose.example.body.v1.NestedBodyRequest.Item
+	7,  // 2: leo.goose.example.body.v1.HttpBodyRequest.body:type_name -> google.api.HttpBody
+	5,  // 3: leo.goose.example.body.v1.NestedBodyRequest.Item.body:type_name -> leo.goose.example.body.v1.NamedBodyRequest.Body
+	0,  // 4: leo.goose.example.body.v1.Body.StarBody:input_type -> leo.goose.example.body.v1.BodyRequest
+	1,  // 5: leo.goose.example.body.v1.Body.NamedBody:input_type -> leo.goose.example.body.v1.NamedBodyRequest
+	2,  // 6: leo.goose.example.body.v1.Body.NestedBody:input_type -> leo.goose.example.body.v1.NestedBodyRequest
+	8,  // 7: leo.goose.example.body.v1.Body.NonBody:input_type -> google.protobuf.Empty
+	7,  // 8: leo.goose.example.body.v1.Body.HttpBodyStarBody:input_type -> google.api.HttpBody
+	3,  // 9: leo.goose.example.body.v1.Body.HttpBodyNamedBody:input_type -> leo.goose.example.body.v1.HttpBodyRequest
+	9,  // 10: leo.goose.example.body.v1.Body.HttpRequest:input_type -> google.rpc.HttpRequest
+	4,  // 11: leo.goose.example.body.v1.Body.StarBody:output_type -> leo.goose.example.body.v1.Response
+	4,  // 12: leo.goose.example.body.v1.Body.NamedBody:output_type -> leo.goose.example.body.v1.Response
+	4,  // 13: leo.goose.example.body.v1.Body.NestedBody:output_type -> leo.goose.example.body.v1.Response
+	4,  // 14: leo.goose.example.body.v1.Body.NonBody:output_type -> leo.goose.example.body.v1.Response
+	4,  // 15: leo.goose.example.body.v1.Body.HttpBodyStarBody:output_type -> leo.goose.example.body.v1.Response
+	4,  // 16: leo.goose.example.body.v1.Body.HttpBodyNamedBody:output_type -> leo.goose.example.body.v1.Response
+	4,  // 17: leo.goose.example.body.v1.Body.HttpRequest:output_type -> leo.goose.example.body.v1.Response
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_example_body_body_proto_init() }
@@ -324,7 +433,7 @@ func file_example_body_body_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_example_body_body_proto_rawDesc), len(file_example_body_body_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
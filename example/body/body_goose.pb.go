@@ -15,21 +15,20 @@ import (
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http1 "net/http"
+	url "net/url"
 )
 
 type BodyService interface {
 	StarBody(ctx context.Context, req *BodyRequest) (*Response, error)
 	NamedBody(ctx context.Context, req *NamedBodyRequest) (*Response, error)
+	NestedBody(ctx context.Context, req *NestedBodyRequest) (*Response, error)
 	NonBody(ctx context.Context, req *emptypb.Empty) (*Response, error)
 	HttpBodyStarBody(ctx context.Context, req *httpbody.HttpBody) (*Response, error)
 	HttpBodyNamedBody(ctx context.Context, req *HttpBodyRequest) (*Response, error)
 	HttpRequest(ctx context.Context, req *http.HttpRequest) (*Response, error)
 }
 
-func AppendBodyHttpRoute(router *http1.ServeMux, service BodyService, opts ...server.Option) *http1.ServeMux {
-	if router == nil {
-		router = http1.NewServeMux()
-	}
+func AppendBodyHttpRoute[R goose.Router](router R, service BodyService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := bodyHandler{
 		service: service,
@@ -44,16 +43,22 @@ func AppendBodyHttpRoute(router *http1.ServeMux, service BodyService, opts ...se
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
-	}
-	router.Handle("POST /v1/star/body", http1.HandlerFunc(handler.StarBody))
-	router.Handle("POST /v1/named/body", http1.HandlerFunc(handler.NamedBody))
-	router.Handle("GET /v1/user_body", http1.HandlerFunc(handler.NonBody))
-	router.Handle("PUT /v1/http/body/star/body", http1.HandlerFunc(handler.HttpBodyStarBody))
-	router.Handle("PUT /v1/http/body/named/body", http1.HandlerFunc(handler.HttpBodyNamedBody))
-	router.Handle("PUT /v1/http/request", http1.HandlerFunc(handler.HttpRequest))
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("POST "+options.Prefix()+"/v1/star/body", http1.HandlerFunc(handler.StarBody))
+	router.Handle("POST "+options.Prefix()+"/v1/named/body", http1.HandlerFunc(handler.NamedBody))
+	router.Handle("POST "+options.Prefix()+"/v1/nested/{item_id}/body", http1.HandlerFunc(handler.NestedBody))
+	router.Handle("GET "+options.Prefix()+"/v1/user_body", http1.HandlerFunc(handler.NonBody))
+	router.Handle("PUT "+options.Prefix()+"/v1/http/body/star/body", http1.HandlerFunc(handler.HttpBodyStarBody))
+	router.Handle("PUT "+options.Prefix()+"/v1/http/body/named/body", http1.HandlerFunc(handler.HttpBodyNamedBody))
+	router.Handle("PUT "+options.Prefix()+"/v1/http/request", http1.HandlerFunc(handler.HttpRequest))
 	return router
 }
 
+func NewBodyHandler(service BodyService, opts ...server.Option) http1.Handler {
+	return AppendBodyHttpRoute(http1.NewServeMux(), service, opts...)
+}
+
 type bodyHandler struct {
 	service                 BodyService
 	decoder                 bodyRequestDecoder
@@ -62,16 +67,18 @@ type bodyHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h bodyHandler) StarBody(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.StarBody(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -86,17 +93,18 @@ func (h bodyHandler) StarBody(response http1.ResponseWriter, request *http1.Requ
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_StarBody_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_StarBody_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h bodyHandler) NamedBody(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.NamedBody(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -111,17 +119,44 @@ func (h bodyHandler) NamedBody(response http1.ResponseWriter, request *http1.Req
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_NamedBody_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_NamedBody_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+func (h bodyHandler) NestedBody(response http1.ResponseWriter, request *http1.Request) {
+	invoke := func(response http1.ResponseWriter, request *http1.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.NestedBody(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.NestedBody(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.NestedBody(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_NestedBody_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h bodyHandler) NonBody(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.NonBody(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -136,17 +171,18 @@ func (h bodyHandler) NonBody(response http1.ResponseWriter, request *http1.Reque
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_NonBody_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_NonBody_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h bodyHandler) HttpBodyStarBody(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.HttpBodyStarBody(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -161,17 +197,18 @@ func (h bodyHandler) HttpBodyStarBody(response http1.ResponseWriter, request *ht
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_HttpBodyStarBody_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_HttpBodyStarBody_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h bodyHandler) HttpBodyNamedBody(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.HttpBodyNamedBody(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -186,17 +223,18 @@ func (h bodyHandler) HttpBodyNamedBody(response http1.ResponseWriter, request *h
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_HttpBodyNamedBody_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_HttpBodyNamedBody_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h bodyHandler) HttpRequest(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.HttpRequest(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -211,7 +249,7 @@ func (h bodyHandler) HttpRequest(response http1.ResponseWriter, request *http1.R
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_HttpRequest_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_body_v1_Body_HttpRequest_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type bodyRequestDecoder struct {
@@ -249,6 +287,32 @@ func (decoder bodyRequestDecoder) NamedBody(ctx context.Context, request *http1.
 	}
 	return req, nil
 }
+func (decoder bodyRequestDecoder) NestedBody(ctx context.Context, request *http1.Request) (*NestedBodyRequest, error) {
+	req := &NestedBodyRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	if req.Item == nil {
+		req.Item = &NestedBodyRequest_Item{}
+	}
+	if req.Item.Body == nil {
+		req.Item.Body = &NamedBodyRequest_Body{}
+	}
+	if err := server.DecodeRequest(ctx, request, req.Item.Body, decoder.unmarshalOptions); err != nil {
+		return nil, err
+	}
+	vars := goose.FormFromPath(request, "item_id")
+	var varErr error
+	req.Item.Id = vars.Get("item_id")
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
 func (decoder bodyRequestDecoder) NonBody(ctx context.Context, request *http1.Request) (*emptypb.Empty, error) {
 	req := &emptypb.Empty{}
 	ok, err := server.CustomDecodeRequest(ctx, request, req)
@@ -317,6 +381,9 @@ func (encoder bodyResponseEncoder) StarBody(ctx context.Context, w http1.Respons
 func (encoder bodyResponseEncoder) NamedBody(ctx context.Context, w http1.ResponseWriter, resp *Response) error {
 	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
 }
+func (encoder bodyResponseEncoder) NestedBody(ctx context.Context, w http1.ResponseWriter, resp *Response) error {
+	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
+}
 func (encoder bodyResponseEncoder) NonBody(ctx context.Context, w http1.ResponseWriter, resp *Response) error {
 	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
 }
@@ -330,7 +397,7 @@ func (encoder bodyResponseEncoder) HttpRequest(ctx context.Context, w http1.Resp
 	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
 }
 
-func NewBodyHttpClient(target string, opts ...client.Option) BodyService {
+func NewBodyHttpClient(target string, opts ...client.Option) BodyHttpClient {
 	options := client.NewOptions(opts...)
 	client := &bodyHttpClient{
 		client: options.Client(),
@@ -351,6 +418,18 @@ func NewBodyHttpClient(target string, opts ...client.Option) BodyService {
 	return client
 }
 
+type BodyHttpClient interface {
+	StarBody(ctx context.Context, req *BodyRequest, opts ...client.CallOption) (*Response, error)
+	NamedBody(ctx context.Context, req *NamedBodyRequest, opts ...client.CallOption) (*Response, error)
+	NestedBody(ctx context.Context, req *NestedBodyRequest, opts ...client.CallOption) (*Response, error)
+	NonBody(ctx context.Context, req *emptypb.Empty, opts ...client.CallOption) (*Response, error)
+	HttpBodyStarBody(ctx context.Context, req *httpbody.HttpBody, opts ...client.CallOption) (*Response, error)
+	HttpBodyNamedBody(ctx context.Context, req *HttpBodyRequest, opts ...client.CallOption) (*Response, error)
+	HttpRequest(ctx context.Context, req *http.HttpRequest, opts ...client.CallOption) (*Response, error)
+}
+
+var _ BodyHttpClient = (*bodyHttpClient)(nil)
+
 type bodyHttpClient struct {
 	client                  *http1.Client
 	encoder                 bodyRequestEncoder
@@ -360,15 +439,17 @@ type bodyHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *bodyHttpClient) StarBody(ctx context.Context, req *BodyRequest) (*Response, error) {
+func (c *bodyHttpClient) StarBody(ctx context.Context, req *BodyRequest, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.StarBody(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.StarBody(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_StarBody_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_StarBody_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -379,15 +460,17 @@ func (c *bodyHttpClient) StarBody(ctx context.Context, req *BodyRequest) (*Respo
 	return resp, nil
 }
 
-func (c *bodyHttpClient) NamedBody(ctx context.Context, req *NamedBodyRequest) (*Response, error) {
+func (c *bodyHttpClient) NamedBody(ctx context.Context, req *NamedBodyRequest, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.NamedBody(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.NamedBody(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_NamedBody_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_NamedBody_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -398,15 +481,38 @@ func (c *bodyHttpClient) NamedBody(ctx context.Context, req *NamedBodyRequest) (
 	return resp, nil
 }
 
-func (c *bodyHttpClient) NonBody(ctx context.Context, req *emptypb.Empty) (*Response, error) {
+func (c *bodyHttpClient) NestedBody(ctx context.Context, req *NestedBodyRequest, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.NonBody(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.NestedBody(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_NestedBody_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_NonBody_Desc.RouteInfo)
+	resp, err := c.decoder.NestedBody(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *bodyHttpClient) NonBody(ctx context.Context, req *emptypb.Empty, opts ...client.CallOption) (*Response, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.NonBody(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_NonBody_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -417,15 +523,17 @@ func (c *bodyHttpClient) NonBody(ctx context.Context, req *emptypb.Empty) (*Resp
 	return resp, nil
 }
 
-func (c *bodyHttpClient) HttpBodyStarBody(ctx context.Context, req *httpbody.HttpBody) (*Response, error) {
+func (c *bodyHttpClient) HttpBodyStarBody(ctx context.Context, req *httpbody.HttpBody, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.HttpBodyStarBody(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.HttpBodyStarBody(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_HttpBodyStarBody_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_HttpBodyStarBody_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -436,15 +544,17 @@ func (c *bodyHttpClient) HttpBodyStarBody(ctx context.Context, req *httpbody.Htt
 	return resp, nil
 }
 
-func (c *bodyHttpClient) HttpBodyNamedBody(ctx context.Context, req *HttpBodyRequest) (*Response, error) {
+func (c *bodyHttpClient) HttpBodyNamedBody(ctx context.Context, req *HttpBodyRequest, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.HttpBodyNamedBody(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.HttpBodyNamedBody(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_HttpBodyNamedBody_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_HttpBodyNamedBody_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -455,15 +565,17 @@ func (c *bodyHttpClient) HttpBodyNamedBody(ctx context.Context, req *HttpBodyReq
 	return resp, nil
 }
 
-func (c *bodyHttpClient) HttpRequest(ctx context.Context, req *http.HttpRequest) (*Response, error) {
+func (c *bodyHttpClient) HttpRequest(ctx context.Context, req *http.HttpRequest, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.HttpRequest(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.HttpRequest(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_HttpRequest_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_body_v1_Body_HttpRequest_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -528,6 +640,38 @@ func (encoder *bodyRequestEncoder) NamedBody(ctx context.Context, req *NamedBody
 	return request, nil
 }
 
+func (encoder *bodyRequestEncoder) NestedBody(ctx context.Context, req *NestedBodyRequest) (*http1.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "POST"
+	header := http1.Header{}
+	var body bytes.Buffer
+	if err := client.EncodeMessage(ctx, req.GetItem().GetBody(), header, &body, encoder.marshalOptions); err != nil {
+		return nil, err
+	}
+	path := "/v1/nested/{item.id}/body"
+	pairs := map[string]string{
+		"item.id": req.GetItem().GetId(),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	request, err := http1.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
 func (encoder *bodyRequestEncoder) NonBody(ctx context.Context, req *emptypb.Empty) (*http1.Request, error) {
 	if req == nil {
 		return nil, errors.New("request is nil")
@@ -649,6 +793,17 @@ func (decoder *bodyResponseDecoder) NamedBody(ctx context.Context, response *htt
 	return resp, nil
 }
 
+func (decoder *bodyResponseDecoder) NestedBody(ctx context.Context, response *http1.Response) (*Response, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &Response{}
+	if err := client.DecodeMessage(ctx, response, resp, decoder.unmarshalOptions); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (decoder *bodyResponseDecoder) NonBody(ctx context.Context, response *http1.Response) (*Response, error) {
 	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
 		return nil, respErr
@@ -709,6 +864,14 @@ var _leo_goose_example_body_v1_Body_NamedBody_Desc = &goose.Desc{
 	},
 }
 
+var _leo_goose_example_body_v1_Body_NestedBody_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "POST",
+		Pattern:    "/v1/nested/{item_id}/body",
+		FullMethod: "/leo.goose.example.body.v1.Body/NestedBody",
+	},
+}
+
 var _leo_goose_example_body_v1_Body_NonBody_Desc = &goose.Desc{
 	RouteInfo: &goose.RouteInfo{
 		HttpMethod: "GET",
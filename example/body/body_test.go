@@ -45,6 +45,10 @@ func (m *MockBodyService) NamedBody(ctx context.Context, request *NamedBodyReque
 	return &Response{Message: string(request.GetBody().GetMessage())}, nil
 }
 
+func (m *MockBodyService) NestedBody(ctx context.Context, request *NestedBodyRequest) (*Response, error) {
+	return &Response{Message: request.GetItem().GetId() + ":" + request.GetItem().GetBody().GetMessage()}, nil
+}
+
 func (m *MockBodyService) NonBody(ctx context.Context, request *emptypb.Empty) (*Response, error) {
 	return &Response{Message: "NonBody"}, nil
 }
@@ -63,7 +67,7 @@ func runServer(server *http.Server, port int) {
 	}
 }
 
-func newClient(port int) BodyService {
+func newClient(port int) BodyHttpClient {
 	return NewBodyHttpClient(fmt.Sprintf("http://localhost:%d", port))
 }
 
@@ -161,3 +165,23 @@ func TestHttpRequest(t *testing.T) {
 		t.Fatal("resp is not equal")
 	}
 }
+
+func TestNestedBody(t *testing.T) {
+	server := new(http.Server)
+	defer server.Shutdown(context.Background())
+	go runServer(server, 38087)
+	time.Sleep(1 * time.Second)
+	client := newClient(38087)
+	resp, err := client.NestedBody(context.Background(), &NestedBodyRequest{
+		Item: &NestedBodyRequest_Item{
+			Id:   "10",
+			Body: &NamedBodyRequest_Body{Message: "hello"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Message != "10:hello" {
+		t.Fatal("resp is not equal")
+	}
+}
@@ -76,7 +76,7 @@ type oaSchema struct {
 	Items                *oaSchema           `json:"items,omitempty"`
 	Required             []string            `json:"required,omitempty"`
 	Ref                  string              `json:"$ref,omitempty"`
-	Enum                 []string            `json:"enum,omitempty"`
+	Enum                 []any               `json:"enum,omitempty"`
 	Nullable             bool                `json:"nullable,omitempty"`
 	AdditionalProperties *oaSchema           `json:"additionalProperties,omitempty"`
 }
@@ -126,7 +126,11 @@ func exampleValue(fieldName string, schema *oaSchema, components *oaComponents)
 
 func exampleString(fieldName string, schema *oaSchema) string {
 	if len(schema.Enum) > 0 {
-		return schema.Enum[0]
+		return fmt.Sprint(schema.Enum[0])
+	}
+	if schema.Format == "int64" || schema.Format == "uint64" {
+		// protojson writes 64-bit integers as strings
+		return "1"
 	}
 
 	switch {
@@ -147,7 +151,12 @@ func exampleString(fieldName string, schema *oaSchema) string {
 	}
 }
 
-func exampleInt(fieldName string, _ *oaSchema) int64 {
+func exampleInt(fieldName string, schema *oaSchema) int64 {
+	if len(schema.Enum) > 0 {
+		if value, ok := schema.Enum[0].(float64); ok {
+			return int64(value)
+		}
+	}
 	switch {
 	case strings.Contains(fieldName, "pageNum") || strings.Contains(fieldName, "page_num"):
 		return 1
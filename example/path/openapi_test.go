@@ -72,11 +72,12 @@ type oaResponse struct {
 type oaSchema struct {
 	Type                 string            `json:"type,omitempty"`
 	Format               string            `json:"format,omitempty"`
+	Pattern              string            `json:"pattern,omitempty"`
 	Properties           map[string]*oaSchema `json:"properties,omitempty"`
 	Items                *oaSchema           `json:"items,omitempty"`
 	Required             []string            `json:"required,omitempty"`
 	Ref                  string              `json:"$ref,omitempty"`
-	Enum                 []string            `json:"enum,omitempty"`
+	Enum                 []any               `json:"enum,omitempty"`
 	Nullable             bool                `json:"nullable,omitempty"`
 	AdditionalProperties *oaSchema           `json:"additionalProperties,omitempty"`
 }
@@ -126,7 +127,11 @@ func exampleValue(fieldName string, schema *oaSchema, components *oaComponents)
 
 func exampleString(fieldName string, schema *oaSchema) string {
 	if len(schema.Enum) > 0 {
-		return schema.Enum[0]
+		return fmt.Sprint(schema.Enum[0])
+	}
+	if schema.Format == "int64" || schema.Format == "uint64" {
+		// protojson writes 64-bit integers as strings
+		return "1"
 	}
 
 	switch {
@@ -138,6 +143,8 @@ func exampleString(fieldName string, schema *oaSchema) string {
 		return "https://example.com"
 	case strings.Contains(fieldName, "contentType") || strings.Contains(fieldName, "content_type"):
 		return "application/json"
+	case strings.Contains(fieldName, "ttl") || strings.Contains(fieldName, "duration"):
+		return "1.5s"
 	case schema.Format == "date-time":
 		return "2024-01-01T00:00:00Z"
 	case schema.Format == "byte" || schema.Format == "binary":
@@ -147,7 +154,12 @@ func exampleString(fieldName string, schema *oaSchema) string {
 	}
 }
 
-func exampleInt(fieldName string, _ *oaSchema) int64 {
+func exampleInt(fieldName string, schema *oaSchema) int64 {
+	if len(schema.Enum) > 0 {
+		if value, ok := schema.Enum[0].(float64); ok {
+			return int64(value)
+		}
+	}
 	switch {
 	case strings.Contains(fieldName, "pageNum") || strings.Contains(fieldName, "page_num"):
 		return 1
@@ -248,6 +260,9 @@ func pathParamExample(name string, schema *oaSchema) string {
 	if schema == nil {
 		return "1"
 	}
+	if schema.Pattern != "" {
+		return patternExample(schema.Pattern)
+	}
 	switch schema.Type {
 	case "string":
 		return exampleString(name, schema)
@@ -260,6 +275,14 @@ func pathParamExample(name string, schema *oaSchema) string {
 	}
 }
 
+// patternExample builds a value matching the pattern of a resource name, e.g. ^shelves/[^/]+$.
+func patternExample(pattern string) string {
+	value := strings.TrimSuffix(strings.TrimPrefix(pattern, "^"), "$")
+	value = strings.ReplaceAll(value, "[^/]+", "1")
+	value = strings.ReplaceAll(value, ".+", "a/b")
+	return strings.ReplaceAll(value, `\`, "")
+}
+
 func buildQueryString(params []oaParameter) string {
 	var parts []string
 	for _, p := range params {
@@ -404,6 +427,21 @@ func TestOpenAPISpec(t *testing.T) {
 		"EnumPathRequest_EnumPath": {48099, func(r *http.ServeMux) *http.ServeMux {
 			return AppendEnumPathHttpRoute(r, &MockEnumPathService{})
 		}},
+		"ResourcePathRequest_GetBook": {48100, func(r *http.ServeMux) *http.ServeMux {
+			return AppendResourcePathHttpRoute(r, &MockResourcePathService{})
+		}},
+		"ResourcePathRequest_PublishBook": {48101, func(r *http.ServeMux) *http.ServeMux {
+			return AppendResourcePathHttpRoute(r, &MockResourcePathService{})
+		}},
+		"ResourcePathRequest_ArchiveBook": {48102, func(r *http.ServeMux) *http.ServeMux {
+			return AppendResourcePathHttpRoute(r, &MockResourcePathService{})
+		}},
+		"ResourcePathRequest_GetFile": {48103, func(r *http.ServeMux) *http.ServeMux {
+			return AppendResourcePathHttpRoute(r, &MockResourcePathService{})
+		}},
+		"TimePathRequest_TimePath": {48104, func(r *http.ServeMux) *http.ServeMux {
+			return AppendTimePathHttpRoute(r, &MockTimePathService{})
+		}},
 	}
 
 	for path, item := range doc.Paths {
@@ -11,6 +11,8 @@ import (
 	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -712,11 +714,107 @@ func (x *EnumPathRequest) GetOptStatus() EnumPathRequest_Status {
 	return EnumPathRequest_UNKNOWN
 }
 
+type ResourcePathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourcePathRequest) Reset() {
+	*x = ResourcePathRequest{}
+	mi := &file_example_path_path_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourcePathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourcePathRequest) ProtoMessage() {}
+
+func (x *ResourcePathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_path_path_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourcePathRequest.ProtoReflect.Descriptor instead.
+func (*ResourcePathRequest) Descriptor() ([]byte, []int) {
+	return file_example_path_path_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResourcePathRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TimePathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimePathRequest) Reset() {
+	*x = TimePathRequest{}
+	mi := &file_example_path_path_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimePathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimePathRequest) ProtoMessage() {}
+
+func (x *TimePathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_path_path_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimePathRequest.ProtoReflect.Descriptor instead.
+func (*TimePathRequest) Descriptor() ([]byte, []int) {
+	return file_example_path_path_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TimePathRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *TimePathRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
 var File_example_path_path_proto protoreflect.FileDescriptor
 
 const file_example_path_path_proto_rawDesc = "" +
 	"\n" +
-	"\x17example/path/path.proto\x12\x19leo.goose.example.path.v1\x1a\x19google/api/httpbody.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x8b\x01\n" +
+	"\x17example/path/path.proto\x12\x19leo.goose.example.path.v1\x1a\x19google/api/httpbody.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\"\x8b\x01\n" +
 	"\x0fBoolPathRequest\x12\x12\n" +
 	"\x04bool\x18\x01 \x01(\bR\x04bool\x12\x1e\n" +
 	"\bopt_bool\x18\x02 \x01(\bH\x00R\aoptBool\x88\x01\x01\x127\n" +
@@ -803,7 +901,12 @@ const file_example_path_path_proto_rawDesc = "" +
 	"\x02OK\x10\x01\x12\r\n" +
 	"\tCANCELLED\x10\x02\x12\x11\n" +
 	"\rUNKNOWN_ERROR\x10\x03B\r\n" +
-	"\v_opt_status2\x83\x01\n" +
+	"\v_opt_status\")\n" +
+	"\x13ResourcePathRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"j\n" +
+	"\x0fTimePathRequest\x12*\n" +
+	"\x02at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl2\x83\x01\n" +
 	"\bBoolPath\x12w\n" +
 	"\bBoolPath\x12*.leo.goose.example.path.v1.BoolPathRequest\x1a\x14.google.api.HttpBody\")\x82\xd3\xe4\x93\x02#\x12!/v1/{bool}/{opt_bool}/{wrap_bool}2\xba\x01\n" +
 	"\tInt32Path\x12\xac\x01\n" +
@@ -829,7 +932,14 @@ const file_example_path_path_proto_rawDesc = "" +
 	"\n" +
 	"StringPath\x12,.leo.goose.example.path.v1.StringPathRequest\x1a\x14.google.api.HttpBody\"A\x82\xd3\xe4\x93\x02;\x129/v1/{string}/{opt_string}/{wrap_string}/{multi_string...}2{\n" +
 	"\bEnumPath\x12o\n" +
-	"\bEnumPath\x12*.leo.goose.example.path.v1.EnumPathRequest\x1a\x14.google.api.HttpBody\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/{status}/{opt_status}B/Z-github.com/soyacen/goose/example/path/v1;pathb\x06proto3"
+	"\bEnumPath\x12*.leo.goose.example.path.v1.EnumPathRequest\x1a\x14.google.api.HttpBody\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/{status}/{opt_status}2\x82\x04\n" +
+	"\fResourcePath\x12u\n" +
+	"\aGetBook\x12..leo.goose.example.path.v1.ResourcePathRequest\x1a\x14.google.api.HttpBody\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/{name=shelves/*/books/*}\x12\x81\x01\n" +
+	"\vPublishBook\x12..leo.goose.example.path.v1.ResourcePathRequest\x1a\x14.google.api.HttpBody\",\x82\xd3\xe4\x93\x02&\x12$/v1/{name=shelves/*/books/*}:publish\x12\x81\x01\n" +
+	"\vArchiveBook\x12..leo.goose.example.path.v1.ResourcePathRequest\x1a\x14.google.api.HttpBody\",\x82\xd3\xe4\x93\x02&\x12$/v1/{name=shelves/*/books/*}:archive\x12s\n" +
+	"\aGetFile\x12..leo.goose.example.path.v1.ResourcePathRequest\x1a\x14.google.api.HttpBody\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/static/{name=files/**}2u\n" +
+	"\bTimePath\x12i\n" +
+	"\bTimePath\x12*.leo.goose.example.path.v1.TimePathRequest\x1a\x14.google.api.HttpBody\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/time/{at}/{ttl}B/Z-github.com/soyacen/goose/example/path/v1;pathb\x06proto3"
 
 var (
 	file_example_path_path_proto_rawDescOnce sync.Once
@@ -844,7 +954,7 @@ func file_example_path_path_proto_rawDescGZIP() []byte {
 }
 
 var file_example_path_path_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_example_path_path_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_example_path_path_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_example_path_path_proto_goTypes = []any{
 	(EnumPathRequest_Status)(0),    // 0: leo.goose.example.path.v1.EnumPathRequest.Status
 	(*BoolPathRequest)(nil),        // 1: leo.goose.example.path.v1.BoolPathRequest
@@ -856,50 +966,66 @@ var file_example_path_path_proto_goTypes = []any{
 	(*DoublePathRequest)(nil),      // 7: leo.goose.example.path.v1.DoublePathRequest
 	(*StringPathRequest)(nil),      // 8: leo.goose.example.path.v1.StringPathRequest
 	(*EnumPathRequest)(nil),        // 9: leo.goose.example.path.v1.EnumPathRequest
-	(*wrapperspb.BoolValue)(nil),   // 10: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),  // 11: google.protobuf.Int32Value
-	(*wrapperspb.Int64Value)(nil),  // 12: google.protobuf.Int64Value
-	(*wrapperspb.UInt32Value)(nil), // 13: google.protobuf.UInt32Value
-	(*wrapperspb.UInt64Value)(nil), // 14: google.protobuf.UInt64Value
-	(*wrapperspb.FloatValue)(nil),  // 15: google.protobuf.FloatValue
-	(*wrapperspb.DoubleValue)(nil), // 16: google.protobuf.DoubleValue
-	(*wrapperspb.StringValue)(nil), // 17: google.protobuf.StringValue
-	(*httpbody.HttpBody)(nil),      // 18: google.api.HttpBody
+	(*ResourcePathRequest)(nil),    // 10: leo.goose.example.path.v1.ResourcePathRequest
+	(*TimePathRequest)(nil),        // 11: leo.goose.example.path.v1.TimePathRequest
+	(*wrapperspb.BoolValue)(nil),   // 12: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),  // 13: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),  // 14: google.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil), // 15: google.protobuf.UInt32Value
+	(*wrapperspb.UInt64Value)(nil), // 16: google.protobuf.UInt64Value
+	(*wrapperspb.FloatValue)(nil),  // 17: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil), // 18: google.protobuf.DoubleValue
+	(*wrapperspb.StringValue)(nil), // 19: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),  // 20: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 21: google.protobuf.Duration
+	(*httpbody.HttpBody)(nil),      // 22: google.api.HttpBody
 }
 var file_example_path_path_proto_depIdxs = []int32{
-	10, // 0: leo.goose.example.path.v1.BoolPathRequest.wrap_bool:type_name -> google.protobuf.BoolValue
-	11, // 1: leo.goose.example.path.v1.Int32PathRequest.wrap_int32:type_name -> google.protobuf.Int32Value
-	12, // 2: leo.goose.example.path.v1.Int64PathRequest.wrap_int64:type_name -> google.protobuf.Int64Value
-	13, // 3: leo.goose.example.path.v1.Uint32PathRequest.wrap_uint32:type_name -> google.protobuf.UInt32Value
-	14, // 4: leo.goose.example.path.v1.Uint64PathRequest.wrap_uint64:type_name -> google.protobuf.UInt64Value
-	15, // 5: leo.goose.example.path.v1.FloatPathRequest.wrap_float:type_name -> google.protobuf.FloatValue
-	16, // 6: leo.goose.example.path.v1.DoublePathRequest.wrap_double:type_name -> google.protobuf.DoubleValue
-	17, // 7: leo.goose.example.path.v1.StringPathRequest.wrap_string:type_name -> google.protobuf.StringValue
+	12, // 0: leo.goose.example.path.v1.BoolPathRequest.wrap_bool:type_name -> google.protobuf.BoolValue
+	13, // 1: leo.goose.example.path.v1.Int32PathRequest.wrap_int32:type_name -> google.protobuf.Int32Value
+	14, // 2: leo.goose.example.path.v1.Int64PathRequest.wrap_int64:type_name -> google.protobuf.Int64Value
+	15, // 3: leo.goose.example.path.v1.Uint32PathRequest.wrap_uint32:type_name -> google.protobuf.UInt32Value
+	16, // 4: leo.goose.example.path.v1.Uint64PathRequest.wrap_uint64:type_name -> google.protobuf.UInt64Value
+	17, // 5: leo.goose.example.path.v1.FloatPathRequest.wrap_float:type_name -> google.protobuf.FloatValue
+	18, // 6: leo.goose.example.path.v1.DoublePathRequest.wrap_double:type_name -> google.protobuf.DoubleValue
+	19, // 7: leo.goose.example.path.v1.StringPathRequest.wrap_string:type_name -> google.protobuf.StringValue
 	0,  // 8: leo.goose.example.path.v1.EnumPathRequest.status:type_name -> leo.goose.example.path.v1.EnumPathRequest.Status
 	0,  // 9: leo.goose.example.path.v1.EnumPathRequest.opt_status:type_name -> leo.goose.example.path.v1.EnumPathRequest.Status
-	1,  // 10: leo.goose.example.path.v1.BoolPath.BoolPath:input_type -> leo.goose.example.path.v1.BoolPathRequest
-	2,  // 11: leo.goose.example.path.v1.Int32Path.Int32Path:input_type -> leo.goose.example.path.v1.Int32PathRequest
-	3,  // 12: leo.goose.example.path.v1.Int64Path.Int64Path:input_type -> leo.goose.example.path.v1.Int64PathRequest
-	4,  // 13: leo.goose.example.path.v1.Uint32Path.Uint32Path:input_type -> leo.goose.example.path.v1.Uint32PathRequest
-	5,  // 14: leo.goose.example.path.v1.Uint64Path.Uint64Path:input_type -> leo.goose.example.path.v1.Uint64PathRequest
-	6,  // 15: leo.goose.example.path.v1.FloatPath.FloatPath:input_type -> leo.goose.example.path.v1.FloatPathRequest
-	7,  // 16: leo.goose.example.path.v1.DoublePath.DoublePath:input_type -> leo.goose.example.path.v1.DoublePathRequest
-	8,  // 17: leo.goose.example.path.v1.StringPath.StringPath:input_type -> leo.goose.example.path.v1.StringPathRequest
-	9,  // 18: leo.goose.example.path.v1.EnumPath.EnumPath:input_type -> leo.goose.example.path.v1.EnumPathRequest
-	18, // 19: leo.goose.example.path.v1.BoolPath.BoolPath:output_type -> google.api.HttpBody
-	18, // 20: leo.goose.example.path.v1.Int32Path.Int32Path:output_type -> google.api.HttpBody
-	18, // 21: leo.goose.example.path.v1.Int64Path.Int64Path:output_type -> google.api.HttpBody
-	18, // 22: leo.goose.example.path.v1.Uint32Path.Uint32Path:output_type -> google.api.HttpBody
-	18, // 23: leo.goose.example.path.v1.Uint64Path.Uint64Path:output_type -> google.api.HttpBody
-	18, // 24: leo.goose.example.path.v1.FloatPath.FloatPath:output_type -> google.api.HttpBody
-	18, // 25: leo.goose.example.path.v1.DoublePath.DoublePath:output_type -> google.api.HttpBody
-	18, // 26: leo.goose.example.path.v1.StringPath.StringPath:output_type -> google.api.HttpBody
-	18, // 27: leo.goose.example.path.v1.EnumPath.EnumPath:output_type -> google.api.HttpBody
-	19, // [19:28] is the sub-list for method output_type
-	10, // [10:19] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	20, // 10: leo.goose.example.path.v1.TimePathRequest.at:type_name -> google.protobuf.Timestamp
+	21, // 11: leo.goose.example.path.v1.TimePathRequest.ttl:type_name -> google.protobuf.Duration
+	1,  // 12: leo.goose.example.path.v1.BoolPath.BoolPath:input_type -> leo.goose.example.path.v1.BoolPathRequest
+	2,  // 13: leo.goose.example.path.v1.Int32Path.Int32Path:input_type -> leo.goose.example.path.v1.Int32PathRequest
+	3,  // 14: leo.goose.example.path.v1.Int64Path.Int64Path:input_type -> leo.goose.example.path.v1.Int64PathRequest
+	4,  // 15: leo.goose.example.path.v1.Uint32Path.Uint32Path:input_type -> leo.goose.example.path.v1.Uint32PathRequest
+	5,  // 16: leo.goose.example.path.v1.Uint64Path.Uint64Path:input_type -> leo.goose.example.path.v1.Uint64PathRequest
+	6,  // 17: leo.goose.example.path.v1.FloatPath.FloatPath:input_type -> leo.goose.example.path.v1.FloatPathRequest
+	7,  // 18: leo.goose.example.path.v1.DoublePath.DoublePath:input_type -> leo.goose.example.path.v1.DoublePathRequest
+	8,  // 19: leo.goose.example.path.v1.StringPath.StringPath:input_type -> leo.goose.example.path.v1.StringPathRequest
+	9,  // 20: leo.goose.example.path.v1.EnumPath.EnumPath:input_type -> leo.goose.example.path.v1.EnumPathRequest
+	10, // 21: leo.goose.example.path.v1.ResourcePath.GetBook:input_type -> leo.goose.example.path.v1.ResourcePathRequest
+	10, // 22: leo.goose.example.path.v1.ResourcePath.PublishBook:input_type -> leo.goose.example.path.v1.ResourcePathRequest
+	10, // 23: leo.goose.example.path.v1.ResourcePath.ArchiveBook:input_type -> leo.goose.example.path.v1.ResourcePathRequest
+	10, // 24: leo.goose.example.path.v1.ResourcePath.GetFile:input_type -> leo.goose.example.path.v1.ResourcePathRequest
+	11, // 25: leo.goose.example.path.v1.TimePath.TimePath:input_type -> leo.goose.example.path.v1.TimePathRequest
+	22, // 26: leo.goose.example.path.v1.BoolPath.BoolPath:output_type -> google.api.HttpBody
+	22, // 27: leo.goose.example.path.v1.Int32Path.Int32Path:output_type -> google.api.HttpBody
+	22, // 28: leo.goose.example.path.v1.Int64Path.Int64Path:output_type -> google.api.HttpBody
+	22, // 29: leo.goose.example.path.v1.Uint32Path.Uint32Path:output_type -> google.api.HttpBody
+	22, // 30: leo.goose.example.path.v1.Uint64Path.Uint64Path:output_type -> google.api.HttpBody
+	22, // 31: leo.goose.example.path.v1.FloatPath.FloatPath:output_type -> google.api.HttpBody
+	22, // 32: leo.goose.example.path.v1.DoublePath.DoublePath:output_type -> google.api.HttpBody
+	22, // 33: leo.goose.example.path.v1.StringPath.StringPath:output_type -> google.api.HttpBody
+	22, // 34: leo.goose.example.path.v1.EnumPath.EnumPath:output_type -> google.api.HttpBody
+	22, // 35: leo.goose.example.path.v1.ResourcePath.GetBook:output_type -> google.api.HttpBody
+	22, // 36: leo.goose.example.path.v1.ResourcePath.PublishBook:output_type -> google.api.HttpBody
+	22, // 37: leo.goose.example.path.v1.ResourcePath.ArchiveBook:output_type -> google.api.HttpBody
+	22, // 38: leo.goose.example.path.v1.ResourcePath.GetFile:output_type -> google.api.HttpBody
+	22, // 39: leo.goose.example.path.v1.TimePath.TimePath:output_type -> google.api.HttpBody
+	26, // [26:40] is the sub-list for method output_type
+	12, // [12:26] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_example_path_path_proto_init() }
@@ -922,9 +1048,9 @@ func file_example_path_path_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_example_path_path_proto_rawDesc), len(file_example_path_path_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
-			NumServices:   9,
+			NumServices:   11,
 		},
 		GoTypes:           file_example_path_path_proto_goTypes,
 		DependencyIndexes: file_example_path_path_proto_depIdxs,
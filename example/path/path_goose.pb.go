@@ -13,6 +13,8 @@ import (
 	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	http "net/http"
 	url "net/url"
@@ -22,10 +24,7 @@ type BoolPathService interface {
 	BoolPath(ctx context.Context, req *BoolPathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendBoolPathHttpRoute(router *http.ServeMux, service BoolPathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendBoolPathHttpRoute[R goose.Router](router R, service BoolPathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := boolPathHandler{
 		service: service,
@@ -40,11 +39,16 @@ func AppendBoolPathHttpRoute(router *http.ServeMux, service BoolPathService, opt
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{bool}/{opt_bool}/{wrap_bool}", http.HandlerFunc(handler.BoolPath))
+	router.Handle("GET "+options.Prefix()+"/v1/{bool}/{opt_bool}/{wrap_bool}", http.HandlerFunc(handler.BoolPath))
 	return router
 }
 
+func NewBoolPathHandler(service BoolPathService, opts ...server.Option) http.Handler {
+	return AppendBoolPathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type boolPathHandler struct {
 	service                 BoolPathService
 	decoder                 boolPathRequestDecoder
@@ -53,16 +57,18 @@ type boolPathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h boolPathHandler) BoolPath(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.BoolPath(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -77,7 +83,7 @@ func (h boolPathHandler) BoolPath(response http.ResponseWriter, request *http.Re
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_BoolPath_BoolPath_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_BoolPath_BoolPath_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type boolPathRequestDecoder struct {
@@ -113,7 +119,7 @@ func (encoder boolPathResponseEncoder) BoolPath(ctx context.Context, w http.Resp
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewBoolPathHttpClient(target string, opts ...client.Option) BoolPathService {
+func NewBoolPathHttpClient(target string, opts ...client.Option) BoolPathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &boolPathHttpClient{
 		client: options.Client(),
@@ -134,6 +140,12 @@ func NewBoolPathHttpClient(target string, opts ...client.Option) BoolPathService
 	return client
 }
 
+type BoolPathHttpClient interface {
+	BoolPath(ctx context.Context, req *BoolPathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ BoolPathHttpClient = (*boolPathHttpClient)(nil)
+
 type boolPathHttpClient struct {
 	client                  *http.Client
 	encoder                 boolPathRequestEncoder
@@ -143,15 +155,17 @@ type boolPathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *boolPathHttpClient) BoolPath(ctx context.Context, req *BoolPathRequest) (*httpbody.HttpBody, error) {
+func (c *boolPathHttpClient) BoolPath(ctx context.Context, req *BoolPathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.BoolPath(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.BoolPath(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_BoolPath_BoolPath_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_BoolPath_BoolPath_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -228,10 +242,7 @@ type Int32PathService interface {
 	Int32Path(ctx context.Context, req *Int32PathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendInt32PathHttpRoute(router *http.ServeMux, service Int32PathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendInt32PathHttpRoute[R goose.Router](router R, service Int32PathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := int32PathHandler{
 		service: service,
@@ -246,11 +257,16 @@ func AppendInt32PathHttpRoute(router *http.ServeMux, service Int32PathService, o
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{int32}/{sint32}/{sfixed32}/{opt_int32}/{opt_sint32}/{opt_sfixed32}/{wrap_int32}", http.HandlerFunc(handler.Int32Path))
+	router.Handle("GET "+options.Prefix()+"/v1/{int32}/{sint32}/{sfixed32}/{opt_int32}/{opt_sint32}/{opt_sfixed32}/{wrap_int32}", http.HandlerFunc(handler.Int32Path))
 	return router
 }
 
+func NewInt32PathHandler(service Int32PathService, opts ...server.Option) http.Handler {
+	return AppendInt32PathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type int32PathHandler struct {
 	service                 Int32PathService
 	decoder                 int32PathRequestDecoder
@@ -259,16 +275,18 @@ type int32PathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h int32PathHandler) Int32Path(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Int32Path(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -283,7 +301,7 @@ func (h int32PathHandler) Int32Path(response http.ResponseWriter, request *http.
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_Int32Path_Int32Path_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_Int32Path_Int32Path_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type int32PathRequestDecoder struct {
@@ -323,7 +341,7 @@ func (encoder int32PathResponseEncoder) Int32Path(ctx context.Context, w http.Re
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewInt32PathHttpClient(target string, opts ...client.Option) Int32PathService {
+func NewInt32PathHttpClient(target string, opts ...client.Option) Int32PathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &int32PathHttpClient{
 		client: options.Client(),
@@ -344,6 +362,12 @@ func NewInt32PathHttpClient(target string, opts ...client.Option) Int32PathServi
 	return client
 }
 
+type Int32PathHttpClient interface {
+	Int32Path(ctx context.Context, req *Int32PathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ Int32PathHttpClient = (*int32PathHttpClient)(nil)
+
 type int32PathHttpClient struct {
 	client                  *http.Client
 	encoder                 int32PathRequestEncoder
@@ -353,15 +377,17 @@ type int32PathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *int32PathHttpClient) Int32Path(ctx context.Context, req *Int32PathRequest) (*httpbody.HttpBody, error) {
+func (c *int32PathHttpClient) Int32Path(ctx context.Context, req *Int32PathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Int32Path(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Int32Path(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_Int32Path_Int32Path_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_Int32Path_Int32Path_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -442,10 +468,7 @@ type Int64PathService interface {
 	Int64Path(ctx context.Context, req *Int64PathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendInt64PathHttpRoute(router *http.ServeMux, service Int64PathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendInt64PathHttpRoute[R goose.Router](router R, service Int64PathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := int64PathHandler{
 		service: service,
@@ -460,11 +483,16 @@ func AppendInt64PathHttpRoute(router *http.ServeMux, service Int64PathService, o
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{int64}/{sint64}/{sfixed64}/{opt_int64}/{opt_sint64}/{opt_sfixed64}/{wrap_int64}", http.HandlerFunc(handler.Int64Path))
+	router.Handle("GET "+options.Prefix()+"/v1/{int64}/{sint64}/{sfixed64}/{opt_int64}/{opt_sint64}/{opt_sfixed64}/{wrap_int64}", http.HandlerFunc(handler.Int64Path))
 	return router
 }
 
+func NewInt64PathHandler(service Int64PathService, opts ...server.Option) http.Handler {
+	return AppendInt64PathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type int64PathHandler struct {
 	service                 Int64PathService
 	decoder                 int64PathRequestDecoder
@@ -473,16 +501,18 @@ type int64PathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h int64PathHandler) Int64Path(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Int64Path(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -497,7 +527,7 @@ func (h int64PathHandler) Int64Path(response http.ResponseWriter, request *http.
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_Int64Path_Int64Path_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_Int64Path_Int64Path_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type int64PathRequestDecoder struct {
@@ -537,7 +567,7 @@ func (encoder int64PathResponseEncoder) Int64Path(ctx context.Context, w http.Re
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewInt64PathHttpClient(target string, opts ...client.Option) Int64PathService {
+func NewInt64PathHttpClient(target string, opts ...client.Option) Int64PathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &int64PathHttpClient{
 		client: options.Client(),
@@ -558,6 +588,12 @@ func NewInt64PathHttpClient(target string, opts ...client.Option) Int64PathServi
 	return client
 }
 
+type Int64PathHttpClient interface {
+	Int64Path(ctx context.Context, req *Int64PathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ Int64PathHttpClient = (*int64PathHttpClient)(nil)
+
 type int64PathHttpClient struct {
 	client                  *http.Client
 	encoder                 int64PathRequestEncoder
@@ -567,15 +603,17 @@ type int64PathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *int64PathHttpClient) Int64Path(ctx context.Context, req *Int64PathRequest) (*httpbody.HttpBody, error) {
+func (c *int64PathHttpClient) Int64Path(ctx context.Context, req *Int64PathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Int64Path(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Int64Path(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_Int64Path_Int64Path_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_Int64Path_Int64Path_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -656,10 +694,7 @@ type Uint32PathService interface {
 	Uint32Path(ctx context.Context, req *Uint32PathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendUint32PathHttpRoute(router *http.ServeMux, service Uint32PathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendUint32PathHttpRoute[R goose.Router](router R, service Uint32PathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := uint32PathHandler{
 		service: service,
@@ -674,11 +709,16 @@ func AppendUint32PathHttpRoute(router *http.ServeMux, service Uint32PathService,
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{uint32}/{fixed32}/{opt_uint32}/{opt_fixed32}/{wrap_uint32}", http.HandlerFunc(handler.Uint32Path))
+	router.Handle("GET "+options.Prefix()+"/v1/{uint32}/{fixed32}/{opt_uint32}/{opt_fixed32}/{wrap_uint32}", http.HandlerFunc(handler.Uint32Path))
 	return router
 }
 
+func NewUint32PathHandler(service Uint32PathService, opts ...server.Option) http.Handler {
+	return AppendUint32PathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type uint32PathHandler struct {
 	service                 Uint32PathService
 	decoder                 uint32PathRequestDecoder
@@ -687,16 +727,18 @@ type uint32PathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h uint32PathHandler) Uint32Path(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Uint32Path(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -711,7 +753,7 @@ func (h uint32PathHandler) Uint32Path(response http.ResponseWriter, request *htt
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_Uint32Path_Uint32Path_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_Uint32Path_Uint32Path_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type uint32PathRequestDecoder struct {
@@ -749,7 +791,7 @@ func (encoder uint32PathResponseEncoder) Uint32Path(ctx context.Context, w http.
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewUint32PathHttpClient(target string, opts ...client.Option) Uint32PathService {
+func NewUint32PathHttpClient(target string, opts ...client.Option) Uint32PathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &uint32PathHttpClient{
 		client: options.Client(),
@@ -770,6 +812,12 @@ func NewUint32PathHttpClient(target string, opts ...client.Option) Uint32PathSer
 	return client
 }
 
+type Uint32PathHttpClient interface {
+	Uint32Path(ctx context.Context, req *Uint32PathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ Uint32PathHttpClient = (*uint32PathHttpClient)(nil)
+
 type uint32PathHttpClient struct {
 	client                  *http.Client
 	encoder                 uint32PathRequestEncoder
@@ -779,15 +827,17 @@ type uint32PathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *uint32PathHttpClient) Uint32Path(ctx context.Context, req *Uint32PathRequest) (*httpbody.HttpBody, error) {
+func (c *uint32PathHttpClient) Uint32Path(ctx context.Context, req *Uint32PathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Uint32Path(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Uint32Path(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_Uint32Path_Uint32Path_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_Uint32Path_Uint32Path_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -866,10 +916,7 @@ type Uint64PathService interface {
 	Uint64Path(ctx context.Context, req *Uint64PathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendUint64PathHttpRoute(router *http.ServeMux, service Uint64PathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendUint64PathHttpRoute[R goose.Router](router R, service Uint64PathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := uint64PathHandler{
 		service: service,
@@ -884,11 +931,16 @@ func AppendUint64PathHttpRoute(router *http.ServeMux, service Uint64PathService,
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{uint64}/{fixed64}/{opt_uint64}/{opt_fixed64}/{wrap_uint64}", http.HandlerFunc(handler.Uint64Path))
+	router.Handle("GET "+options.Prefix()+"/v1/{uint64}/{fixed64}/{opt_uint64}/{opt_fixed64}/{wrap_uint64}", http.HandlerFunc(handler.Uint64Path))
 	return router
 }
 
+func NewUint64PathHandler(service Uint64PathService, opts ...server.Option) http.Handler {
+	return AppendUint64PathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type uint64PathHandler struct {
 	service                 Uint64PathService
 	decoder                 uint64PathRequestDecoder
@@ -897,16 +949,18 @@ type uint64PathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h uint64PathHandler) Uint64Path(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Uint64Path(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -921,7 +975,7 @@ func (h uint64PathHandler) Uint64Path(response http.ResponseWriter, request *htt
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_Uint64Path_Uint64Path_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_Uint64Path_Uint64Path_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type uint64PathRequestDecoder struct {
@@ -959,7 +1013,7 @@ func (encoder uint64PathResponseEncoder) Uint64Path(ctx context.Context, w http.
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewUint64PathHttpClient(target string, opts ...client.Option) Uint64PathService {
+func NewUint64PathHttpClient(target string, opts ...client.Option) Uint64PathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &uint64PathHttpClient{
 		client: options.Client(),
@@ -980,6 +1034,12 @@ func NewUint64PathHttpClient(target string, opts ...client.Option) Uint64PathSer
 	return client
 }
 
+type Uint64PathHttpClient interface {
+	Uint64Path(ctx context.Context, req *Uint64PathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ Uint64PathHttpClient = (*uint64PathHttpClient)(nil)
+
 type uint64PathHttpClient struct {
 	client                  *http.Client
 	encoder                 uint64PathRequestEncoder
@@ -989,15 +1049,17 @@ type uint64PathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *uint64PathHttpClient) Uint64Path(ctx context.Context, req *Uint64PathRequest) (*httpbody.HttpBody, error) {
+func (c *uint64PathHttpClient) Uint64Path(ctx context.Context, req *Uint64PathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Uint64Path(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Uint64Path(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_Uint64Path_Uint64Path_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_Uint64Path_Uint64Path_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1076,10 +1138,7 @@ type FloatPathService interface {
 	FloatPath(ctx context.Context, req *FloatPathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendFloatPathHttpRoute(router *http.ServeMux, service FloatPathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendFloatPathHttpRoute[R goose.Router](router R, service FloatPathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := floatPathHandler{
 		service: service,
@@ -1094,11 +1153,16 @@ func AppendFloatPathHttpRoute(router *http.ServeMux, service FloatPathService, o
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{float}/{opt_float}/{wrap_float}", http.HandlerFunc(handler.FloatPath))
+	router.Handle("GET "+options.Prefix()+"/v1/{float}/{opt_float}/{wrap_float}", http.HandlerFunc(handler.FloatPath))
 	return router
 }
 
+func NewFloatPathHandler(service FloatPathService, opts ...server.Option) http.Handler {
+	return AppendFloatPathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type floatPathHandler struct {
 	service                 FloatPathService
 	decoder                 floatPathRequestDecoder
@@ -1107,16 +1171,18 @@ type floatPathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h floatPathHandler) FloatPath(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.FloatPath(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -1131,7 +1197,7 @@ func (h floatPathHandler) FloatPath(response http.ResponseWriter, request *http.
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_FloatPath_FloatPath_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_FloatPath_FloatPath_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type floatPathRequestDecoder struct {
@@ -1167,7 +1233,7 @@ func (encoder floatPathResponseEncoder) FloatPath(ctx context.Context, w http.Re
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewFloatPathHttpClient(target string, opts ...client.Option) FloatPathService {
+func NewFloatPathHttpClient(target string, opts ...client.Option) FloatPathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &floatPathHttpClient{
 		client: options.Client(),
@@ -1188,6 +1254,12 @@ func NewFloatPathHttpClient(target string, opts ...client.Option) FloatPathServi
 	return client
 }
 
+type FloatPathHttpClient interface {
+	FloatPath(ctx context.Context, req *FloatPathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ FloatPathHttpClient = (*floatPathHttpClient)(nil)
+
 type floatPathHttpClient struct {
 	client                  *http.Client
 	encoder                 floatPathRequestEncoder
@@ -1197,15 +1269,17 @@ type floatPathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *floatPathHttpClient) FloatPath(ctx context.Context, req *FloatPathRequest) (*httpbody.HttpBody, error) {
+func (c *floatPathHttpClient) FloatPath(ctx context.Context, req *FloatPathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.FloatPath(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.FloatPath(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_FloatPath_FloatPath_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_FloatPath_FloatPath_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1282,10 +1356,7 @@ type DoublePathService interface {
 	DoublePath(ctx context.Context, req *DoublePathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendDoublePathHttpRoute(router *http.ServeMux, service DoublePathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendDoublePathHttpRoute[R goose.Router](router R, service DoublePathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := doublePathHandler{
 		service: service,
@@ -1300,11 +1371,16 @@ func AppendDoublePathHttpRoute(router *http.ServeMux, service DoublePathService,
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{double}/{opt_double}/{wrap_double}", http.HandlerFunc(handler.DoublePath))
+	router.Handle("GET "+options.Prefix()+"/v1/{double}/{opt_double}/{wrap_double}", http.HandlerFunc(handler.DoublePath))
 	return router
 }
 
+func NewDoublePathHandler(service DoublePathService, opts ...server.Option) http.Handler {
+	return AppendDoublePathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type doublePathHandler struct {
 	service                 DoublePathService
 	decoder                 doublePathRequestDecoder
@@ -1313,16 +1389,18 @@ type doublePathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h doublePathHandler) DoublePath(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.DoublePath(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -1337,7 +1415,7 @@ func (h doublePathHandler) DoublePath(response http.ResponseWriter, request *htt
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_DoublePath_DoublePath_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_DoublePath_DoublePath_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type doublePathRequestDecoder struct {
@@ -1373,7 +1451,7 @@ func (encoder doublePathResponseEncoder) DoublePath(ctx context.Context, w http.
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewDoublePathHttpClient(target string, opts ...client.Option) DoublePathService {
+func NewDoublePathHttpClient(target string, opts ...client.Option) DoublePathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &doublePathHttpClient{
 		client: options.Client(),
@@ -1394,6 +1472,12 @@ func NewDoublePathHttpClient(target string, opts ...client.Option) DoublePathSer
 	return client
 }
 
+type DoublePathHttpClient interface {
+	DoublePath(ctx context.Context, req *DoublePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ DoublePathHttpClient = (*doublePathHttpClient)(nil)
+
 type doublePathHttpClient struct {
 	client                  *http.Client
 	encoder                 doublePathRequestEncoder
@@ -1403,15 +1487,17 @@ type doublePathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *doublePathHttpClient) DoublePath(ctx context.Context, req *DoublePathRequest) (*httpbody.HttpBody, error) {
+func (c *doublePathHttpClient) DoublePath(ctx context.Context, req *DoublePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.DoublePath(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.DoublePath(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_DoublePath_DoublePath_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_DoublePath_DoublePath_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1488,10 +1574,7 @@ type StringPathService interface {
 	StringPath(ctx context.Context, req *StringPathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendStringPathHttpRoute(router *http.ServeMux, service StringPathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendStringPathHttpRoute[R goose.Router](router R, service StringPathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := stringPathHandler{
 		service: service,
@@ -1506,11 +1589,16 @@ func AppendStringPathHttpRoute(router *http.ServeMux, service StringPathService,
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{string}/{opt_string}/{wrap_string}/{multi_string...}", http.HandlerFunc(handler.StringPath))
+	router.Handle("GET "+options.Prefix()+"/v1/{string}/{opt_string}/{wrap_string}/{multi_string...}", http.HandlerFunc(handler.StringPath))
 	return router
 }
 
+func NewStringPathHandler(service StringPathService, opts ...server.Option) http.Handler {
+	return AppendStringPathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type stringPathHandler struct {
 	service                 StringPathService
 	decoder                 stringPathRequestDecoder
@@ -1519,16 +1607,18 @@ type stringPathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h stringPathHandler) StringPath(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.StringPath(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -1543,7 +1633,7 @@ func (h stringPathHandler) StringPath(response http.ResponseWriter, request *htt
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_StringPath_StringPath_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_StringPath_StringPath_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type stringPathRequestDecoder struct {
@@ -1580,7 +1670,7 @@ func (encoder stringPathResponseEncoder) StringPath(ctx context.Context, w http.
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewStringPathHttpClient(target string, opts ...client.Option) StringPathService {
+func NewStringPathHttpClient(target string, opts ...client.Option) StringPathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &stringPathHttpClient{
 		client: options.Client(),
@@ -1601,6 +1691,12 @@ func NewStringPathHttpClient(target string, opts ...client.Option) StringPathSer
 	return client
 }
 
+type StringPathHttpClient interface {
+	StringPath(ctx context.Context, req *StringPathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ StringPathHttpClient = (*stringPathHttpClient)(nil)
+
 type stringPathHttpClient struct {
 	client                  *http.Client
 	encoder                 stringPathRequestEncoder
@@ -1610,15 +1706,17 @@ type stringPathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *stringPathHttpClient) StringPath(ctx context.Context, req *StringPathRequest) (*httpbody.HttpBody, error) {
+func (c *stringPathHttpClient) StringPath(ctx context.Context, req *StringPathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.StringPath(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.StringPath(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_StringPath_StringPath_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_StringPath_StringPath_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1696,10 +1794,7 @@ type EnumPathService interface {
 	EnumPath(ctx context.Context, req *EnumPathRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendEnumPathHttpRoute(router *http.ServeMux, service EnumPathService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendEnumPathHttpRoute[R goose.Router](router R, service EnumPathService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := enumPathHandler{
 		service: service,
@@ -1714,11 +1809,16 @@ func AppendEnumPathHttpRoute(router *http.ServeMux, service EnumPathService, opt
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/{status}/{opt_status}", http.HandlerFunc(handler.EnumPath))
+	router.Handle("GET "+options.Prefix()+"/v1/{status}/{opt_status}", http.HandlerFunc(handler.EnumPath))
 	return router
 }
 
+func NewEnumPathHandler(service EnumPathService, opts ...server.Option) http.Handler {
+	return AppendEnumPathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type enumPathHandler struct {
 	service                 EnumPathService
 	decoder                 enumPathRequestDecoder
@@ -1727,16 +1827,18 @@ type enumPathHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h enumPathHandler) EnumPath(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.EnumPath(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -1751,7 +1853,7 @@ func (h enumPathHandler) EnumPath(response http.ResponseWriter, request *http.Re
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_EnumPath_EnumPath_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_EnumPath_EnumPath_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type enumPathRequestDecoder struct {
@@ -1786,7 +1888,7 @@ func (encoder enumPathResponseEncoder) EnumPath(ctx context.Context, w http.Resp
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewEnumPathHttpClient(target string, opts ...client.Option) EnumPathService {
+func NewEnumPathHttpClient(target string, opts ...client.Option) EnumPathHttpClient {
 	options := client.NewOptions(opts...)
 	client := &enumPathHttpClient{
 		client: options.Client(),
@@ -1807,6 +1909,12 @@ func NewEnumPathHttpClient(target string, opts ...client.Option) EnumPathService
 	return client
 }
 
+type EnumPathHttpClient interface {
+	EnumPath(ctx context.Context, req *EnumPathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ EnumPathHttpClient = (*enumPathHttpClient)(nil)
+
 type enumPathHttpClient struct {
 	client                  *http.Client
 	encoder                 enumPathRequestEncoder
@@ -1816,15 +1924,17 @@ type enumPathHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *enumPathHttpClient) EnumPath(ctx context.Context, req *EnumPathRequest) (*httpbody.HttpBody, error) {
+func (c *enumPathHttpClient) EnumPath(ctx context.Context, req *EnumPathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.EnumPath(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.EnumPath(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_EnumPath_EnumPath_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_EnumPath_EnumPath_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1895,3 +2005,798 @@ var _leo_goose_example_path_v1_EnumPath_EnumPath_Desc = &goose.Desc{
 		FullMethod: "/leo.goose.example.path.v1.EnumPath/EnumPath",
 	},
 }
+
+type ResourcePathService interface {
+	GetBook(ctx context.Context, req *ResourcePathRequest) (*httpbody.HttpBody, error)
+	PublishBook(ctx context.Context, req *ResourcePathRequest) (*httpbody.HttpBody, error)
+	ArchiveBook(ctx context.Context, req *ResourcePathRequest) (*httpbody.HttpBody, error)
+	GetFile(ctx context.Context, req *ResourcePathRequest) (*httpbody.HttpBody, error)
+}
+
+func AppendResourcePathHttpRoute[R goose.Router](router R, service ResourcePathService, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	handler := resourcePathHandler{
+		service: service,
+		decoder: resourcePathRequestDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		encoder: resourcePathResponseEncoder{
+			marshalOptions:   options.MarshalOptions(),
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		errorEncoder:            options.ErrorEncoder(),
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("GET "+options.Prefix()+"/v1/shelves/{name_1}/books/{name_2}", http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		switch {
+		case goose.MatchVerb(request, "name_2", "publish"):
+			handler.PublishBook(response, request)
+		case goose.MatchVerb(request, "name_2", "archive"):
+			handler.ArchiveBook(response, request)
+		default:
+			handler.GetBook(response, request)
+		}
+	}))
+	router.Handle("GET "+options.Prefix()+"/v1/static/files/{name_1...}", http.HandlerFunc(handler.GetFile))
+	return router
+}
+
+func NewResourcePathHandler(service ResourcePathService, opts ...server.Option) http.Handler {
+	return AppendResourcePathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
+type resourcePathHandler struct {
+	service                 ResourcePathService
+	decoder                 resourcePathRequestDecoder
+	encoder                 resourcePathResponseEncoder
+	errorEncoder            goose.ErrorEncoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              server.Middleware
+	prefix                  string
+}
+
+func (h resourcePathHandler) GetBook(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.GetBook(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.GetBook(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.GetBook(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_ResourcePath_GetBook_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+func (h resourcePathHandler) PublishBook(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.PublishBook(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.PublishBook(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.PublishBook(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_ResourcePath_PublishBook_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+func (h resourcePathHandler) ArchiveBook(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.ArchiveBook(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.ArchiveBook(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.ArchiveBook(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_ResourcePath_ArchiveBook_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+func (h resourcePathHandler) GetFile(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.GetFile(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.GetFile(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.GetFile(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_ResourcePath_GetFile_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+type resourcePathRequestDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (decoder resourcePathRequestDecoder) GetBook(ctx context.Context, request *http.Request) (*ResourcePathRequest, error) {
+	req := &ResourcePathRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := url.Values{}
+	vars.Set("name", "shelves/"+request.PathValue("name_1")+"/books/"+request.PathValue("name_2"))
+	var varErr error
+	req.Name = vars.Get("name")
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
+func (decoder resourcePathRequestDecoder) PublishBook(ctx context.Context, request *http.Request) (*ResourcePathRequest, error) {
+	req := &ResourcePathRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := url.Values{}
+	vars.Set("name", "shelves/"+request.PathValue("name_1")+"/books/"+request.PathValue("name_2"))
+	var varErr error
+	req.Name = vars.Get("name")
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
+func (decoder resourcePathRequestDecoder) ArchiveBook(ctx context.Context, request *http.Request) (*ResourcePathRequest, error) {
+	req := &ResourcePathRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := url.Values{}
+	vars.Set("name", "shelves/"+request.PathValue("name_1")+"/books/"+request.PathValue("name_2"))
+	var varErr error
+	req.Name = vars.Get("name")
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
+func (decoder resourcePathRequestDecoder) GetFile(ctx context.Context, request *http.Request) (*ResourcePathRequest, error) {
+	req := &ResourcePathRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := url.Values{}
+	vars.Set("name", "files/"+request.PathValue("name_1"))
+	var varErr error
+	req.Name = vars.Get("name")
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
+
+type resourcePathResponseEncoder struct {
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (encoder resourcePathResponseEncoder) GetBook(ctx context.Context, w http.ResponseWriter, resp *httpbody.HttpBody) error {
+	return server.EncodeHttpBody(ctx, w, resp)
+}
+func (encoder resourcePathResponseEncoder) PublishBook(ctx context.Context, w http.ResponseWriter, resp *httpbody.HttpBody) error {
+	return server.EncodeHttpBody(ctx, w, resp)
+}
+func (encoder resourcePathResponseEncoder) ArchiveBook(ctx context.Context, w http.ResponseWriter, resp *httpbody.HttpBody) error {
+	return server.EncodeHttpBody(ctx, w, resp)
+}
+func (encoder resourcePathResponseEncoder) GetFile(ctx context.Context, w http.ResponseWriter, resp *httpbody.HttpBody) error {
+	return server.EncodeHttpBody(ctx, w, resp)
+}
+
+func NewResourcePathHttpClient(target string, opts ...client.Option) ResourcePathHttpClient {
+	options := client.NewOptions(opts...)
+	client := &resourcePathHttpClient{
+		client: options.Client(),
+		encoder: resourcePathRequestEncoder{
+			target:         target,
+			marshalOptions: options.MarshalOptions(),
+			resolver:       options.Resolver(),
+		},
+		decoder: resourcePathResponseDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+			errorDecoder:     options.ErrorDecoder(),
+			errorFactory:     options.ErrorFactory(),
+		},
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              client.Chain(options.Middlewares()...),
+	}
+	return client
+}
+
+type ResourcePathHttpClient interface {
+	GetBook(ctx context.Context, req *ResourcePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+	PublishBook(ctx context.Context, req *ResourcePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+	ArchiveBook(ctx context.Context, req *ResourcePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+	GetFile(ctx context.Context, req *ResourcePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ ResourcePathHttpClient = (*resourcePathHttpClient)(nil)
+
+type resourcePathHttpClient struct {
+	client                  *http.Client
+	encoder                 resourcePathRequestEncoder
+	decoder                 resourcePathResponseDecoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              client.Middleware
+}
+
+func (c *resourcePathHttpClient) GetBook(ctx context.Context, req *ResourcePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.GetBook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_ResourcePath_GetBook_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.GetBook(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *resourcePathHttpClient) PublishBook(ctx context.Context, req *ResourcePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.PublishBook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_ResourcePath_PublishBook_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.PublishBook(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *resourcePathHttpClient) ArchiveBook(ctx context.Context, req *ResourcePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.ArchiveBook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_ResourcePath_ArchiveBook_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.ArchiveBook(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *resourcePathHttpClient) GetFile(ctx context.Context, req *ResourcePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.GetFile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_ResourcePath_GetFile_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.GetFile(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type resourcePathRequestEncoder struct {
+	target         string
+	marshalOptions protojson.MarshalOptions
+	resolver       resolver.Resolver
+}
+
+func (encoder *resourcePathRequestEncoder) GetBook(ctx context.Context, req *ResourcePathRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/{name}"
+	pairs := map[string]string{
+		"name": req.GetName(),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+func (encoder *resourcePathRequestEncoder) PublishBook(ctx context.Context, req *ResourcePathRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/{name}:publish"
+	pairs := map[string]string{
+		"name": req.GetName(),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+func (encoder *resourcePathRequestEncoder) ArchiveBook(ctx context.Context, req *ResourcePathRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/{name}:archive"
+	pairs := map[string]string{
+		"name": req.GetName(),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+func (encoder *resourcePathRequestEncoder) GetFile(ctx context.Context, req *ResourcePathRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/static/{name}"
+	pairs := map[string]string{
+		"name": req.GetName(),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+type resourcePathResponseDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+	errorDecoder     goose.ErrorDecoder
+	errorFactory     goose.ErrorFactory
+}
+
+func (decoder *resourcePathResponseDecoder) GetBook(ctx context.Context, response *http.Response) (*httpbody.HttpBody, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &httpbody.HttpBody{}
+	if err := client.DecodeHttpBody(ctx, response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (decoder *resourcePathResponseDecoder) PublishBook(ctx context.Context, response *http.Response) (*httpbody.HttpBody, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &httpbody.HttpBody{}
+	if err := client.DecodeHttpBody(ctx, response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (decoder *resourcePathResponseDecoder) ArchiveBook(ctx context.Context, response *http.Response) (*httpbody.HttpBody, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &httpbody.HttpBody{}
+	if err := client.DecodeHttpBody(ctx, response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (decoder *resourcePathResponseDecoder) GetFile(ctx context.Context, response *http.Response) (*httpbody.HttpBody, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &httpbody.HttpBody{}
+	if err := client.DecodeHttpBody(ctx, response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var _leo_goose_example_path_v1_ResourcePath_GetBook_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/shelves/{name_1}/books/{name_2}",
+		FullMethod: "/leo.goose.example.path.v1.ResourcePath/GetBook",
+	},
+}
+
+var _leo_goose_example_path_v1_ResourcePath_PublishBook_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/shelves/{name_1}/books/{name_2}",
+		FullMethod: "/leo.goose.example.path.v1.ResourcePath/PublishBook",
+	},
+}
+
+var _leo_goose_example_path_v1_ResourcePath_ArchiveBook_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/shelves/{name_1}/books/{name_2}",
+		FullMethod: "/leo.goose.example.path.v1.ResourcePath/ArchiveBook",
+	},
+}
+
+var _leo_goose_example_path_v1_ResourcePath_GetFile_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/static/files/{name_1...}",
+		FullMethod: "/leo.goose.example.path.v1.ResourcePath/GetFile",
+	},
+}
+
+type TimePathService interface {
+	TimePath(ctx context.Context, req *TimePathRequest) (*httpbody.HttpBody, error)
+}
+
+func AppendTimePathHttpRoute[R goose.Router](router R, service TimePathService, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	handler := timePathHandler{
+		service: service,
+		decoder: timePathRequestDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		encoder: timePathResponseEncoder{
+			marshalOptions:   options.MarshalOptions(),
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		errorEncoder:            options.ErrorEncoder(),
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("GET "+options.Prefix()+"/v1/time/{at}/{ttl}", http.HandlerFunc(handler.TimePath))
+	return router
+}
+
+func NewTimePathHandler(service TimePathService, opts ...server.Option) http.Handler {
+	return AppendTimePathHttpRoute(http.NewServeMux(), service, opts...)
+}
+
+type timePathHandler struct {
+	service                 TimePathService
+	decoder                 timePathRequestDecoder
+	encoder                 timePathResponseEncoder
+	errorEncoder            goose.ErrorEncoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              server.Middleware
+	prefix                  string
+}
+
+func (h timePathHandler) TimePath(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.TimePath(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.TimePath(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.TimePath(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_path_v1_TimePath_TimePath_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+type timePathRequestDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (decoder timePathRequestDecoder) TimePath(ctx context.Context, request *http.Request) (*TimePathRequest, error) {
+	req := &TimePathRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := goose.FormFromPath(request, "at", "ttl")
+	var varErr error
+	req.At, varErr = goose.GetForm[*timestamppb.Timestamp](varErr, vars, "at", goose.GetTimestamp)
+	req.Ttl, varErr = goose.GetForm[*durationpb.Duration](varErr, vars, "ttl", goose.GetDuration)
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
+
+type timePathResponseEncoder struct {
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (encoder timePathResponseEncoder) TimePath(ctx context.Context, w http.ResponseWriter, resp *httpbody.HttpBody) error {
+	return server.EncodeHttpBody(ctx, w, resp)
+}
+
+func NewTimePathHttpClient(target string, opts ...client.Option) TimePathHttpClient {
+	options := client.NewOptions(opts...)
+	client := &timePathHttpClient{
+		client: options.Client(),
+		encoder: timePathRequestEncoder{
+			target:         target,
+			marshalOptions: options.MarshalOptions(),
+			resolver:       options.Resolver(),
+		},
+		decoder: timePathResponseDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+			errorDecoder:     options.ErrorDecoder(),
+			errorFactory:     options.ErrorFactory(),
+		},
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              client.Chain(options.Middlewares()...),
+	}
+	return client
+}
+
+type TimePathHttpClient interface {
+	TimePath(ctx context.Context, req *TimePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ TimePathHttpClient = (*timePathHttpClient)(nil)
+
+type timePathHttpClient struct {
+	client                  *http.Client
+	encoder                 timePathRequestEncoder
+	decoder                 timePathResponseDecoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              client.Middleware
+}
+
+func (c *timePathHttpClient) TimePath(ctx context.Context, req *TimePathRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.TimePath(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_path_v1_TimePath_TimePath_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.TimePath(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type timePathRequestEncoder struct {
+	target         string
+	marshalOptions protojson.MarshalOptions
+	resolver       resolver.Resolver
+}
+
+func (encoder *timePathRequestEncoder) TimePath(ctx context.Context, req *TimePathRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/time/{at}/{ttl}"
+	pairs := map[string]string{
+		"at":  goose.FormatTimestamp(req.GetAt()),
+		"ttl": goose.FormatDuration(req.GetTtl()),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+type timePathResponseDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+	errorDecoder     goose.ErrorDecoder
+	errorFactory     goose.ErrorFactory
+}
+
+func (decoder *timePathResponseDecoder) TimePath(ctx context.Context, response *http.Response) (*httpbody.HttpBody, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &httpbody.HttpBody{}
+	if err := client.DecodeHttpBody(ctx, response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var _leo_goose_example_path_v1_TimePath_TimePath_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/time/{at}/{ttl}",
+		FullMethod: "/leo.goose.example.path.v1.TimePath/TimePath",
+	},
+}
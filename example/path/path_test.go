@@ -6,10 +6,14 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/soyacen/goose/client"
 	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -105,6 +109,34 @@ func (m *MockEnumPathService) EnumPath(ctx context.Context, req *EnumPathRequest
 	return &httpbody.HttpBody{Data: data}, nil
 }
 
+type MockTimePathService struct{}
+
+func (m *MockTimePathService) TimePath(ctx context.Context, req *TimePathRequest) (*httpbody.HttpBody, error) {
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return &httpbody.HttpBody{Data: data}, nil
+}
+
+type MockResourcePathService struct{}
+
+func (m *MockResourcePathService) GetBook(ctx context.Context, req *ResourcePathRequest) (*httpbody.HttpBody, error) {
+	return &httpbody.HttpBody{Data: []byte("get " + req.GetName())}, nil
+}
+
+func (m *MockResourcePathService) PublishBook(ctx context.Context, req *ResourcePathRequest) (*httpbody.HttpBody, error) {
+	return &httpbody.HttpBody{Data: []byte("publish " + req.GetName())}, nil
+}
+
+func (m *MockResourcePathService) ArchiveBook(ctx context.Context, req *ResourcePathRequest) (*httpbody.HttpBody, error) {
+	return &httpbody.HttpBody{Data: []byte("archive " + req.GetName())}, nil
+}
+
+func (m *MockResourcePathService) GetFile(ctx context.Context, req *ResourcePathRequest) (*httpbody.HttpBody, error) {
+	return &httpbody.HttpBody{Data: []byte("file " + req.GetName())}, nil
+}
+
 // ---- Test Cases ----
 
 func TestBoolPath(t *testing.T) {
@@ -362,3 +394,77 @@ func TestEnumPath(t *testing.T) {
 		t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
 	}
 }
+
+func TestResourcePath(t *testing.T) {
+	server := &http.Server{}
+	go func() {
+		router := http.NewServeMux()
+		router = AppendResourcePathHttpRoute(router, &MockResourcePathService{})
+		server.Addr = ":48090"
+		server.Handler = router
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+	defer server.Close()
+	time.Sleep(time.Second)
+	cli := NewResourcePathHttpClient("http://localhost:48090")
+	req := &ResourcePathRequest{Name: "shelves/1/books/2"}
+	calls := map[string]func(context.Context, *ResourcePathRequest, ...client.CallOption) (*httpbody.HttpBody, error){
+		"get shelves/1/books/2":     cli.GetBook,
+		"publish shelves/1/books/2": cli.PublishBook,
+		"archive shelves/1/books/2": cli.ArchiveBook,
+	}
+	for expected, call := range calls {
+		resp, err := call(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(resp.GetData()) != expected {
+			t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
+		}
+	}
+	resp, err := cli.GetFile(context.Background(), &ResourcePathRequest{Name: "files/a/b.txt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.GetData()) != "file files/a/b.txt" {
+		t.Fatalf("body is not equal: got %s", string(resp.GetData()))
+	}
+
+	response, err := http.Get("http://localhost:48090/v1/shelves/1/books/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("status code is %d, want %d", response.StatusCode, http.StatusOK)
+	}
+}
+
+func TestTimePath(t *testing.T) {
+	server := &http.Server{}
+	go func() {
+		router := http.NewServeMux()
+		router = AppendTimePathHttpRoute(router, &MockTimePathService{})
+		server.Addr = ":48105"
+		server.Handler = router
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+	defer server.Close()
+	time.Sleep(time.Second)
+	cli := NewTimePathHttpClient("http://localhost:48105")
+	resp, err := cli.TimePath(context.Background(), &TimePathRequest{
+		At:  &timestamppb.Timestamp{Seconds: 1704067200},
+		Ttl: &durationpb.Duration{Seconds: 1, Nanos: 500000000},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"at":"2024-01-01T00:00:00Z", "ttl":"1.500s"}`
+	if strings.ReplaceAll(string(resp.GetData()), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
+	}
+}
@@ -76,7 +76,7 @@ type oaSchema struct {
 	Items                *oaSchema           `json:"items,omitempty"`
 	Required             []string            `json:"required,omitempty"`
 	Ref                  string              `json:"$ref,omitempty"`
-	Enum                 []string            `json:"enum,omitempty"`
+	Enum                 []any               `json:"enum,omitempty"`
 	Nullable             bool                `json:"nullable,omitempty"`
 	AdditionalProperties *oaSchema           `json:"additionalProperties,omitempty"`
 }
@@ -126,7 +126,11 @@ func exampleValue(fieldName string, schema *oaSchema, components *oaComponents)
 
 func exampleString(fieldName string, schema *oaSchema) string {
 	if len(schema.Enum) > 0 {
-		return schema.Enum[0]
+		return fmt.Sprint(schema.Enum[0])
+	}
+	if schema.Format == "int64" || schema.Format == "uint64" {
+		// protojson writes 64-bit integers as strings
+		return "1"
 	}
 
 	switch {
@@ -138,6 +142,8 @@ func exampleString(fieldName string, schema *oaSchema) string {
 		return "https://example.com"
 	case strings.Contains(fieldName, "contentType") || strings.Contains(fieldName, "content_type"):
 		return "application/json"
+	case strings.Contains(fieldName, "ttl") || strings.Contains(fieldName, "duration"):
+		return "1.5s"
 	case schema.Format == "date-time":
 		return "2024-01-01T00:00:00Z"
 	case schema.Format == "byte" || schema.Format == "binary":
@@ -147,7 +153,12 @@ func exampleString(fieldName string, schema *oaSchema) string {
 	}
 }
 
-func exampleInt(fieldName string, _ *oaSchema) int64 {
+func exampleInt(fieldName string, schema *oaSchema) int64 {
+	if len(schema.Enum) > 0 {
+		if value, ok := schema.Enum[0].(float64); ok {
+			return int64(value)
+		}
+	}
 	switch {
 	case strings.Contains(fieldName, "pageNum") || strings.Contains(fieldName, "page_num"):
 		return 1
@@ -382,6 +393,9 @@ func TestOpenAPISpec(t *testing.T) {
 		router = AppendDoubleQueryHttpRoute(router, &MockDoubleQueryService{})
 		router = AppendStringQueryHttpRoute(router, &MockStringQueryService{})
 		router = AppendEnumQueryHttpRoute(router, &MockEnumQueryService{})
+		router = AppendNestedQueryHttpRoute(router, &MockNestedQueryService{})
+		router = AppendTimeQueryHttpRoute(router, &MockTimeQueryService{})
+		router = AppendMapQueryHttpRoute(router, &MockMapQueryService{})
 		server.Addr = fmt.Sprintf(":%d", port)
 		server.Handler = router
 		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -506,13 +520,13 @@ func TestOpenAPIContent(t *testing.T) {
 	if int32Op != nil {
 		foundList := false
 		for _, p := range int32Op.Parameters {
-			if p.In == "query" && p.Name == "listInt32" {
+			if p.In == "query" && p.Name == "list_int32" {
 				foundList = true
 				break
 			}
 		}
 		if !foundList {
-			t.Error("GET /v1/int32 missing query parameter 'listInt32'")
+			t.Error("GET /v1/int32 missing query parameter 'list_int32'")
 		}
 	}
 }
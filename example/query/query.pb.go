@@ -11,6 +11,9 @@ import (
 	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -888,11 +891,335 @@ func (x *EnumQueryRequest) GetListStatus() []EnumQueryRequest_Status {
 	return nil
 }
 
+type NestedQueryRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Filter        *NestedQueryRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize      int32                      `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedQueryRequest) Reset() {
+	*x = NestedQueryRequest{}
+	mi := &file_example_query_query_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedQueryRequest) ProtoMessage() {}
+
+func (x *NestedQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_query_query_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedQueryRequest.ProtoReflect.Descriptor instead.
+func (*NestedQueryRequest) Descriptor() ([]byte, []int) {
+	return file_example_query_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NestedQueryRequest) GetFilter() *NestedQueryRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *NestedQueryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type TimeQueryRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	CreatedAfter  *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	Ttl           *durationpb.Duration     `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	ReadMask      *fieldmaskpb.FieldMask   `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	ListTimestamp []*timestamppb.Timestamp `protobuf:"bytes,4,rep,name=list_timestamp,json=listTimestamp,proto3" json:"list_timestamp,omitempty"`
+	ListDuration  []*durationpb.Duration   `protobuf:"bytes,5,rep,name=list_duration,json=listDuration,proto3" json:"list_duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeQueryRequest) Reset() {
+	*x = TimeQueryRequest{}
+	mi := &file_example_query_query_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeQueryRequest) ProtoMessage() {}
+
+func (x *TimeQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_query_query_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeQueryRequest.ProtoReflect.Descriptor instead.
+func (*TimeQueryRequest) Descriptor() ([]byte, []int) {
+	return file_example_query_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TimeQueryRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *TimeQueryRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *TimeQueryRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *TimeQueryRequest) GetListTimestamp() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.ListTimestamp
+	}
+	return nil
+}
+
+func (x *TimeQueryRequest) GetListDuration() []*durationpb.Duration {
+	if x != nil {
+		return x.ListDuration
+	}
+	return nil
+}
+
+type MapQueryRequest struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Labels        map[string]string                  `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Counts        map[int32]int64                    `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Flags         map[uint64]bool                    `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Weights       map[string]float64                 `protobuf:"bytes,4,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	States        map[string]EnumQueryRequest_Status `protobuf:"bytes,5,rep,name=states,proto3" json:"states,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=leo.goose.example.query.v1.EnumQueryRequest_Status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapQueryRequest) Reset() {
+	*x = MapQueryRequest{}
+	mi := &file_example_query_query_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapQueryRequest) ProtoMessage() {}
+
+func (x *MapQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_query_query_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapQueryRequest.ProtoReflect.Descriptor instead.
+func (*MapQueryRequest) Descriptor() ([]byte, []int) {
+	return file_example_query_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MapQueryRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *MapQueryRequest) GetCounts() map[int32]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *MapQueryRequest) GetFlags() map[uint64]bool {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *MapQueryRequest) GetWeights() map[string]float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *MapQueryRequest) GetStates() map[string]EnumQueryRequest_Status {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type NestedQueryRequest_Owner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedQueryRequest_Owner) Reset() {
+	*x = NestedQueryRequest_Owner{}
+	mi := &file_example_query_query_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedQueryRequest_Owner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedQueryRequest_Owner) ProtoMessage() {}
+
+func (x *NestedQueryRequest_Owner) ProtoReflect() protoreflect.Message {
+	mi := &file_example_query_query_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedQueryRequest_Owner.ProtoReflect.Descriptor instead.
+func (*NestedQueryRequest_Owner) Descriptor() ([]byte, []int) {
+	return file_example_query_query_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *NestedQueryRequest_Owner) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NestedQueryRequest_Owner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NestedQueryRequest_Filter struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Status        EnumQueryRequest_Status   `protobuf:"varint,1,opt,name=status,proto3,enum=leo.goose.example.query.v1.EnumQueryRequest_Status" json:"status,omitempty"`
+	Owner         *NestedQueryRequest_Owner `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Tags          []string                  `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Labels        map[string]string         `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NestedQueryRequest_Filter) Reset() {
+	*x = NestedQueryRequest_Filter{}
+	mi := &file_example_query_query_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedQueryRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedQueryRequest_Filter) ProtoMessage() {}
+
+func (x *NestedQueryRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_example_query_query_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedQueryRequest_Filter.ProtoReflect.Descriptor instead.
+func (*NestedQueryRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_example_query_query_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *NestedQueryRequest_Filter) GetStatus() EnumQueryRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return EnumQueryRequest_UNKNOWN
+}
+
+func (x *NestedQueryRequest_Filter) GetOwner() *NestedQueryRequest_Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *NestedQueryRequest_Filter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *NestedQueryRequest_Filter) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 var File_example_query_query_proto protoreflect.FileDescriptor
 
 const file_example_query_query_proto_rawDesc = "" +
 	"\n" +
-	"\x19example/query/query.proto\x12\x1aleo.goose.example.query.v1\x1a\x19google/api/httpbody.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xeb\x01\n" +
+	"\x19example/query/query.proto\x12\x1aleo.goose.example.query.v1\x1a\x19google/api/httpbody.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\"\xeb\x01\n" +
 	"\x10BoolQueryRequest\x12\x12\n" +
 	"\x04bool\x18\x01 \x01(\bR\x04bool\x12\x1e\n" +
 	"\bopt_bool\x18\x02 \x01(\bH\x00R\aoptBool\x88\x01\x01\x127\n" +
@@ -1013,7 +1340,49 @@ const file_example_query_query_proto_rawDesc = "" +
 	"\x02OK\x10\x01\x12\r\n" +
 	"\tCANCELLED\x10\x02\x12\x11\n" +
 	"\rUNKNOWN_ERROR\x10\x03B\r\n" +
-	"\v_opt_status2n\n" +
+	"\v_opt_status\"\xfb\x03\n" +
+	"\x12NestedQueryRequest\x12M\n" +
+	"\x06filter\x18\x01 \x01(\v25.leo.goose.example.query.v1.NestedQueryRequest.FilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x1a+\n" +
+	"\x05Owner\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x1a\xcb\x02\n" +
+	"\x06Filter\x12K\n" +
+	"\x06status\x18\x01 \x01(\x0e23.leo.goose.example.query.v1.EnumQueryRequest.StatusR\x06status\x12J\n" +
+	"\x05owner\x18\x02 \x01(\v24.leo.goose.example.query.v1.NestedQueryRequest.OwnerR\x05owner\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12Y\n" +
+	"\x06labels\x18\x04 \x03(\v2A.leo.goose.example.query.v1.NestedQueryRequest.Filter.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbc\x02\n" +
+	"\x10TimeQueryRequest\x12?\n" +
+	"\rcreated_after\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12A\n" +
+	"\x0elist_timestamp\x18\x04 \x03(\v2\x1a.google.protobuf.TimestampR\rlistTimestamp\x12>\n" +
+	"\rlist_duration\x18\x05 \x03(\v2\x19.google.protobuf.DurationR\flistDuration\"\x82\x06\n" +
+	"\x0fMapQueryRequest\x12O\n" +
+	"\x06labels\x18\x01 \x03(\v27.leo.goose.example.query.v1.MapQueryRequest.LabelsEntryR\x06labels\x12O\n" +
+	"\x06counts\x18\x02 \x03(\v27.leo.goose.example.query.v1.MapQueryRequest.CountsEntryR\x06counts\x12L\n" +
+	"\x05flags\x18\x03 \x03(\v26.leo.goose.example.query.v1.MapQueryRequest.FlagsEntryR\x05flags\x12R\n" +
+	"\aweights\x18\x04 \x03(\v28.leo.goose.example.query.v1.MapQueryRequest.WeightsEntryR\aweights\x12O\n" +
+	"\x06states\x18\x05 \x03(\v27.leo.goose.example.query.v1.MapQueryRequest.StatesEntryR\x06states\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"FlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1a:\n" +
+	"\fWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1an\n" +
+	"\vStatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12I\n" +
+	"\x05value\x18\x02 \x01(\x0e23.leo.goose.example.query.v1.EnumQueryRequest.StatusR\x05value:\x028\x012n\n" +
 	"\tBoolQuery\x12a\n" +
 	"\tBoolQuery\x12,.leo.goose.example.query.v1.BoolQueryRequest\x1a\x14.google.api.HttpBody\"\x10\x82\xd3\xe4\x93\x02\n" +
 	"\x12\b/v1/bool2r\n" +
@@ -1043,7 +1412,15 @@ const file_example_query_query_proto_rawDesc = "" +
 	"/v1/string2n\n" +
 	"\tEnumQuery\x12a\n" +
 	"\tEnumQuery\x12,.leo.goose.example.query.v1.EnumQueryRequest\x1a\x14.google.api.HttpBody\"\x10\x82\xd3\xe4\x93\x02\n" +
-	"\x12\b/v1/enumB1Z/github.com/soyacen/goose/example/query/v1;queryb\x06proto3"
+	"\x12\b/v1/enum2v\n" +
+	"\vNestedQuery\x12g\n" +
+	"\vNestedQuery\x12..leo.goose.example.query.v1.NestedQueryRequest\x1a\x14.google.api.HttpBody\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/nested2n\n" +
+	"\tTimeQuery\x12a\n" +
+	"\tTimeQuery\x12,.leo.goose.example.query.v1.TimeQueryRequest\x1a\x14.google.api.HttpBody\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/time2j\n" +
+	"\bMapQuery\x12^\n" +
+	"\bMapQuery\x12+.leo.goose.example.query.v1.MapQueryRequest\x1a\x14.google.api.HttpBody\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/v1/mapB1Z/github.com/soyacen/goose/example/query/v1;queryb\x06proto3"
 
 var (
 	file_example_query_query_proto_rawDescOnce sync.Once
@@ -1058,71 +1435,106 @@ func file_example_query_query_proto_rawDescGZIP() []byte {
 }
 
 var file_example_query_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_example_query_query_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_example_query_query_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_example_query_query_proto_goTypes = []any{
-	(EnumQueryRequest_Status)(0),   // 0: leo.goose.example.query.v1.EnumQueryRequest.Status
-	(*BoolQueryRequest)(nil),       // 1: leo.goose.example.query.v1.BoolQueryRequest
-	(*Int32QueryRequest)(nil),      // 2: leo.goose.example.query.v1.Int32QueryRequest
-	(*Int64QueryRequest)(nil),      // 3: leo.goose.example.query.v1.Int64QueryRequest
-	(*Uint32QueryRequest)(nil),     // 4: leo.goose.example.query.v1.Uint32QueryRequest
-	(*Uint64QueryRequest)(nil),     // 5: leo.goose.example.query.v1.Uint64QueryRequest
-	(*FloatQueryRequest)(nil),      // 6: leo.goose.example.query.v1.FloatQueryRequest
-	(*DoubleQueryRequest)(nil),     // 7: leo.goose.example.query.v1.DoubleQueryRequest
-	(*StringQueryRequest)(nil),     // 8: leo.goose.example.query.v1.StringQueryRequest
-	(*EnumQueryRequest)(nil),       // 9: leo.goose.example.query.v1.EnumQueryRequest
-	(*wrapperspb.BoolValue)(nil),   // 10: google.protobuf.BoolValue
-	(*wrapperspb.Int32Value)(nil),  // 11: google.protobuf.Int32Value
-	(*wrapperspb.Int64Value)(nil),  // 12: google.protobuf.Int64Value
-	(*wrapperspb.UInt32Value)(nil), // 13: google.protobuf.UInt32Value
-	(*wrapperspb.UInt64Value)(nil), // 14: google.protobuf.UInt64Value
-	(*wrapperspb.FloatValue)(nil),  // 15: google.protobuf.FloatValue
-	(*wrapperspb.DoubleValue)(nil), // 16: google.protobuf.DoubleValue
-	(*wrapperspb.StringValue)(nil), // 17: google.protobuf.StringValue
-	(*httpbody.HttpBody)(nil),      // 18: google.api.HttpBody
+	(EnumQueryRequest_Status)(0),      // 0: leo.goose.example.query.v1.EnumQueryRequest.Status
+	(*BoolQueryRequest)(nil),          // 1: leo.goose.example.query.v1.BoolQueryRequest
+	(*Int32QueryRequest)(nil),         // 2: leo.goose.example.query.v1.Int32QueryRequest
+	(*Int64QueryRequest)(nil),         // 3: leo.goose.example.query.v1.Int64QueryRequest
+	(*Uint32QueryRequest)(nil),        // 4: leo.goose.example.query.v1.Uint32QueryRequest
+	(*Uint64QueryRequest)(nil),        // 5: leo.goose.example.query.v1.Uint64QueryRequest
+	(*FloatQueryRequest)(nil),         // 6: leo.goose.example.query.v1.FloatQueryRequest
+	(*DoubleQueryRequest)(nil),        // 7: leo.goose.example.query.v1.DoubleQueryRequest
+	(*StringQueryRequest)(nil),        // 8: leo.goose.example.query.v1.StringQueryRequest
+	(*EnumQueryRequest)(nil),          // 9: leo.goose.example.query.v1.EnumQueryRequest
+	(*NestedQueryRequest)(nil),        // 10: leo.goose.example.query.v1.NestedQueryRequest
+	(*TimeQueryRequest)(nil),          // 11: leo.goose.example.query.v1.TimeQueryRequest
+	(*MapQueryRequest)(nil),           // 12: leo.goose.example.query.v1.MapQueryRequest
+	(*NestedQueryRequest_Owner)(nil),  // 13: leo.goose.example.query.v1.NestedQueryRequest.Owner
+	(*NestedQueryRequest_Filter)(nil), // 14: leo.goose.example.query.v1.NestedQueryRequest.Filter
+	nil,                               // 15: leo.goose.example.query.v1.NestedQueryRequest.Filter.LabelsEntry
+	nil,                               // 16: leo.goose.example.query.v1.MapQueryRequest.LabelsEntry
+	nil,                               // 17: leo.goose.example.query.v1.MapQueryRequest.CountsEntry
+	nil,                               // 18: leo.goose.example.query.v1.MapQueryRequest.FlagsEntry
+	nil,                               // 19: leo.goose.example.query.v1.MapQueryRequest.WeightsEntry
+	nil,                               // 20: leo.goose.example.query.v1.MapQueryRequest.StatesEntry
+	(*wrapperspb.BoolValue)(nil),      // 21: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),     // 22: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),     // 23: google.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil),    // 24: google.protobuf.UInt32Value
+	(*wrapperspb.UInt64Value)(nil),    // 25: google.protobuf.UInt64Value
+	(*wrapperspb.FloatValue)(nil),     // 26: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil),    // 27: google.protobuf.DoubleValue
+	(*wrapperspb.StringValue)(nil),    // 28: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),     // 29: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 30: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),     // 31: google.protobuf.FieldMask
+	(*httpbody.HttpBody)(nil),         // 32: google.api.HttpBody
 }
 var file_example_query_query_proto_depIdxs = []int32{
-	10, // 0: leo.goose.example.query.v1.BoolQueryRequest.wrap_bool:type_name -> google.protobuf.BoolValue
-	10, // 1: leo.goose.example.query.v1.BoolQueryRequest.list_wrap_bool:type_name -> google.protobuf.BoolValue
-	11, // 2: leo.goose.example.query.v1.Int32QueryRequest.wrap_int32:type_name -> google.protobuf.Int32Value
-	11, // 3: leo.goose.example.query.v1.Int32QueryRequest.list_wrap_int32:type_name -> google.protobuf.Int32Value
-	12, // 4: leo.goose.example.query.v1.Int64QueryRequest.wrap_int64:type_name -> google.protobuf.Int64Value
-	12, // 5: leo.goose.example.query.v1.Int64QueryRequest.list_wrap_int64:type_name -> google.protobuf.Int64Value
-	13, // 6: leo.goose.example.query.v1.Uint32QueryRequest.wrap_uint32:type_name -> google.protobuf.UInt32Value
-	13, // 7: leo.goose.example.query.v1.Uint32QueryRequest.list_wrap_uint32:type_name -> google.protobuf.UInt32Value
-	14, // 8: leo.goose.example.query.v1.Uint64QueryRequest.wrap_uint64:type_name -> google.protobuf.UInt64Value
-	14, // 9: leo.goose.example.query.v1.Uint64QueryRequest.list_wrap_uint64:type_name -> google.protobuf.UInt64Value
-	15, // 10: leo.goose.example.query.v1.FloatQueryRequest.wrap_float:type_name -> google.protobuf.FloatValue
-	15, // 11: leo.goose.example.query.v1.FloatQueryRequest.list_wrap_float:type_name -> google.protobuf.FloatValue
-	16, // 12: leo.goose.example.query.v1.DoubleQueryRequest.wrap_double:type_name -> google.protobuf.DoubleValue
-	16, // 13: leo.goose.example.query.v1.DoubleQueryRequest.list_wrap_double:type_name -> google.protobuf.DoubleValue
-	17, // 14: leo.goose.example.query.v1.StringQueryRequest.wrap_string:type_name -> google.protobuf.StringValue
-	17, // 15: leo.goose.example.query.v1.StringQueryRequest.list_wrap_string:type_name -> google.protobuf.StringValue
+	21, // 0: leo.goose.example.query.v1.BoolQueryRequest.wrap_bool:type_name -> google.protobuf.BoolValue
+	21, // 1: leo.goose.example.query.v1.BoolQueryRequest.list_wrap_bool:type_name -> google.protobuf.BoolValue
+	22, // 2: leo.goose.example.query.v1.Int32QueryRequest.wrap_int32:type_name -> google.protobuf.Int32Value
+	22, // 3: leo.goose.example.query.v1.Int32QueryRequest.list_wrap_int32:type_name -> google.protobuf.Int32Value
+	23, // 4: leo.goose.example.query.v1.Int64QueryRequest.wrap_int64:type_name -> google.protobuf.Int64Value
+	23, // 5: leo.goose.example.query.v1.Int64QueryRequest.list_wrap_int64:type_name -> google.protobuf.Int64Value
+	24, // 6: leo.goose.example.query.v1.Uint32QueryRequest.wrap_uint32:type_name -> google.protobuf.UInt32Value
+	24, // 7: leo.goose.example.query.v1.Uint32QueryRequest.list_wrap_uint32:type_name -> google.protobuf.UInt32Value
+	25, // 8: leo.goose.example.query.v1.Uint64QueryRequest.wrap_uint64:type_name -> google.protobuf.UInt64Value
+	25, // 9: leo.goose.example.query.v1.Uint64QueryRequest.list_wrap_uint64:type_name -> google.protobuf.UInt64Value
+	26, // 10: leo.goose.example.query.v1.FloatQueryRequest.wrap_float:type_name -> google.protobuf.FloatValue
+	26, // 11: leo.goose.example.query.v1.FloatQueryRequest.list_wrap_float:type_name -> google.protobuf.FloatValue
+	27, // 12: leo.goose.example.query.v1.DoubleQueryRequest.wrap_double:type_name -> google.protobuf.DoubleValue
+	27, // 13: leo.goose.example.query.v1.DoubleQueryRequest.list_wrap_double:type_name -> google.protobuf.DoubleValue
+	28, // 14: leo.goose.example.query.v1.StringQueryRequest.wrap_string:type_name -> google.protobuf.StringValue
+	28, // 15: leo.goose.example.query.v1.StringQueryRequest.list_wrap_string:type_name -> google.protobuf.StringValue
 	0,  // 16: leo.goose.example.query.v1.EnumQueryRequest.status:type_name -> leo.goose.example.query.v1.EnumQueryRequest.Status
 	0,  // 17: leo.goose.example.query.v1.EnumQueryRequest.opt_status:type_name -> leo.goose.example.query.v1.EnumQueryRequest.Status
 	0,  // 18: leo.goose.example.query.v1.EnumQueryRequest.list_status:type_name -> leo.goose.example.query.v1.EnumQueryRequest.Status
-	1,  // 19: leo.goose.example.query.v1.BoolQuery.BoolQuery:input_type -> leo.goose.example.query.v1.BoolQueryRequest
-	2,  // 20: leo.goose.example.query.v1.Int32Query.Int32Query:input_type -> leo.goose.example.query.v1.Int32QueryRequest
-	3,  // 21: leo.goose.example.query.v1.Int64Query.Int64Query:input_type -> leo.goose.example.query.v1.Int64QueryRequest
-	4,  // 22: leo.goose.example.query.v1.Uint32Query.Uint32Query:input_type -> leo.goose.example.query.v1.Uint32QueryRequest
-	5,  // 23: leo.goose.example.query.v1.Uint64Query.Uint64Query:input_type -> leo.goose.example.query.v1.Uint64QueryRequest
-	6,  // 24: leo.goose.example.query.v1.FloatQuery.FloatQuery:input_type -> leo.goose.example.query.v1.FloatQueryRequest
-	7,  // 25: leo.goose.example.query.v1.DoubleQuery.DoubleQuery:input_type -> leo.goose.example.query.v1.DoubleQueryRequest
-	8,  // 26: leo.goose.example.query.v1.StringQuery.StringQuery:input_type -> leo.goose.example.query.v1.StringQueryRequest
-	9,  // 27: leo.goose.example.query.v1.EnumQuery.EnumQuery:input_type -> leo.goose.example.query.v1.EnumQueryRequest
-	18, // 28: leo.goose.example.query.v1.BoolQuery.BoolQuery:output_type -> google.api.HttpBody
-	18, // 29: leo.goose.example.query.v1.Int32Query.Int32Query:output_type -> google.api.HttpBody
-	18, // 30: leo.goose.example.query.v1.Int64Query.Int64Query:output_type -> google.api.HttpBody
-	18, // 31: leo.goose.example.query.v1.Uint32Query.Uint32Query:output_type -> google.api.HttpBody
-	18, // 32: leo.goose.example.query.v1.Uint64Query.Uint64Query:output_type -> google.api.HttpBody
-	18, // 33: leo.goose.example.query.v1.FloatQuery.FloatQuery:output_type -> google.api.HttpBody
-	18, // 34: leo.goose.example.query.v1.DoubleQuery.DoubleQuery:output_type -> google.api.HttpBody
-	18, // 35: leo.goose.example.query.v1.StringQuery.StringQuery:output_type -> google.api.HttpBody
-	18, // 36: leo.goose.example.query.v1.EnumQuery.EnumQuery:output_type -> google.api.HttpBody
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	14, // 19: leo.goose.example.query.v1.NestedQueryRequest.filter:type_name -> leo.goose.example.query.v1.NestedQueryRequest.Filter
+	29, // 20: leo.goose.example.query.v1.TimeQueryRequest.created_after:type_name -> google.protobuf.Timestamp
+	30, // 21: leo.goose.example.query.v1.TimeQueryRequest.ttl:type_name -> google.protobuf.Duration
+	31, // 22: leo.goose.example.query.v1.TimeQueryRequest.read_mask:type_name -> google.protobuf.FieldMask
+	29, // 23: leo.goose.example.query.v1.TimeQueryRequest.list_timestamp:type_name -> google.protobuf.Timestamp
+	30, // 24: leo.goose.example.query.v1.TimeQueryRequest.list_duration:type_name -> google.protobuf.Duration
+	16, // 25: leo.goose.example.query.v1.MapQueryRequest.labels:type_name -> leo.goose.example.query.v1.MapQueryRequest.LabelsEntry
+	17, // 26: leo.goose.example.query.v1.MapQueryRequest.counts:type_name -> leo.goose.example.query.v1.MapQueryRequest.CountsEntry
+	18, // 27: leo.goose.example.query.v1.MapQueryRequest.flags:type_name -> leo.goose.example.query.v1.MapQueryRequest.FlagsEntry
+	19, // 28: leo.goose.example.query.v1.MapQueryRequest.weights:type_name -> leo.goose.example.query.v1.MapQueryRequest.WeightsEntry
+	20, // 29: leo.goose.example.query.v1.MapQueryRequest.states:type_name -> leo.goose.example.query.v1.MapQueryRequest.StatesEntry
+	0,  // 30: leo.goose.example.query.v1.NestedQueryRequest.Filter.status:type_name -> leo.goose.example.query.v1.EnumQueryRequest.Status
+	13, // 31: leo.goose.example.query.v1.NestedQueryRequest.Filter.owner:type_name -> leo.goose.example.query.v1.NestedQueryRequest.Owner
+	15, // 32: leo.goose.example.query.v1.NestedQueryRequest.Filter.labels:type_name -> leo.goose.example.query.v1.NestedQueryRequest.Filter.LabelsEntry
+	0,  // 33: leo.goose.example.query.v1.MapQueryRequest.StatesEntry.value:type_name -> leo.goose.example.query.v1.EnumQueryRequest.Status
+	1,  // 34: leo.goose.example.query.v1.BoolQuery.BoolQuery:input_type -> leo.goose.example.query.v1.BoolQueryRequest
+	2,  // 35: leo.goose.example.query.v1.Int32Query.Int32Query:input_type -> leo.goose.example.query.v1.Int32QueryRequest
+	3,  // 36: leo.goose.example.query.v1.Int64Query.Int64Query:input_type -> leo.goose.example.query.v1.Int64QueryRequest
+	4,  // 37: leo.goose.example.query.v1.Uint32Query.Uint32Query:input_type -> leo.goose.example.query.v1.Uint32QueryRequest
+	5,  // 38: leo.goose.example.query.v1.Uint64Query.Uint64Query:input_type -> leo.goose.example.query.v1.Uint64QueryRequest
+	6,  // 39: leo.goose.example.query.v1.FloatQuery.FloatQuery:input_type -> leo.goose.example.query.v1.FloatQueryRequest
+	7,  // 40: leo.goose.example.query.v1.DoubleQuery.DoubleQuery:input_type -> leo.goose.example.query.v1.DoubleQueryRequest
+	8,  // 41: leo.goose.example.query.v1.StringQuery.StringQuery:input_type -> leo.goose.example.query.v1.StringQueryRequest
+	9,  // 42: leo.goose.example.query.v1.EnumQuery.EnumQuery:input_type -> leo.goose.example.query.v1.EnumQueryRequest
+	10, // 43: leo.goose.example.query.v1.NestedQuery.NestedQuery:input_type -> leo.goose.example.query.v1.NestedQueryRequest
+	11, // 44: leo.goose.example.query.v1.TimeQuery.TimeQuery:input_type -> leo.goose.example.query.v1.TimeQueryRequest
+	12, // 45: leo.goose.example.query.v1.MapQuery.MapQuery:input_type -> leo.goose.example.query.v1.MapQueryRequest
+	32, // 46: leo.goose.example.query.v1.BoolQuery.BoolQuery:output_type -> google.api.HttpBody
+	32, // 47: leo.goose.example.query.v1.Int32Query.Int32Query:output_type -> google.api.HttpBody
+	32, // 48: leo.goose.example.query.v1.Int64Query.Int64Query:output_type -> google.api.HttpBody
+	32, // 49: leo.goose.example.query.v1.Uint32Query.Uint32Query:output_type -> google.api.HttpBody
+	32, // 50: leo.goose.example.query.v1.Uint64Query.Uint64Query:output_type -> google.api.HttpBody
+	32, // 51: leo.goose.example.query.v1.FloatQuery.FloatQuery:output_type -> google.api.HttpBody
+	32, // 52: leo.goose.example.query.v1.DoubleQuery.DoubleQuery:output_type -> google.api.HttpBody
+	32, // 53: leo.goose.example.query.v1.StringQuery.StringQuery:output_type -> google.api.HttpBody
+	32, // 54: leo.goose.example.query.v1.EnumQuery.EnumQuery:output_type -> google.api.HttpBody
+	32, // 55: leo.goose.example.query.v1.NestedQuery.NestedQuery:output_type -> google.api.HttpBody
+	32, // 56: leo.goose.example.query.v1.TimeQuery.TimeQuery:output_type -> google.api.HttpBody
+	32, // 57: leo.goose.example.query.v1.MapQuery.MapQuery:output_type -> google.api.HttpBody
+	46, // [46:58] is the sub-list for method output_type
+	34, // [34:46] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_example_query_query_proto_init() }
@@ -1145,9 +1557,9 @@ func file_example_query_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_example_query_query_proto_rawDesc), len(file_example_query_query_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   20,
 			NumExtensions: 0,
-			NumServices:   9,
+			NumServices:   12,
 		},
 		GoTypes:           file_example_query_query_proto_goTypes,
 		DependencyIndexes: file_example_query_query_proto_depIdxs,
@@ -13,6 +13,9 @@ import (
 	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	http "net/http"
 	url "net/url"
@@ -22,10 +25,7 @@ type BoolQueryService interface {
 	BoolQuery(ctx context.Context, req *BoolQueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendBoolQueryHttpRoute(router *http.ServeMux, service BoolQueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendBoolQueryHttpRoute[R goose.Router](router R, service BoolQueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := boolQueryHandler{
 		service: service,
@@ -40,11 +40,16 @@ func AppendBoolQueryHttpRoute(router *http.ServeMux, service BoolQueryService, o
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/bool", http.HandlerFunc(handler.BoolQuery))
+	router.Handle("GET "+options.Prefix()+"/v1/bool", http.HandlerFunc(handler.BoolQuery))
 	return router
 }
 
+func NewBoolQueryHandler(service BoolQueryService, opts ...server.Option) http.Handler {
+	return AppendBoolQueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type boolQueryHandler struct {
 	service                 BoolQueryService
 	decoder                 boolQueryRequestDecoder
@@ -53,16 +58,18 @@ type boolQueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h boolQueryHandler) BoolQuery(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.BoolQuery(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -77,7 +84,7 @@ func (h boolQueryHandler) BoolQuery(response http.ResponseWriter, request *http.
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_BoolQuery_BoolQuery_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_BoolQuery_BoolQuery_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type boolQueryRequestDecoder struct {
@@ -93,7 +100,7 @@ func (decoder boolQueryRequestDecoder) BoolQuery(ctx context.Context, request *h
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Bool, queryErr = goose.GetForm[bool](queryErr, queries, "bool", goose.GetBool)
 	req.OptBool, queryErr = goose.GetForm[*bool](queryErr, queries, "opt_bool", goose.GetBoolPtr)
@@ -115,7 +122,7 @@ func (encoder boolQueryResponseEncoder) BoolQuery(ctx context.Context, w http.Re
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewBoolQueryHttpClient(target string, opts ...client.Option) BoolQueryService {
+func NewBoolQueryHttpClient(target string, opts ...client.Option) BoolQueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &boolQueryHttpClient{
 		client: options.Client(),
@@ -136,6 +143,12 @@ func NewBoolQueryHttpClient(target string, opts ...client.Option) BoolQueryServi
 	return client
 }
 
+type BoolQueryHttpClient interface {
+	BoolQuery(ctx context.Context, req *BoolQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ BoolQueryHttpClient = (*boolQueryHttpClient)(nil)
+
 type boolQueryHttpClient struct {
 	client                  *http.Client
 	encoder                 boolQueryRequestEncoder
@@ -145,15 +158,17 @@ type boolQueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *boolQueryHttpClient) BoolQuery(ctx context.Context, req *BoolQueryRequest) (*httpbody.HttpBody, error) {
+func (c *boolQueryHttpClient) BoolQuery(ctx context.Context, req *BoolQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.BoolQuery(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.BoolQuery(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_BoolQuery_BoolQuery_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_BoolQuery_BoolQuery_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -227,10 +242,7 @@ type Int32QueryService interface {
 	Int32Query(ctx context.Context, req *Int32QueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendInt32QueryHttpRoute(router *http.ServeMux, service Int32QueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendInt32QueryHttpRoute[R goose.Router](router R, service Int32QueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := int32QueryHandler{
 		service: service,
@@ -245,11 +257,16 @@ func AppendInt32QueryHttpRoute(router *http.ServeMux, service Int32QueryService,
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/int32", http.HandlerFunc(handler.Int32Query))
+	router.Handle("GET "+options.Prefix()+"/v1/int32", http.HandlerFunc(handler.Int32Query))
 	return router
 }
 
+func NewInt32QueryHandler(service Int32QueryService, opts ...server.Option) http.Handler {
+	return AppendInt32QueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type int32QueryHandler struct {
 	service                 Int32QueryService
 	decoder                 int32QueryRequestDecoder
@@ -258,16 +275,18 @@ type int32QueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h int32QueryHandler) Int32Query(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Int32Query(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -282,7 +301,7 @@ func (h int32QueryHandler) Int32Query(response http.ResponseWriter, request *htt
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_Int32Query_Int32Query_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_Int32Query_Int32Query_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type int32QueryRequestDecoder struct {
@@ -298,7 +317,7 @@ func (decoder int32QueryRequestDecoder) Int32Query(ctx context.Context, request
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Int32, queryErr = goose.GetForm[int32](queryErr, queries, "int32", goose.GetInt)
 	req.Sint32, queryErr = goose.GetForm[int32](queryErr, queries, "sint32", goose.GetInt)
@@ -326,7 +345,7 @@ func (encoder int32QueryResponseEncoder) Int32Query(ctx context.Context, w http.
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewInt32QueryHttpClient(target string, opts ...client.Option) Int32QueryService {
+func NewInt32QueryHttpClient(target string, opts ...client.Option) Int32QueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &int32QueryHttpClient{
 		client: options.Client(),
@@ -347,6 +366,12 @@ func NewInt32QueryHttpClient(target string, opts ...client.Option) Int32QuerySer
 	return client
 }
 
+type Int32QueryHttpClient interface {
+	Int32Query(ctx context.Context, req *Int32QueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ Int32QueryHttpClient = (*int32QueryHttpClient)(nil)
+
 type int32QueryHttpClient struct {
 	client                  *http.Client
 	encoder                 int32QueryRequestEncoder
@@ -356,15 +381,17 @@ type int32QueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *int32QueryHttpClient) Int32Query(ctx context.Context, req *Int32QueryRequest) (*httpbody.HttpBody, error) {
+func (c *int32QueryHttpClient) Int32Query(ctx context.Context, req *Int32QueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Int32Query(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Int32Query(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_Int32Query_Int32Query_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_Int32Query_Int32Query_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -444,10 +471,7 @@ type Int64QueryService interface {
 	Int64Query(ctx context.Context, req *Int64QueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendInt64QueryHttpRoute(router *http.ServeMux, service Int64QueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendInt64QueryHttpRoute[R goose.Router](router R, service Int64QueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := int64QueryHandler{
 		service: service,
@@ -462,11 +486,16 @@ func AppendInt64QueryHttpRoute(router *http.ServeMux, service Int64QueryService,
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/int64", http.HandlerFunc(handler.Int64Query))
+	router.Handle("GET "+options.Prefix()+"/v1/int64", http.HandlerFunc(handler.Int64Query))
 	return router
 }
 
+func NewInt64QueryHandler(service Int64QueryService, opts ...server.Option) http.Handler {
+	return AppendInt64QueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type int64QueryHandler struct {
 	service                 Int64QueryService
 	decoder                 int64QueryRequestDecoder
@@ -475,16 +504,18 @@ type int64QueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h int64QueryHandler) Int64Query(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Int64Query(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -499,7 +530,7 @@ func (h int64QueryHandler) Int64Query(response http.ResponseWriter, request *htt
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_Int64Query_Int64Query_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_Int64Query_Int64Query_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type int64QueryRequestDecoder struct {
@@ -515,7 +546,7 @@ func (decoder int64QueryRequestDecoder) Int64Query(ctx context.Context, request
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Int64, queryErr = goose.GetForm[int64](queryErr, queries, "int64", goose.GetInt)
 	req.Sint64, queryErr = goose.GetForm[int64](queryErr, queries, "sint64", goose.GetInt)
@@ -543,7 +574,7 @@ func (encoder int64QueryResponseEncoder) Int64Query(ctx context.Context, w http.
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewInt64QueryHttpClient(target string, opts ...client.Option) Int64QueryService {
+func NewInt64QueryHttpClient(target string, opts ...client.Option) Int64QueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &int64QueryHttpClient{
 		client: options.Client(),
@@ -564,6 +595,12 @@ func NewInt64QueryHttpClient(target string, opts ...client.Option) Int64QuerySer
 	return client
 }
 
+type Int64QueryHttpClient interface {
+	Int64Query(ctx context.Context, req *Int64QueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ Int64QueryHttpClient = (*int64QueryHttpClient)(nil)
+
 type int64QueryHttpClient struct {
 	client                  *http.Client
 	encoder                 int64QueryRequestEncoder
@@ -573,15 +610,17 @@ type int64QueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *int64QueryHttpClient) Int64Query(ctx context.Context, req *Int64QueryRequest) (*httpbody.HttpBody, error) {
+func (c *int64QueryHttpClient) Int64Query(ctx context.Context, req *Int64QueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Int64Query(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Int64Query(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_Int64Query_Int64Query_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_Int64Query_Int64Query_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -661,10 +700,7 @@ type Uint32QueryService interface {
 	Uint32Query(ctx context.Context, req *Uint32QueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendUint32QueryHttpRoute(router *http.ServeMux, service Uint32QueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendUint32QueryHttpRoute[R goose.Router](router R, service Uint32QueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := uint32QueryHandler{
 		service: service,
@@ -679,11 +715,16 @@ func AppendUint32QueryHttpRoute(router *http.ServeMux, service Uint32QueryServic
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/uint32", http.HandlerFunc(handler.Uint32Query))
+	router.Handle("GET "+options.Prefix()+"/v1/uint32", http.HandlerFunc(handler.Uint32Query))
 	return router
 }
 
+func NewUint32QueryHandler(service Uint32QueryService, opts ...server.Option) http.Handler {
+	return AppendUint32QueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type uint32QueryHandler struct {
 	service                 Uint32QueryService
 	decoder                 uint32QueryRequestDecoder
@@ -692,16 +733,18 @@ type uint32QueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h uint32QueryHandler) Uint32Query(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Uint32Query(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -716,7 +759,7 @@ func (h uint32QueryHandler) Uint32Query(response http.ResponseWriter, request *h
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_Uint32Query_Uint32Query_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_Uint32Query_Uint32Query_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type uint32QueryRequestDecoder struct {
@@ -732,7 +775,7 @@ func (decoder uint32QueryRequestDecoder) Uint32Query(ctx context.Context, reques
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Uint32, queryErr = goose.GetForm[uint32](queryErr, queries, "uint32", goose.GetUint)
 	req.Fixed32, queryErr = goose.GetForm[uint32](queryErr, queries, "fixed32", goose.GetUint)
@@ -757,7 +800,7 @@ func (encoder uint32QueryResponseEncoder) Uint32Query(ctx context.Context, w htt
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewUint32QueryHttpClient(target string, opts ...client.Option) Uint32QueryService {
+func NewUint32QueryHttpClient(target string, opts ...client.Option) Uint32QueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &uint32QueryHttpClient{
 		client: options.Client(),
@@ -778,6 +821,12 @@ func NewUint32QueryHttpClient(target string, opts ...client.Option) Uint32QueryS
 	return client
 }
 
+type Uint32QueryHttpClient interface {
+	Uint32Query(ctx context.Context, req *Uint32QueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ Uint32QueryHttpClient = (*uint32QueryHttpClient)(nil)
+
 type uint32QueryHttpClient struct {
 	client                  *http.Client
 	encoder                 uint32QueryRequestEncoder
@@ -787,15 +836,17 @@ type uint32QueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *uint32QueryHttpClient) Uint32Query(ctx context.Context, req *Uint32QueryRequest) (*httpbody.HttpBody, error) {
+func (c *uint32QueryHttpClient) Uint32Query(ctx context.Context, req *Uint32QueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Uint32Query(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Uint32Query(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_Uint32Query_Uint32Query_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_Uint32Query_Uint32Query_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -872,10 +923,7 @@ type Uint64QueryService interface {
 	Uint64Query(ctx context.Context, req *Uint64QueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendUint64QueryHttpRoute(router *http.ServeMux, service Uint64QueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendUint64QueryHttpRoute[R goose.Router](router R, service Uint64QueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := uint64QueryHandler{
 		service: service,
@@ -890,11 +938,16 @@ func AppendUint64QueryHttpRoute(router *http.ServeMux, service Uint64QueryServic
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/uint64", http.HandlerFunc(handler.Uint64Query))
+	router.Handle("GET "+options.Prefix()+"/v1/uint64", http.HandlerFunc(handler.Uint64Query))
 	return router
 }
 
+func NewUint64QueryHandler(service Uint64QueryService, opts ...server.Option) http.Handler {
+	return AppendUint64QueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type uint64QueryHandler struct {
 	service                 Uint64QueryService
 	decoder                 uint64QueryRequestDecoder
@@ -903,16 +956,18 @@ type uint64QueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h uint64QueryHandler) Uint64Query(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Uint64Query(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -927,7 +982,7 @@ func (h uint64QueryHandler) Uint64Query(response http.ResponseWriter, request *h
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_Uint64Query_Uint64Query_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_Uint64Query_Uint64Query_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type uint64QueryRequestDecoder struct {
@@ -943,7 +998,7 @@ func (decoder uint64QueryRequestDecoder) Uint64Query(ctx context.Context, reques
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Uint64, queryErr = goose.GetForm[uint64](queryErr, queries, "uint64", goose.GetUint)
 	req.Fixed64, queryErr = goose.GetForm[uint64](queryErr, queries, "fixed64", goose.GetUint)
@@ -968,7 +1023,7 @@ func (encoder uint64QueryResponseEncoder) Uint64Query(ctx context.Context, w htt
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewUint64QueryHttpClient(target string, opts ...client.Option) Uint64QueryService {
+func NewUint64QueryHttpClient(target string, opts ...client.Option) Uint64QueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &uint64QueryHttpClient{
 		client: options.Client(),
@@ -989,6 +1044,12 @@ func NewUint64QueryHttpClient(target string, opts ...client.Option) Uint64QueryS
 	return client
 }
 
+type Uint64QueryHttpClient interface {
+	Uint64Query(ctx context.Context, req *Uint64QueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ Uint64QueryHttpClient = (*uint64QueryHttpClient)(nil)
+
 type uint64QueryHttpClient struct {
 	client                  *http.Client
 	encoder                 uint64QueryRequestEncoder
@@ -998,15 +1059,17 @@ type uint64QueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *uint64QueryHttpClient) Uint64Query(ctx context.Context, req *Uint64QueryRequest) (*httpbody.HttpBody, error) {
+func (c *uint64QueryHttpClient) Uint64Query(ctx context.Context, req *Uint64QueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Uint64Query(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Uint64Query(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_Uint64Query_Uint64Query_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_Uint64Query_Uint64Query_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1083,10 +1146,7 @@ type FloatQueryService interface {
 	FloatQuery(ctx context.Context, req *FloatQueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendFloatQueryHttpRoute(router *http.ServeMux, service FloatQueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendFloatQueryHttpRoute[R goose.Router](router R, service FloatQueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := floatQueryHandler{
 		service: service,
@@ -1101,11 +1161,16 @@ func AppendFloatQueryHttpRoute(router *http.ServeMux, service FloatQueryService,
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/float", http.HandlerFunc(handler.FloatQuery))
+	router.Handle("GET "+options.Prefix()+"/v1/float", http.HandlerFunc(handler.FloatQuery))
 	return router
 }
 
+func NewFloatQueryHandler(service FloatQueryService, opts ...server.Option) http.Handler {
+	return AppendFloatQueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type floatQueryHandler struct {
 	service                 FloatQueryService
 	decoder                 floatQueryRequestDecoder
@@ -1114,16 +1179,18 @@ type floatQueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h floatQueryHandler) FloatQuery(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.FloatQuery(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -1138,7 +1205,7 @@ func (h floatQueryHandler) FloatQuery(response http.ResponseWriter, request *htt
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_FloatQuery_FloatQuery_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_FloatQuery_FloatQuery_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type floatQueryRequestDecoder struct {
@@ -1154,7 +1221,7 @@ func (decoder floatQueryRequestDecoder) FloatQuery(ctx context.Context, request
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Float, queryErr = goose.GetForm[float32](queryErr, queries, "float", goose.GetFloat)
 	req.OptFloat, queryErr = goose.GetForm[*float32](queryErr, queries, "opt_float", goose.GetFloatPtr)
@@ -1176,7 +1243,7 @@ func (encoder floatQueryResponseEncoder) FloatQuery(ctx context.Context, w http.
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewFloatQueryHttpClient(target string, opts ...client.Option) FloatQueryService {
+func NewFloatQueryHttpClient(target string, opts ...client.Option) FloatQueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &floatQueryHttpClient{
 		client: options.Client(),
@@ -1197,6 +1264,12 @@ func NewFloatQueryHttpClient(target string, opts ...client.Option) FloatQuerySer
 	return client
 }
 
+type FloatQueryHttpClient interface {
+	FloatQuery(ctx context.Context, req *FloatQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ FloatQueryHttpClient = (*floatQueryHttpClient)(nil)
+
 type floatQueryHttpClient struct {
 	client                  *http.Client
 	encoder                 floatQueryRequestEncoder
@@ -1206,15 +1279,17 @@ type floatQueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *floatQueryHttpClient) FloatQuery(ctx context.Context, req *FloatQueryRequest) (*httpbody.HttpBody, error) {
+func (c *floatQueryHttpClient) FloatQuery(ctx context.Context, req *FloatQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.FloatQuery(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.FloatQuery(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_FloatQuery_FloatQuery_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_FloatQuery_FloatQuery_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1288,10 +1363,7 @@ type DoubleQueryService interface {
 	DoubleQuery(ctx context.Context, req *DoubleQueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendDoubleQueryHttpRoute(router *http.ServeMux, service DoubleQueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendDoubleQueryHttpRoute[R goose.Router](router R, service DoubleQueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := doubleQueryHandler{
 		service: service,
@@ -1306,11 +1378,16 @@ func AppendDoubleQueryHttpRoute(router *http.ServeMux, service DoubleQueryServic
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/double", http.HandlerFunc(handler.DoubleQuery))
+	router.Handle("GET "+options.Prefix()+"/v1/double", http.HandlerFunc(handler.DoubleQuery))
 	return router
 }
 
+func NewDoubleQueryHandler(service DoubleQueryService, opts ...server.Option) http.Handler {
+	return AppendDoubleQueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type doubleQueryHandler struct {
 	service                 DoubleQueryService
 	decoder                 doubleQueryRequestDecoder
@@ -1319,16 +1396,18 @@ type doubleQueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h doubleQueryHandler) DoubleQuery(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.DoubleQuery(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -1343,7 +1422,7 @@ func (h doubleQueryHandler) DoubleQuery(response http.ResponseWriter, request *h
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_DoubleQuery_DoubleQuery_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_DoubleQuery_DoubleQuery_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type doubleQueryRequestDecoder struct {
@@ -1359,7 +1438,7 @@ func (decoder doubleQueryRequestDecoder) DoubleQuery(ctx context.Context, reques
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Double, queryErr = goose.GetForm[float64](queryErr, queries, "double", goose.GetFloat)
 	req.OptDouble, queryErr = goose.GetForm[*float64](queryErr, queries, "opt_double", goose.GetFloatPtr)
@@ -1381,7 +1460,7 @@ func (encoder doubleQueryResponseEncoder) DoubleQuery(ctx context.Context, w htt
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewDoubleQueryHttpClient(target string, opts ...client.Option) DoubleQueryService {
+func NewDoubleQueryHttpClient(target string, opts ...client.Option) DoubleQueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &doubleQueryHttpClient{
 		client: options.Client(),
@@ -1402,6 +1481,12 @@ func NewDoubleQueryHttpClient(target string, opts ...client.Option) DoubleQueryS
 	return client
 }
 
+type DoubleQueryHttpClient interface {
+	DoubleQuery(ctx context.Context, req *DoubleQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ DoubleQueryHttpClient = (*doubleQueryHttpClient)(nil)
+
 type doubleQueryHttpClient struct {
 	client                  *http.Client
 	encoder                 doubleQueryRequestEncoder
@@ -1411,15 +1496,17 @@ type doubleQueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *doubleQueryHttpClient) DoubleQuery(ctx context.Context, req *DoubleQueryRequest) (*httpbody.HttpBody, error) {
+func (c *doubleQueryHttpClient) DoubleQuery(ctx context.Context, req *DoubleQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.DoubleQuery(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.DoubleQuery(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_DoubleQuery_DoubleQuery_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_DoubleQuery_DoubleQuery_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1493,10 +1580,7 @@ type StringQueryService interface {
 	StringQuery(ctx context.Context, req *StringQueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendStringQueryHttpRoute(router *http.ServeMux, service StringQueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendStringQueryHttpRoute[R goose.Router](router R, service StringQueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := stringQueryHandler{
 		service: service,
@@ -1511,11 +1595,16 @@ func AppendStringQueryHttpRoute(router *http.ServeMux, service StringQueryServic
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/string", http.HandlerFunc(handler.StringQuery))
+	router.Handle("GET "+options.Prefix()+"/v1/string", http.HandlerFunc(handler.StringQuery))
 	return router
 }
 
+func NewStringQueryHandler(service StringQueryService, opts ...server.Option) http.Handler {
+	return AppendStringQueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type stringQueryHandler struct {
 	service                 StringQueryService
 	decoder                 stringQueryRequestDecoder
@@ -1524,16 +1613,18 @@ type stringQueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h stringQueryHandler) StringQuery(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.StringQuery(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -1548,7 +1639,7 @@ func (h stringQueryHandler) StringQuery(response http.ResponseWriter, request *h
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_StringQuery_StringQuery_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_StringQuery_StringQuery_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type stringQueryRequestDecoder struct {
@@ -1564,7 +1655,7 @@ func (decoder stringQueryRequestDecoder) StringQuery(ctx context.Context, reques
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.String_ = queries.Get("string")
 	req.OptString = proto.String(queries.Get("opt_string"))
@@ -1586,7 +1677,7 @@ func (encoder stringQueryResponseEncoder) StringQuery(ctx context.Context, w htt
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewStringQueryHttpClient(target string, opts ...client.Option) StringQueryService {
+func NewStringQueryHttpClient(target string, opts ...client.Option) StringQueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &stringQueryHttpClient{
 		client: options.Client(),
@@ -1607,6 +1698,12 @@ func NewStringQueryHttpClient(target string, opts ...client.Option) StringQueryS
 	return client
 }
 
+type StringQueryHttpClient interface {
+	StringQuery(ctx context.Context, req *StringQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ StringQueryHttpClient = (*stringQueryHttpClient)(nil)
+
 type stringQueryHttpClient struct {
 	client                  *http.Client
 	encoder                 stringQueryRequestEncoder
@@ -1616,15 +1713,17 @@ type stringQueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *stringQueryHttpClient) StringQuery(ctx context.Context, req *StringQueryRequest) (*httpbody.HttpBody, error) {
+func (c *stringQueryHttpClient) StringQuery(ctx context.Context, req *StringQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.StringQuery(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.StringQuery(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_StringQuery_StringQuery_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_StringQuery_StringQuery_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1698,10 +1797,7 @@ type EnumQueryService interface {
 	EnumQuery(ctx context.Context, req *EnumQueryRequest) (*httpbody.HttpBody, error)
 }
 
-func AppendEnumQueryHttpRoute(router *http.ServeMux, service EnumQueryService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendEnumQueryHttpRoute[R goose.Router](router R, service EnumQueryService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := enumQueryHandler{
 		service: service,
@@ -1716,11 +1812,16 @@ func AppendEnumQueryHttpRoute(router *http.ServeMux, service EnumQueryService, o
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("GET /v1/enum", http.HandlerFunc(handler.EnumQuery))
+	router.Handle("GET "+options.Prefix()+"/v1/enum", http.HandlerFunc(handler.EnumQuery))
 	return router
 }
 
+func NewEnumQueryHandler(service EnumQueryService, opts ...server.Option) http.Handler {
+	return AppendEnumQueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type enumQueryHandler struct {
 	service                 EnumQueryService
 	decoder                 enumQueryRequestDecoder
@@ -1729,16 +1830,18 @@ type enumQueryHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h enumQueryHandler) EnumQuery(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.EnumQuery(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -1753,7 +1856,7 @@ func (h enumQueryHandler) EnumQuery(response http.ResponseWriter, request *http.
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_EnumQuery_EnumQuery_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_EnumQuery_EnumQuery_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type enumQueryRequestDecoder struct {
@@ -1769,7 +1872,7 @@ func (decoder enumQueryRequestDecoder) EnumQuery(ctx context.Context, request *h
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Status, queryErr = goose.GetForm[EnumQueryRequest_Status](queryErr, queries, "status", goose.GetInt[EnumQueryRequest_Status])
 	req.OptStatus, queryErr = goose.GetForm[*EnumQueryRequest_Status](queryErr, queries, "opt_status", goose.GetIntPtr[EnumQueryRequest_Status])
@@ -1789,7 +1892,7 @@ func (encoder enumQueryResponseEncoder) EnumQuery(ctx context.Context, w http.Re
 	return server.EncodeHttpBody(ctx, w, resp)
 }
 
-func NewEnumQueryHttpClient(target string, opts ...client.Option) EnumQueryService {
+func NewEnumQueryHttpClient(target string, opts ...client.Option) EnumQueryHttpClient {
 	options := client.NewOptions(opts...)
 	client := &enumQueryHttpClient{
 		client: options.Client(),
@@ -1810,6 +1913,12 @@ func NewEnumQueryHttpClient(target string, opts ...client.Option) EnumQueryServi
 	return client
 }
 
+type EnumQueryHttpClient interface {
+	EnumQuery(ctx context.Context, req *EnumQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ EnumQueryHttpClient = (*enumQueryHttpClient)(nil)
+
 type enumQueryHttpClient struct {
 	client                  *http.Client
 	encoder                 enumQueryRequestEncoder
@@ -1819,15 +1928,17 @@ type enumQueryHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *enumQueryHttpClient) EnumQuery(ctx context.Context, req *EnumQueryRequest) (*httpbody.HttpBody, error) {
+func (c *enumQueryHttpClient) EnumQuery(ctx context.Context, req *EnumQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.EnumQuery(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.EnumQuery(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_EnumQuery_EnumQuery_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_EnumQuery_EnumQuery_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1894,3 +2005,715 @@ var _leo_goose_example_query_v1_EnumQuery_EnumQuery_Desc = &goose.Desc{
 		FullMethod: "/leo.goose.example.query.v1.EnumQuery/EnumQuery",
 	},
 }
+
+type NestedQueryService interface {
+	NestedQuery(ctx context.Context, req *NestedQueryRequest) (*httpbody.HttpBody, error)
+}
+
+func AppendNestedQueryHttpRoute[R goose.Router](router R, service NestedQueryService, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	handler := nestedQueryHandler{
+		service: service,
+		decoder: nestedQueryRequestDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		encoder: nestedQueryResponseEncoder{
+			marshalOptions:   options.MarshalOptions(),
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		errorEncoder:            options.ErrorEncoder(),
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("GET "+options.Prefix()+"/v1/nested", http.HandlerFunc(handler.NestedQuery))
+	return router
+}
+
+func NewNestedQueryHandler(service NestedQueryService, opts ...server.Option) http.Handler {
+	return AppendNestedQueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
+type nestedQueryHandler struct {
+	service                 NestedQueryService
+	decoder                 nestedQueryRequestDecoder
+	encoder                 nestedQueryResponseEncoder
+	errorEncoder            goose.ErrorEncoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              server.Middleware
+	prefix                  string
+}
+
+func (h nestedQueryHandler) NestedQuery(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.NestedQuery(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.NestedQuery(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.NestedQuery(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_NestedQuery_NestedQuery_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+type nestedQueryRequestDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (decoder nestedQueryRequestDecoder) NestedQuery(ctx context.Context, request *http.Request) (*NestedQueryRequest, error) {
+	req := &NestedQueryRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	queries := goose.FormFromQuery(request)
+	var queryErr error
+	if queries.Has("filter.status") {
+		if req.Filter == nil {
+			req.Filter = &NestedQueryRequest_Filter{}
+		}
+		req.Filter.Status, queryErr = goose.GetForm[EnumQueryRequest_Status](queryErr, queries, "filter.status", goose.GetInt[EnumQueryRequest_Status])
+	}
+	if queries.Has("filter.owner.id") {
+		if req.Filter == nil {
+			req.Filter = &NestedQueryRequest_Filter{}
+		}
+		if req.Filter.Owner == nil {
+			req.Filter.Owner = &NestedQueryRequest_Owner{}
+		}
+		req.Filter.Owner.Id, queryErr = goose.GetForm[int64](queryErr, queries, "filter.owner.id", goose.GetInt)
+	}
+	if queries.Has("filter.owner.name") {
+		if req.Filter == nil {
+			req.Filter = &NestedQueryRequest_Filter{}
+		}
+		if req.Filter.Owner == nil {
+			req.Filter.Owner = &NestedQueryRequest_Owner{}
+		}
+		req.Filter.Owner.Name = queries.Get("filter.owner.name")
+	}
+	if queries.Has("filter.tags") {
+		if req.Filter == nil {
+			req.Filter = &NestedQueryRequest_Filter{}
+		}
+		req.Filter.Tags = queries["filter.tags"]
+	}
+	if goose.HasMapForm(queries, "filter.labels") {
+		if req.Filter == nil {
+			req.Filter = &NestedQueryRequest_Filter{}
+		}
+		req.Filter.Labels, queryErr = goose.GetForm[map[string]string](queryErr, queries, "filter.labels", goose.GetStringMap)
+	}
+	req.PageSize, queryErr = goose.GetForm[int32](queryErr, queries, "page_size", goose.GetInt)
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	return req, nil
+}
+
+type nestedQueryResponseEncoder struct {
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (encoder nestedQueryResponseEncoder) NestedQuery(ctx context.Context, w http.ResponseWriter, resp *httpbody.HttpBody) error {
+	return server.EncodeHttpBody(ctx, w, resp)
+}
+
+func NewNestedQueryHttpClient(target string, opts ...client.Option) NestedQueryHttpClient {
+	options := client.NewOptions(opts...)
+	client := &nestedQueryHttpClient{
+		client: options.Client(),
+		encoder: nestedQueryRequestEncoder{
+			target:         target,
+			marshalOptions: options.MarshalOptions(),
+			resolver:       options.Resolver(),
+		},
+		decoder: nestedQueryResponseDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+			errorDecoder:     options.ErrorDecoder(),
+			errorFactory:     options.ErrorFactory(),
+		},
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              client.Chain(options.Middlewares()...),
+	}
+	return client
+}
+
+type NestedQueryHttpClient interface {
+	NestedQuery(ctx context.Context, req *NestedQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ NestedQueryHttpClient = (*nestedQueryHttpClient)(nil)
+
+type nestedQueryHttpClient struct {
+	client                  *http.Client
+	encoder                 nestedQueryRequestEncoder
+	decoder                 nestedQueryResponseDecoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              client.Middleware
+}
+
+func (c *nestedQueryHttpClient) NestedQuery(ctx context.Context, req *NestedQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.NestedQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_NestedQuery_NestedQuery_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.NestedQuery(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type nestedQueryRequestEncoder struct {
+	target         string
+	marshalOptions protojson.MarshalOptions
+	resolver       resolver.Resolver
+}
+
+func (encoder *nestedQueryRequestEncoder) NestedQuery(ctx context.Context, req *NestedQueryRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/nested"
+	target.Path = path
+	queries := url.Values{}
+	if req.GetFilter() != nil {
+		queries["filter.status"] = append(queries["filter.status"], goose.FormatInt(req.GetFilter().GetStatus(), 10))
+	}
+	if req.GetFilter().GetOwner() != nil {
+		queries["filter.owner.id"] = append(queries["filter.owner.id"], goose.FormatInt(req.GetFilter().GetOwner().GetId(), 10))
+	}
+	if req.GetFilter().GetOwner() != nil {
+		queries["filter.owner.name"] = append(queries["filter.owner.name"], req.GetFilter().GetOwner().GetName())
+	}
+	if req.GetFilter() != nil {
+		queries["filter.tags"] = append(queries["filter.tags"], req.GetFilter().GetTags()...)
+	}
+	if req.GetFilter() != nil {
+		for k, v := range req.GetFilter().GetLabels() {
+			queries.Add(goose.MapFormKey("filter.labels", goose.FormatMapKey(k)), v)
+		}
+	}
+	queries["page_size"] = append(queries["page_size"], goose.FormatInt(req.GetPageSize(), 10))
+	target.RawQuery = queries.Encode()
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+type nestedQueryResponseDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+	errorDecoder     goose.ErrorDecoder
+	errorFactory     goose.ErrorFactory
+}
+
+func (decoder *nestedQueryResponseDecoder) NestedQuery(ctx context.Context, response *http.Response) (*httpbody.HttpBody, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &httpbody.HttpBody{}
+	if err := client.DecodeHttpBody(ctx, response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var _leo_goose_example_query_v1_NestedQuery_NestedQuery_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/nested",
+		FullMethod: "/leo.goose.example.query.v1.NestedQuery/NestedQuery",
+	},
+}
+
+type TimeQueryService interface {
+	TimeQuery(ctx context.Context, req *TimeQueryRequest) (*httpbody.HttpBody, error)
+}
+
+func AppendTimeQueryHttpRoute[R goose.Router](router R, service TimeQueryService, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	handler := timeQueryHandler{
+		service: service,
+		decoder: timeQueryRequestDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		encoder: timeQueryResponseEncoder{
+			marshalOptions:   options.MarshalOptions(),
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		errorEncoder:            options.ErrorEncoder(),
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("GET "+options.Prefix()+"/v1/time", http.HandlerFunc(handler.TimeQuery))
+	return router
+}
+
+func NewTimeQueryHandler(service TimeQueryService, opts ...server.Option) http.Handler {
+	return AppendTimeQueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
+type timeQueryHandler struct {
+	service                 TimeQueryService
+	decoder                 timeQueryRequestDecoder
+	encoder                 timeQueryResponseEncoder
+	errorEncoder            goose.ErrorEncoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              server.Middleware
+	prefix                  string
+}
+
+func (h timeQueryHandler) TimeQuery(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.TimeQuery(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.TimeQuery(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.TimeQuery(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_TimeQuery_TimeQuery_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+type timeQueryRequestDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (decoder timeQueryRequestDecoder) TimeQuery(ctx context.Context, request *http.Request) (*TimeQueryRequest, error) {
+	req := &TimeQueryRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	queries := goose.FormFromQuery(request)
+	var queryErr error
+	req.CreatedAfter, queryErr = goose.GetForm[*timestamppb.Timestamp](queryErr, queries, "created_after", goose.GetTimestamp)
+	req.Ttl, queryErr = goose.GetForm[*durationpb.Duration](queryErr, queries, "ttl", goose.GetDuration)
+	req.ReadMask, queryErr = goose.GetForm[*fieldmaskpb.FieldMask](queryErr, queries, "read_mask", goose.GetFieldMask)
+	req.ListTimestamp, queryErr = goose.GetForm[[]*timestamppb.Timestamp](queryErr, queries, "list_timestamp", goose.GetTimestampSlice)
+	req.ListDuration, queryErr = goose.GetForm[[]*durationpb.Duration](queryErr, queries, "list_duration", goose.GetDurationSlice)
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	return req, nil
+}
+
+type timeQueryResponseEncoder struct {
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (encoder timeQueryResponseEncoder) TimeQuery(ctx context.Context, w http.ResponseWriter, resp *httpbody.HttpBody) error {
+	return server.EncodeHttpBody(ctx, w, resp)
+}
+
+func NewTimeQueryHttpClient(target string, opts ...client.Option) TimeQueryHttpClient {
+	options := client.NewOptions(opts...)
+	client := &timeQueryHttpClient{
+		client: options.Client(),
+		encoder: timeQueryRequestEncoder{
+			target:         target,
+			marshalOptions: options.MarshalOptions(),
+			resolver:       options.Resolver(),
+		},
+		decoder: timeQueryResponseDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+			errorDecoder:     options.ErrorDecoder(),
+			errorFactory:     options.ErrorFactory(),
+		},
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              client.Chain(options.Middlewares()...),
+	}
+	return client
+}
+
+type TimeQueryHttpClient interface {
+	TimeQuery(ctx context.Context, req *TimeQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ TimeQueryHttpClient = (*timeQueryHttpClient)(nil)
+
+type timeQueryHttpClient struct {
+	client                  *http.Client
+	encoder                 timeQueryRequestEncoder
+	decoder                 timeQueryResponseDecoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              client.Middleware
+}
+
+func (c *timeQueryHttpClient) TimeQuery(ctx context.Context, req *TimeQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.TimeQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_TimeQuery_TimeQuery_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.TimeQuery(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type timeQueryRequestEncoder struct {
+	target         string
+	marshalOptions protojson.MarshalOptions
+	resolver       resolver.Resolver
+}
+
+func (encoder *timeQueryRequestEncoder) TimeQuery(ctx context.Context, req *TimeQueryRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/time"
+	target.Path = path
+	queries := url.Values{}
+	if req.GetCreatedAfter() != nil {
+		queries["created_after"] = append(queries["created_after"], goose.FormatTimestamp(req.GetCreatedAfter()))
+	}
+	if req.GetTtl() != nil {
+		queries["ttl"] = append(queries["ttl"], goose.FormatDuration(req.GetTtl()))
+	}
+	if req.GetReadMask() != nil {
+		queries["read_mask"] = append(queries["read_mask"], goose.FormatFieldMask(req.GetReadMask()))
+	}
+	queries["list_timestamp"] = append(queries["list_timestamp"], goose.FormatTimestampSlice(req.GetListTimestamp())...)
+	queries["list_duration"] = append(queries["list_duration"], goose.FormatDurationSlice(req.GetListDuration())...)
+	target.RawQuery = queries.Encode()
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+type timeQueryResponseDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+	errorDecoder     goose.ErrorDecoder
+	errorFactory     goose.ErrorFactory
+}
+
+func (decoder *timeQueryResponseDecoder) TimeQuery(ctx context.Context, response *http.Response) (*httpbody.HttpBody, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &httpbody.HttpBody{}
+	if err := client.DecodeHttpBody(ctx, response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var _leo_goose_example_query_v1_TimeQuery_TimeQuery_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/time",
+		FullMethod: "/leo.goose.example.query.v1.TimeQuery/TimeQuery",
+	},
+}
+
+type MapQueryService interface {
+	MapQuery(ctx context.Context, req *MapQueryRequest) (*httpbody.HttpBody, error)
+}
+
+func AppendMapQueryHttpRoute[R goose.Router](router R, service MapQueryService, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	handler := mapQueryHandler{
+		service: service,
+		decoder: mapQueryRequestDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		encoder: mapQueryResponseEncoder{
+			marshalOptions:   options.MarshalOptions(),
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		errorEncoder:            options.ErrorEncoder(),
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("GET "+options.Prefix()+"/v1/map", http.HandlerFunc(handler.MapQuery))
+	return router
+}
+
+func NewMapQueryHandler(service MapQueryService, opts ...server.Option) http.Handler {
+	return AppendMapQueryHttpRoute(http.NewServeMux(), service, opts...)
+}
+
+type mapQueryHandler struct {
+	service                 MapQueryService
+	decoder                 mapQueryRequestDecoder
+	encoder                 mapQueryResponseEncoder
+	errorEncoder            goose.ErrorEncoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              server.Middleware
+	prefix                  string
+}
+
+func (h mapQueryHandler) MapQuery(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.MapQuery(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.MapQuery(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.MapQuery(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_query_v1_MapQuery_MapQuery_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+type mapQueryRequestDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (decoder mapQueryRequestDecoder) MapQuery(ctx context.Context, request *http.Request) (*MapQueryRequest, error) {
+	req := &MapQueryRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	queries := goose.FormFromQuery(request)
+	var queryErr error
+	req.Labels, queryErr = goose.GetForm[map[string]string](queryErr, queries, "labels", goose.GetStringMap)
+	req.Counts, queryErr = goose.GetForm[map[int32]int64](queryErr, queries, "counts", goose.GetIntMap)
+	req.Flags, queryErr = goose.GetForm[map[uint64]bool](queryErr, queries, "flags", goose.GetBoolMap)
+	req.Weights, queryErr = goose.GetForm[map[string]float64](queryErr, queries, "weights", goose.GetFloatMap)
+	req.States, queryErr = goose.GetForm[map[string]EnumQueryRequest_Status](queryErr, queries, "states", goose.GetIntMap)
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	return req, nil
+}
+
+type mapQueryResponseEncoder struct {
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (encoder mapQueryResponseEncoder) MapQuery(ctx context.Context, w http.ResponseWriter, resp *httpbody.HttpBody) error {
+	return server.EncodeHttpBody(ctx, w, resp)
+}
+
+func NewMapQueryHttpClient(target string, opts ...client.Option) MapQueryHttpClient {
+	options := client.NewOptions(opts...)
+	client := &mapQueryHttpClient{
+		client: options.Client(),
+		encoder: mapQueryRequestEncoder{
+			target:         target,
+			marshalOptions: options.MarshalOptions(),
+			resolver:       options.Resolver(),
+		},
+		decoder: mapQueryResponseDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+			errorDecoder:     options.ErrorDecoder(),
+			errorFactory:     options.ErrorFactory(),
+		},
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              client.Chain(options.Middlewares()...),
+	}
+	return client
+}
+
+type MapQueryHttpClient interface {
+	MapQuery(ctx context.Context, req *MapQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error)
+}
+
+var _ MapQueryHttpClient = (*mapQueryHttpClient)(nil)
+
+type mapQueryHttpClient struct {
+	client                  *http.Client
+	encoder                 mapQueryRequestEncoder
+	decoder                 mapQueryResponseDecoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              client.Middleware
+}
+
+func (c *mapQueryHttpClient) MapQuery(ctx context.Context, req *MapQueryRequest, opts ...client.CallOption) (*httpbody.HttpBody, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.MapQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_query_v1_MapQuery_MapQuery_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.MapQuery(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type mapQueryRequestEncoder struct {
+	target         string
+	marshalOptions protojson.MarshalOptions
+	resolver       resolver.Resolver
+}
+
+func (encoder *mapQueryRequestEncoder) MapQuery(ctx context.Context, req *MapQueryRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/map"
+	target.Path = path
+	queries := url.Values{}
+	for k, v := range req.GetLabels() {
+		queries.Add(goose.MapFormKey("labels", goose.FormatMapKey(k)), v)
+	}
+	for k, v := range req.GetCounts() {
+		queries.Add(goose.MapFormKey("counts", goose.FormatMapKey(k)), goose.FormatInt(v, 10))
+	}
+	for k, v := range req.GetFlags() {
+		queries.Add(goose.MapFormKey("flags", goose.FormatMapKey(k)), goose.FormatBool(v))
+	}
+	for k, v := range req.GetWeights() {
+		queries.Add(goose.MapFormKey("weights", goose.FormatMapKey(k)), goose.FormatFloat(v, 'f', -1, 64))
+	}
+	for k, v := range req.GetStates() {
+		queries.Add(goose.MapFormKey("states", goose.FormatMapKey(k)), goose.FormatInt(v, 10))
+	}
+	target.RawQuery = queries.Encode()
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+type mapQueryResponseDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+	errorDecoder     goose.ErrorDecoder
+	errorFactory     goose.ErrorFactory
+}
+
+func (decoder *mapQueryResponseDecoder) MapQuery(ctx context.Context, response *http.Response) (*httpbody.HttpBody, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &httpbody.HttpBody{}
+	if err := client.DecodeHttpBody(ctx, response, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var _leo_goose_example_query_v1_MapQuery_MapQuery_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/map",
+		FullMethod: "/leo.goose.example.query.v1.MapQuery/MapQuery",
+	},
+}
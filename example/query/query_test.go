@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	errors "errors"
+	"io"
 	"net/http"
 	"strings"
 	"testing"
@@ -11,6 +12,9 @@ import (
 	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -106,6 +110,36 @@ func (m *MockEnumQueryService) EnumQuery(ctx context.Context, req *EnumQueryRequ
 	return &httpbody.HttpBody{Data: data}, nil
 }
 
+type MockNestedQueryService struct{}
+
+func (m *MockNestedQueryService) NestedQuery(ctx context.Context, req *NestedQueryRequest) (*httpbody.HttpBody, error) {
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return &httpbody.HttpBody{Data: data}, nil
+}
+
+type MockTimeQueryService struct{}
+
+func (m *MockTimeQueryService) TimeQuery(ctx context.Context, req *TimeQueryRequest) (*httpbody.HttpBody, error) {
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return &httpbody.HttpBody{Data: data}, nil
+}
+
+type MockMapQueryService struct{}
+
+func (m *MockMapQueryService) MapQuery(ctx context.Context, req *MapQueryRequest) (*httpbody.HttpBody, error) {
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return &httpbody.HttpBody{Data: data}, nil
+}
+
 // ---- Test Cases ----
 
 func TestBoolPath(t *testing.T) {
@@ -394,3 +428,191 @@ func TestEnumPath(t *testing.T) {
 		t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
 	}
 }
+
+func TestNestedQuery(t *testing.T) {
+	server := &http.Server{}
+	go func() {
+		router := http.NewServeMux()
+		router = AppendNestedQueryHttpRoute(router, &MockNestedQueryService{})
+		server.Addr = ":28091"
+		server.Handler = router
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+	defer server.Close()
+	time.Sleep(time.Second)
+	cli := NewNestedQueryHttpClient("http://localhost:28091")
+	resp, err := cli.NestedQuery(context.Background(), &NestedQueryRequest{
+		Filter: &NestedQueryRequest_Filter{
+			Status: EnumQueryRequest_OK,
+			Owner:  &NestedQueryRequest_Owner{Id: 3, Name: "bob"},
+			Tags:   []string{"a", "b"},
+			Labels: map[string]string{"env": "prod"},
+		},
+		PageSize: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"filter":{"status":"OK","owner":{"id":"3","name":"bob"},"tags":["a","b"],"labels":{"env":"prod"}},"pageSize":10}`
+	if strings.ReplaceAll(string(resp.GetData()), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
+	}
+
+	// absent nested messages stay nil
+	resp, err = cli.NestedQuery(context.Background(), &NestedQueryRequest{PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `{"pageSize":10}`
+	if strings.ReplaceAll(string(resp.GetData()), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
+	}
+
+	// a nested map alone allocates its message
+	resp, err = cli.NestedQuery(context.Background(), &NestedQueryRequest{
+		Filter: &NestedQueryRequest_Filter{Labels: map[string]string{"env": "prod"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `{"filter":{"labels":{"env":"prod"}}}`
+	if strings.ReplaceAll(string(resp.GetData()), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
+	}
+
+	// deepObject notation binds the same fields
+	response, err := http.Get("http://localhost:28091/v1/nested?filter[status]=2&filter[owner][id]=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `{"filter":{"status":"CANCELLED","owner":{"id":"5"}}}`
+	if strings.ReplaceAll(string(data), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(data), expected)
+	}
+}
+
+func TestTimeQuery(t *testing.T) {
+	server := &http.Server{}
+	go func() {
+		router := http.NewServeMux()
+		router = AppendTimeQueryHttpRoute(router, &MockTimeQueryService{})
+		server.Addr = ":28092"
+		server.Handler = router
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+	defer server.Close()
+	time.Sleep(time.Second)
+	cli := NewTimeQueryHttpClient("http://localhost:28092")
+	resp, err := cli.TimeQuery(context.Background(), &TimeQueryRequest{
+		CreatedAfter:  &timestamppb.Timestamp{Seconds: 1704067200, Nanos: 500000000},
+		Ttl:           &durationpb.Duration{Seconds: 90},
+		ReadMask:      &fieldmaskpb.FieldMask{Paths: []string{"name", "owner.id"}},
+		ListTimestamp: []*timestamppb.Timestamp{{Seconds: 1}, {Seconds: 2}},
+		ListDuration:  []*durationpb.Duration{{Seconds: 1}, {Nanos: 500000000}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"createdAfter":"2024-01-01T00:00:00.500Z", "ttl":"90s", "readMask":"name,owner.id", "listTimestamp":["1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"], "listDuration":["1s", "0.500s"]}`
+	if strings.ReplaceAll(string(resp.GetData()), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
+	}
+
+	// nil messages are not sent
+	resp, err = cli.TimeQuery(context.Background(), &TimeQueryRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.ReplaceAll(string(resp.GetData()), " ", "") != "{}" {
+		t.Fatalf("body is not equal: got %s, want {}", string(resp.GetData()))
+	}
+
+	// RFC 3339 with offset, protojson duration and comma separated paths
+	response, err := http.Get("http://localhost:28092/v1/time?created_after=2024-01-01T08:00:00%2B08:00&ttl=-1.5s&read_mask=a,b.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `{"createdAfter":"2024-01-01T00:00:00Z", "ttl":"-1.500s", "readMask":"a,b.c"}`
+	if strings.ReplaceAll(string(data), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(data), expected)
+	}
+
+	// malformed values are rejected
+	response, err = http.Get("http://localhost:28092/v1/time?ttl=1h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusOK {
+		t.Fatalf("status code is %d, want an error status", response.StatusCode)
+	}
+}
+
+func TestMapQuery(t *testing.T) {
+	server := &http.Server{}
+	go func() {
+		router := http.NewServeMux()
+		router = AppendMapQueryHttpRoute(router, &MockMapQueryService{})
+		server.Addr = ":28093"
+		server.Handler = router
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+	defer server.Close()
+	time.Sleep(time.Second)
+	cli := NewMapQueryHttpClient("http://localhost:28093")
+	resp, err := cli.MapQuery(context.Background(), &MapQueryRequest{
+		Labels:  map[string]string{"env": "prod"},
+		Counts:  map[int32]int64{-1: 2},
+		Flags:   map[uint64]bool{3: true},
+		Weights: map[string]float64{"a": 0.5},
+		States:  map[string]EnumQueryRequest_Status{"job": EnumQueryRequest_OK},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"labels":{"env":"prod"}, "counts":{"-1":"2"}, "flags":{"3":true}, "weights":{"a":0.5}, "states":{"job":"OK"}}`
+	if strings.ReplaceAll(string(resp.GetData()), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(resp.GetData()), expected)
+	}
+
+	// dot and bracket notation bind the same entries
+	response, err := http.Get("http://localhost:28093/v1/map?labels.env=prod&labels[team]=core")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = `{"labels":{"env":"prod", "team":"core"}}`
+	if strings.ReplaceAll(string(data), " ", "") != strings.ReplaceAll(expected, " ", "") {
+		t.Fatalf("body is not equal: got %s, want %s", string(data), expected)
+	}
+
+	// malformed keys are rejected
+	response, err = http.Get("http://localhost:28093/v1/map?counts[x]=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusOK {
+		t.Fatalf("status code is %d, want an error status", response.StatusCode)
+	}
+}
@@ -26,10 +26,7 @@ type ResponseBodyService interface {
 	HttpResponse(ctx context.Context, req *Request) (*http.HttpResponse, error)
 }
 
-func AppendResponseBodyHttpRoute(router *http1.ServeMux, service ResponseBodyService, opts ...server.Option) *http1.ServeMux {
-	if router == nil {
-		router = http1.NewServeMux()
-	}
+func AppendResponseBodyHttpRoute[R goose.Router](router R, service ResponseBodyService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := responseBodyHandler{
 		service: service,
@@ -44,16 +41,21 @@ func AppendResponseBodyHttpRoute(router *http1.ServeMux, service ResponseBodySer
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
-	}
-	router.Handle("GET /v1/omitted/response", http1.HandlerFunc(handler.OmittedResponse))
-	router.Handle("GET /v1/star/response", http1.HandlerFunc(handler.StarResponse))
-	router.Handle("GET /v1/named/response", http1.HandlerFunc(handler.NamedResponse))
-	router.Handle("GET /v1/http/body/omitted/response", http1.HandlerFunc(handler.HttpBodyResponse))
-	router.Handle("GET /v1/http/body/named/response", http1.HandlerFunc(handler.HttpBodyNamedResponse))
-	router.Handle("GET /v1/http/response", http1.HandlerFunc(handler.HttpResponse))
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("GET "+options.Prefix()+"/v1/omitted/response", http1.HandlerFunc(handler.OmittedResponse))
+	router.Handle("GET "+options.Prefix()+"/v1/star/response", http1.HandlerFunc(handler.StarResponse))
+	router.Handle("GET "+options.Prefix()+"/v1/named/response", http1.HandlerFunc(handler.NamedResponse))
+	router.Handle("GET "+options.Prefix()+"/v1/http/body/omitted/response", http1.HandlerFunc(handler.HttpBodyResponse))
+	router.Handle("GET "+options.Prefix()+"/v1/http/body/named/response", http1.HandlerFunc(handler.HttpBodyNamedResponse))
+	router.Handle("GET "+options.Prefix()+"/v1/http/response", http1.HandlerFunc(handler.HttpResponse))
 	return router
 }
 
+func NewResponseBodyHandler(service ResponseBodyService, opts ...server.Option) http1.Handler {
+	return AppendResponseBodyHttpRoute(http1.NewServeMux(), service, opts...)
+}
+
 type responseBodyHandler struct {
 	service                 ResponseBodyService
 	decoder                 responseBodyRequestDecoder
@@ -62,16 +64,18 @@ type responseBodyHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h responseBodyHandler) OmittedResponse(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.OmittedResponse(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -86,17 +90,18 @@ func (h responseBodyHandler) OmittedResponse(response http1.ResponseWriter, requ
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_OmittedResponse_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_OmittedResponse_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h responseBodyHandler) StarResponse(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.StarResponse(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -111,17 +116,18 @@ func (h responseBodyHandler) StarResponse(response http1.ResponseWriter, request
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_StarResponse_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_StarResponse_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h responseBodyHandler) NamedResponse(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.NamedResponse(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -136,17 +142,18 @@ func (h responseBodyHandler) NamedResponse(response http1.ResponseWriter, reques
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_NamedResponse_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_NamedResponse_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h responseBodyHandler) HttpBodyResponse(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.HttpBodyResponse(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -161,17 +168,18 @@ func (h responseBodyHandler) HttpBodyResponse(response http1.ResponseWriter, req
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_HttpBodyResponse_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_HttpBodyResponse_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h responseBodyHandler) HttpBodyNamedResponse(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.HttpBodyNamedResponse(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -186,17 +194,18 @@ func (h responseBodyHandler) HttpBodyNamedResponse(response http1.ResponseWriter
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_HttpBodyNamedResponse_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_HttpBodyNamedResponse_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h responseBodyHandler) HttpResponse(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.HttpResponse(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -211,7 +220,7 @@ func (h responseBodyHandler) HttpResponse(response http1.ResponseWriter, request
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_HttpResponse_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_response_body_v1_ResponseBody_HttpResponse_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type responseBodyRequestDecoder struct {
@@ -227,7 +236,7 @@ func (decoder responseBodyRequestDecoder) OmittedResponse(ctx context.Context, r
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Message = queries.Get("message")
 	if queryErr != nil {
@@ -244,7 +253,7 @@ func (decoder responseBodyRequestDecoder) StarResponse(ctx context.Context, requ
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Message = queries.Get("message")
 	if queryErr != nil {
@@ -261,7 +270,7 @@ func (decoder responseBodyRequestDecoder) NamedResponse(ctx context.Context, req
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Message = queries.Get("message")
 	if queryErr != nil {
@@ -278,7 +287,7 @@ func (decoder responseBodyRequestDecoder) HttpBodyResponse(ctx context.Context,
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Message = queries.Get("message")
 	if queryErr != nil {
@@ -295,7 +304,7 @@ func (decoder responseBodyRequestDecoder) HttpBodyNamedResponse(ctx context.Cont
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Message = queries.Get("message")
 	if queryErr != nil {
@@ -312,7 +321,7 @@ func (decoder responseBodyRequestDecoder) HttpResponse(ctx context.Context, requ
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.Message = queries.Get("message")
 	if queryErr != nil {
@@ -345,7 +354,7 @@ func (encoder responseBodyResponseEncoder) HttpResponse(ctx context.Context, w h
 	return server.EncodeHttpResponse(ctx, w, resp)
 }
 
-func NewResponseBodyHttpClient(target string, opts ...client.Option) ResponseBodyService {
+func NewResponseBodyHttpClient(target string, opts ...client.Option) ResponseBodyHttpClient {
 	options := client.NewOptions(opts...)
 	client := &responseBodyHttpClient{
 		client: options.Client(),
@@ -366,6 +375,17 @@ func NewResponseBodyHttpClient(target string, opts ...client.Option) ResponseBod
 	return client
 }
 
+type ResponseBodyHttpClient interface {
+	OmittedResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*Response, error)
+	StarResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*Response, error)
+	NamedResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*NamedBodyResponse, error)
+	HttpBodyResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*httpbody.HttpBody, error)
+	HttpBodyNamedResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*NamedHttpBodyResponse, error)
+	HttpResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*http.HttpResponse, error)
+}
+
+var _ ResponseBodyHttpClient = (*responseBodyHttpClient)(nil)
+
 type responseBodyHttpClient struct {
 	client                  *http1.Client
 	encoder                 responseBodyRequestEncoder
@@ -375,15 +395,17 @@ type responseBodyHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *responseBodyHttpClient) OmittedResponse(ctx context.Context, req *Request) (*Response, error) {
+func (c *responseBodyHttpClient) OmittedResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.OmittedResponse(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.OmittedResponse(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_OmittedResponse_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_OmittedResponse_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -394,15 +416,17 @@ func (c *responseBodyHttpClient) OmittedResponse(ctx context.Context, req *Reque
 	return resp, nil
 }
 
-func (c *responseBodyHttpClient) StarResponse(ctx context.Context, req *Request) (*Response, error) {
+func (c *responseBodyHttpClient) StarResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.StarResponse(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.StarResponse(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_StarResponse_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_StarResponse_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -413,15 +437,17 @@ func (c *responseBodyHttpClient) StarResponse(ctx context.Context, req *Request)
 	return resp, nil
 }
 
-func (c *responseBodyHttpClient) NamedResponse(ctx context.Context, req *Request) (*NamedBodyResponse, error) {
+func (c *responseBodyHttpClient) NamedResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*NamedBodyResponse, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.NamedResponse(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.NamedResponse(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_NamedResponse_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_NamedResponse_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -432,15 +458,17 @@ func (c *responseBodyHttpClient) NamedResponse(ctx context.Context, req *Request
 	return resp, nil
 }
 
-func (c *responseBodyHttpClient) HttpBodyResponse(ctx context.Context, req *Request) (*httpbody.HttpBody, error) {
+func (c *responseBodyHttpClient) HttpBodyResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*httpbody.HttpBody, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.HttpBodyResponse(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.HttpBodyResponse(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_HttpBodyResponse_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_HttpBodyResponse_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -451,15 +479,17 @@ func (c *responseBodyHttpClient) HttpBodyResponse(ctx context.Context, req *Requ
 	return resp, nil
 }
 
-func (c *responseBodyHttpClient) HttpBodyNamedResponse(ctx context.Context, req *Request) (*NamedHttpBodyResponse, error) {
+func (c *responseBodyHttpClient) HttpBodyNamedResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*NamedHttpBodyResponse, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.HttpBodyNamedResponse(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.HttpBodyNamedResponse(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_HttpBodyNamedResponse_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_HttpBodyNamedResponse_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -470,15 +500,17 @@ func (c *responseBodyHttpClient) HttpBodyNamedResponse(ctx context.Context, req
 	return resp, nil
 }
 
-func (c *responseBodyHttpClient) HttpResponse(ctx context.Context, req *Request) (*http.HttpResponse, error) {
+func (c *responseBodyHttpClient) HttpResponse(ctx context.Context, req *Request, opts ...client.CallOption) (*http.HttpResponse, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.HttpResponse(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.HttpResponse(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_HttpResponse_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_response_body_v1_ResponseBody_HttpResponse_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
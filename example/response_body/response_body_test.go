@@ -63,7 +63,7 @@ func runServer(server *http.Server, port int) {
 	}
 }
 
-func newClient(port int) ResponseBodyService {
+func newClient(port int) ResponseBodyHttpClient {
 	return NewResponseBodyHttpClient(fmt.Sprintf("http://localhost:%d", port))
 }
 
@@ -0,0 +1,70 @@
+package split
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/soyacen/goose"
+	"github.com/soyacen/goose/client"
+	"github.com/soyacen/goose/goosetest"
+	"github.com/soyacen/goose/server"
+)
+
+// subRouter is a router other than *http.ServeMux, it records the registered patterns.
+type subRouter struct {
+	mux      *http.ServeMux
+	patterns []string
+}
+
+func (r *subRouter) Handle(pattern string, handler http.Handler) {
+	r.patterns = append(r.patterns, pattern)
+	r.mux.Handle(pattern, handler)
+}
+
+func TestRouterPrefix(t *testing.T) {
+	var pattern string
+	srv := goosetest.NewServer(t, func(mux *http.ServeMux) *http.ServeMux {
+		router := AppendCounterRoute(&subRouter{mux: mux}, &counterService{}, server.Prefix("/api"),
+			server.Middlewares(func(response http.ResponseWriter, request *http.Request, invoker http.HandlerFunc) {
+				info, _ := goose.ExtractRouteInfo(request.Context())
+				pattern = info.Pattern
+				invoker(response, request)
+			}),
+		)
+		expected := []string{"GET /api/v1/counters/{name}", "POST /api/v1/counters/{name}", "/api/v1/counters/{name}/watch"}
+		if len(router.patterns) != len(expected) {
+			t.Fatalf("expected patterns %v, got %v", expected, router.patterns)
+		}
+		for i := range expected {
+			if router.patterns[i] != expected[i] {
+				t.Fatalf("expected patterns %v, got %v", expected, router.patterns)
+			}
+		}
+		return mux
+	})
+
+	cli := NewCounterHttpClient(srv.URL+"/api", client.Client(srv.Client()))
+	if _, err := cli.Get(context.Background(), &GetRequest{Name: "visits"}); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if pattern != "/api/v1/counters/{name}" {
+		t.Fatalf("expected the prefixed pattern in the route info, got %q", pattern)
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	srv := goosetest.NewServer(t, func(mux *http.ServeMux) *http.ServeMux {
+		mux.Handle("/api/", http.StripPrefix("/api", NewCounterHandler(&counterService{})))
+		return mux
+	})
+
+	cli := NewCounterHttpClient(srv.URL+"/api", client.Client(srv.Client()))
+	count, err := cli.Add(context.Background(), &AddRequest{Name: "visits", Delta: 3})
+	if err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if count.GetValue() != 3 {
+		t.Fatalf("expected 3, got %d", count.GetValue())
+	}
+}
@@ -0,0 +1,79 @@
+package split
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/soyacen/goose/goosetest"
+	"github.com/soyacen/goose/ws"
+)
+
+type counterService struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+var _ CounterServer = (*counterService)(nil)
+
+func (s *counterService) Get(ctx context.Context, req *GetRequest) (*Count, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &Count{Name: req.GetName(), Value: s.counts[req.GetName()]}, nil
+}
+
+func (s *counterService) Add(ctx context.Context, req *AddRequest) (*Count, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.counts == nil {
+		s.counts = make(map[string]int64)
+	}
+	s.counts[req.GetName()] += req.GetDelta()
+	return &Count{Name: req.GetName(), Value: s.counts[req.GetName()]}, nil
+}
+
+func (s *counterService) Watch(req *GetRequest, stream ws.ServerStreamingServer[*Count]) error {
+	count, _ := s.Get(stream.Context(), req)
+	return stream.Send(count)
+}
+
+func TestSplitHttp(t *testing.T) {
+	cli, _ := goosetest.Start(t, CounterService(&counterService{}), AppendCounterHttpRoute, NewCounterHttpClient)
+
+	ctx := context.Background()
+	if _, err := cli.Add(ctx, &AddRequest{Name: "visits", Delta: 2}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	count, err := cli.Get(ctx, &GetRequest{Name: "visits"})
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if count.GetValue() != 2 {
+		t.Fatalf("expected 2, got %d", count.GetValue())
+	}
+}
+
+func TestSplitStream(t *testing.T) {
+	svc := &counterService{counts: map[string]int64{"visits": 5}}
+	cli, _ := goosetest.StartStream(t, CounterStreamServer(svc), AppendCounterWebsocketRoute, NewCounterStreamClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := cli.Watch(ctx, &GetRequest{Name: "visits"})
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+	count, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv failed: %v", err)
+	}
+	if count.GetValue() != 5 {
+		t.Fatalf("expected 5, got %d", count.GetValue())
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package sse
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/soyacen/goose/goosetest"
+	"github.com/soyacen/goose/ws"
+)
+
+func TestGoosetestEventStream(t *testing.T) {
+	cli, srv := goosetest.StartStream(t, ClockStreamServer(&mockClockService{}), AppendClockWebsocketRoute, NewClockStreamClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := cli.Tick(ctx, &TickRequest{Name: "alarm", Count: 2})
+	if err != nil {
+		t.Fatalf("Tick failed: %v", err)
+	}
+	for seq := int32(1); seq <= 2; seq++ {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if resp.GetSeq() != seq {
+			t.Fatalf("expected seq %d, got %d", seq, resp.GetSeq())
+		}
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+
+	exchange := waitExchange(t, srv)
+	if exchange.Header.Get("Content-Type") != ws.EventStreamContentType {
+		t.Fatalf("unexpected exchange: %+v", exchange)
+	}
+	if !strings.Contains(string(exchange.ResponseBody), "event: close") {
+		t.Fatalf("expected the captured events to end with close, got %s", exchange.ResponseBody)
+	}
+}
+
+// waitExchange waits for the stream handler to return, exchanges are captured once it does.
+func waitExchange(t *testing.T, srv *goosetest.Server) *goosetest.Exchange {
+	t.Helper()
+	for range 100 {
+		if exchange := srv.LastExchange(); exchange != nil {
+			return exchange
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("no exchange captured")
+	return nil
+}
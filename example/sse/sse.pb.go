@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: example/sse/sse.proto
+
+package sse
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NowRequest) Reset() {
+	*x = NowRequest{}
+	mi := &file_example_sse_sse_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NowRequest) ProtoMessage() {}
+
+func (x *NowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_sse_sse_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NowRequest.ProtoReflect.Descriptor instead.
+func (*NowRequest) Descriptor() ([]byte, []int) {
+	return file_example_sse_sse_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NowRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TickRequest) Reset() {
+	*x = TickRequest{}
+	mi := &file_example_sse_sse_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickRequest) ProtoMessage() {}
+
+func (x *TickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_sse_sse_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickRequest.ProtoReflect.Descriptor instead.
+func (*TickRequest) Descriptor() ([]byte, []int) {
+	return file_example_sse_sse_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TickRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TickRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seq           int32                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TickResponse) Reset() {
+	*x = TickResponse{}
+	mi := &file_example_sse_sse_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickResponse) ProtoMessage() {}
+
+func (x *TickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_example_sse_sse_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickResponse.ProtoReflect.Descriptor instead.
+func (*TickResponse) Descriptor() ([]byte, []int) {
+	return file_example_sse_sse_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TickResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TickResponse) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+var File_example_sse_sse_proto protoreflect.FileDescriptor
+
+const file_example_sse_sse_proto_rawDesc = "" +
+	"\n" +
+	"\x15example/sse/sse.proto\x12\x18leo.goose.example.sse.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\" \n" +
+	"\n" +
+	"NowRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"<\n" +
+	"\vTickRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05count\x18\x02 \x01(\x05B\x03\xe0A\x02R\x05count\"4\n" +
+	"\fTickResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x05R\x03seq2\xf1\x01\n" +
+	"\x05Clock\x12n\n" +
+	"\x03Now\x12$.leo.goose.example.sse.v1.NowRequest\x1a&.leo.goose.example.sse.v1.TickResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/clocks/{name}\x12x\n" +
+	"\x04Tick\x12%.leo.goose.example.sse.v1.TickRequest\x1a&.leo.goose.example.sse.v1.TickResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/clocks/{name}/ticks0\x01B-Z+github.com/soyacen/goose/example/sse/v1;sseb\x06proto3"
+
+var (
+	file_example_sse_sse_proto_rawDescOnce sync.Once
+	file_example_sse_sse_proto_rawDescData []byte
+)
+
+func file_example_sse_sse_proto_rawDescGZIP() []byte {
+	file_example_sse_sse_proto_rawDescOnce.Do(func() {
+		file_example_sse_sse_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_example_sse_sse_proto_rawDesc), len(file_example_sse_sse_proto_rawDesc)))
+	})
+	return file_example_sse_sse_proto_rawDescData
+}
+
+var file_example_sse_sse_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_example_sse_sse_proto_goTypes = []any{
+	(*NowRequest)(nil),   // 0: leo.goose.example.sse.v1.NowRequest
+	(*TickRequest)(nil),  // 1: leo.goose.example.sse.v1.TickRequest
+	(*TickResponse)(nil), // 2: leo.goose.example.sse.v1.TickResponse
+}
+var file_example_sse_sse_proto_depIdxs = []int32{
+	0, // 0: leo.goose.example.sse.v1.Clock.Now:input_type -> leo.goose.example.sse.v1.NowRequest
+	1, // 1: leo.goose.example.sse.v1.Clock.Tick:input_type -> leo.goose.example.sse.v1.TickRequest
+	2, // 2: leo.goose.example.sse.v1.Clock.Now:output_type -> leo.goose.example.sse.v1.TickResponse
+	2, // 3: leo.goose.example.sse.v1.Clock.Tick:output_type -> leo.goose.example.sse.v1.TickResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_example_sse_sse_proto_init() }
+func file_example_sse_sse_proto_init() {
+	if File_example_sse_sse_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_example_sse_sse_proto_rawDesc), len(file_example_sse_sse_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_example_sse_sse_proto_goTypes,
+		DependencyIndexes: file_example_sse_sse_proto_depIdxs,
+		MessageInfos:      file_example_sse_sse_proto_msgTypes,
+	}.Build()
+	File_example_sse_sse_proto = out.File
+	file_example_sse_sse_proto_goTypes = nil
+	file_example_sse_sse_proto_depIdxs = nil
+}
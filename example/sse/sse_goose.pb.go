@@ -0,0 +1,475 @@
+// Code generated by protoc-gen-goose. DO NOT EDIT.
+
+package sse
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+	websocket "github.com/coder/websocket"
+	goose "github.com/soyacen/goose"
+	client "github.com/soyacen/goose/client"
+	resolver "github.com/soyacen/goose/client/resolver"
+	server "github.com/soyacen/goose/server"
+	ws "github.com/soyacen/goose/ws"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	slog "log/slog"
+	http "net/http"
+	url "net/url"
+)
+
+type ClockService interface {
+	Now(ctx context.Context, req *NowRequest) (*TickResponse, error)
+}
+
+func AppendClockHttpRoute[R goose.Router](router R, service ClockService, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	handler := clockHandler{
+		service: service,
+		decoder: clockRequestDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		encoder: clockResponseEncoder{
+			marshalOptions:   options.MarshalOptions(),
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		errorEncoder:            options.ErrorEncoder(),
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("GET "+options.Prefix()+"/v1/clocks/{name}", http.HandlerFunc(handler.Now))
+	return router
+}
+
+type clockHandler struct {
+	service                 ClockService
+	decoder                 clockRequestDecoder
+	encoder                 clockResponseEncoder
+	errorEncoder            goose.ErrorEncoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              server.Middleware
+	prefix                  string
+}
+
+func (h clockHandler) Now(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.Now(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.Now(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.Now(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_sse_v1_Clock_Now_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+type clockRequestDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (decoder clockRequestDecoder) Now(ctx context.Context, request *http.Request) (*NowRequest, error) {
+	req := &NowRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := goose.FormFromPath(request, "name")
+	var varErr error
+	req.Name = vars.Get("name")
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
+func (decoder clockRequestDecoder) Tick(ctx context.Context, request *http.Request) (*TickRequest, error) {
+	req := &TickRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := goose.FormFromPath(request, "name")
+	var varErr error
+	req.Name = vars.Get("name")
+	if varErr != nil {
+		return nil, varErr
+	}
+	queries := goose.FormFromQuery(request)
+	var queryErr error
+	req.Count, queryErr = goose.GetForm[int32](queryErr, queries, "count", goose.GetInt)
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	return req, nil
+}
+
+type clockResponseEncoder struct {
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (encoder clockResponseEncoder) Now(ctx context.Context, w http.ResponseWriter, resp *TickResponse) error {
+	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
+}
+
+func NewClockHttpClient(target string, opts ...client.Option) ClockHttpClient {
+	options := client.NewOptions(opts...)
+	client := &clockHttpClient{
+		client: options.Client(),
+		encoder: clockRequestEncoder{
+			target:         target,
+			marshalOptions: options.MarshalOptions(),
+			resolver:       options.Resolver(),
+		},
+		decoder: clockResponseDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+			errorDecoder:     options.ErrorDecoder(),
+			errorFactory:     options.ErrorFactory(),
+		},
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              client.Chain(options.Middlewares()...),
+	}
+	return client
+}
+
+type ClockHttpClient interface {
+	Now(ctx context.Context, req *NowRequest, opts ...client.CallOption) (*TickResponse, error)
+}
+
+var _ ClockHttpClient = (*clockHttpClient)(nil)
+
+type clockHttpClient struct {
+	client                  *http.Client
+	encoder                 clockRequestEncoder
+	decoder                 clockResponseDecoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              client.Middleware
+}
+
+func (c *clockHttpClient) Now(ctx context.Context, req *NowRequest, opts ...client.CallOption) (*TickResponse, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Now(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_sse_v1_Clock_Now_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.Now(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type clockRequestEncoder struct {
+	target         string
+	marshalOptions protojson.MarshalOptions
+	resolver       resolver.Resolver
+}
+
+func (encoder *clockRequestEncoder) Now(ctx context.Context, req *NowRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/clocks/{name}"
+	pairs := map[string]string{
+		"name": req.GetName(),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+func (encoder *clockRequestEncoder) Tick(ctx context.Context, req *TickRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/clocks/{name}/ticks"
+	pairs := map[string]string{
+		"name": req.GetName(),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	queries := url.Values{}
+	queries["count"] = append(queries["count"], goose.FormatInt(req.GetCount(), 10))
+	target.RawQuery = queries.Encode()
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+type clockResponseDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+	errorDecoder     goose.ErrorDecoder
+	errorFactory     goose.ErrorFactory
+}
+
+func (decoder *clockResponseDecoder) Now(ctx context.Context, response *http.Response) (*TickResponse, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &TickResponse{}
+	if err := client.DecodeMessage(ctx, response, resp, decoder.unmarshalOptions); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type ClockStreamServer interface {
+	Tick(*TickRequest, ws.ServerStreamingServer[*TickResponse]) error
+}
+
+type ClockStreamClient interface {
+	Tick(ctx context.Context, in *TickRequest) (ws.ServerStreamingClient[*TickResponse], error)
+}
+
+func AppendClockWebsocketRoute[R goose.Router](router R, service ClockStreamServer, opts ...ws.Option) R {
+	options := ws.NewOptions(opts...)
+	handler := &clockStreamHandler{
+		service:          service,
+		middleware:       server.Chain(options.Middlewares()...),
+		marshalOptions:   options.MarshalOptions(),
+		unmarshalOptions: options.UnmarshalOptions(),
+		acptOpts:         options.AcceptOptions(),
+		cfg:              options.ConnConfig(),
+		logger:           options.Logger(),
+		prefix:           options.Prefix(),
+		decoder: clockRequestDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		eventID:                 options.EventIDFunc(),
+		errorEncoder:            options.ErrorEncoder(),
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+	}
+	router.Handle(options.Prefix()+_leo_goose_example_sse_v1_Clock_Tick_Desc.RouteInfo.Pattern, http.HandlerFunc(handler.Tick))
+	return router
+}
+
+type clockStreamHandler struct {
+	service                 ClockStreamServer
+	middleware              server.Middleware
+	marshalOptions          protojson.MarshalOptions
+	unmarshalOptions        protojson.UnmarshalOptions
+	acptOpts                *websocket.AcceptOptions
+	cfg                     *ws.ConnConfig
+	logger                  *slog.Logger
+	prefix                  string
+	decoder                 clockRequestDecoder
+	eventID                 ws.EventIDFunc
+	errorEncoder            goose.ErrorEncoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+}
+
+func (h clockStreamHandler) Tick(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		if !ws.IsWebsocketRequest(request) {
+			ctx := request.Context()
+			req, err := h.decoder.Tick(ctx, request)
+			if err != nil {
+				h.errorEncoder(ctx, err, response)
+				return
+			}
+			goose.ClearOutputOnly(req)
+			if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+				h.errorEncoder(ctx, err, response)
+				return
+			}
+			stream, err := ws.NewSSEServerStream[*TickResponse](ctx, response, request, h.marshalOptions, h.eventID)
+			if err != nil {
+				h.errorEncoder(ctx, err, response)
+				return
+			}
+			err = h.service.Tick(req, stream)
+			if err != nil && !ws.IsNormalClose(err) {
+				h.logger.Error("failed to handle server stream",
+					"service", "Clock", "method", "Tick", "error", err)
+			}
+			if err := stream.CloseWithError(err); err != nil && !ws.IsNormalClose(err) {
+				h.logger.Error("failed to close event stream",
+					"service", "Clock", "method", "Tick", "error", err)
+			}
+			return
+		}
+		ctx, conn, cancel, err := ws.AcceptConn(response, request, h.acptOpts, h.cfg, h.logger)
+		if err != nil {
+			h.logger.Error("failed to accept websocket connection",
+				"service", "Clock", "method", "Tick", "error", err)
+			return
+		}
+		defer cancel()
+		var req TickRequest
+		data, err := conn.Read(ctx)
+		if err != nil {
+			if !ws.IsNormalClose(err) {
+				h.logger.Error("failed to read request",
+					"service", "Clock", "method", "Tick", "error", err)
+			}
+			return
+		}
+		if err := h.unmarshalOptions.Unmarshal(data, &req); err != nil {
+			h.logger.Error("failed to unmarshal request",
+				"service", "Clock", "method", "Tick", "error", err)
+			return
+		}
+		stream := ws.NewServerStream[*TickRequest, *TickResponse](ctx, conn, h.marshalOptions, h.unmarshalOptions)
+		if err := h.service.Tick(&req, stream); err != nil && !ws.IsNormalClose(err) {
+			h.logger.Error("failed to handle server stream",
+				"service", "Clock", "method", "Tick", "error", err)
+		}
+		if err := stream.CloseSend(); err != nil && !ws.IsNormalClose(err) {
+			h.logger.Error("failed to close send stream",
+				"service", "Clock", "method", "Tick", "error", err)
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_sse_v1_Clock_Tick_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+var _ ClockStreamClient = (*clockStreamClient)(nil)
+
+type clockStreamClient struct {
+	url              string
+	dialOpts         *websocket.DialOptions
+	connCfg          *ws.ConnConfig
+	logger           *slog.Logger
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+	resolver         resolver.Resolver
+	client           *http.Client
+	encoder          clockRequestEncoder
+}
+
+func NewClockStreamClient(url string, opts ...ws.Option) ClockStreamClient {
+	options := ws.NewOptions(opts...)
+	return &clockStreamClient{
+		url:              url,
+		dialOpts:         options.DialOptions(),
+		connCfg:          options.ConnConfig(),
+		logger:           options.Logger(),
+		marshalOptions:   options.MarshalOptions(),
+		unmarshalOptions: options.UnmarshalOptions(),
+		resolver:         options.Resolver(),
+		client:           options.Client(),
+		encoder: clockRequestEncoder{
+			target:         ws.EventStreamTarget(url),
+			marshalOptions: options.MarshalOptions(),
+			resolver:       options.Resolver(),
+		},
+	}
+}
+
+func (c *clockStreamClient) Tick(ctx context.Context, in *TickRequest) (ws.ServerStreamingClient[*TickResponse], error) {
+	request, err := c.encoder.Tick(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := ws.NewSSEClientStream[*TickResponse](ctx, c.client, request, c.unmarshalOptions)
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+type ClockServer interface {
+	ClockService
+	ClockStreamServer
+}
+
+func AppendClockRoute[R goose.Router](router R, service ClockServer, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	router = AppendClockHttpRoute(router, service, opts...)
+	wsOpts := []ws.Option{
+		ws.MarshalOptions(options.MarshalOptions()),
+		ws.UnmarshalOptions(options.UnmarshalOptions()),
+		ws.Middlewares(options.Middlewares()...),
+		ws.Prefix(options.Prefix()),
+		ws.ErrorEncoder(options.ErrorEncoder()),
+		ws.OnValidationErrCallback(options.OnValidationErrCallback()),
+	}
+	if options.ShouldFailFast() {
+		wsOpts = append(wsOpts, ws.FailFast())
+	}
+	router = AppendClockWebsocketRoute(router, service, wsOpts...)
+	return router
+}
+
+func NewClockHandler(service ClockServer, opts ...server.Option) http.Handler {
+	return AppendClockRoute(http.NewServeMux(), service, opts...)
+}
+
+var _leo_goose_example_sse_v1_Clock_Now_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/clocks/{name}",
+		FullMethod: "/leo.goose.example.sse.v1.Clock/Now",
+	},
+}
+
+var _leo_goose_example_sse_v1_Clock_Tick_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/clocks/{name}/ticks",
+		FullMethod: "/leo.goose.example.sse.v1.Clock/Tick",
+	},
+}
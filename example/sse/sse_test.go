@@ -0,0 +1,228 @@
+package sse
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/soyacen/goose"
+	"github.com/soyacen/goose/server"
+	"github.com/soyacen/goose/ws"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type mockClockService struct{}
+
+var _ ClockServer = (*mockClockService)(nil)
+
+func (s *mockClockService) Now(ctx context.Context, req *NowRequest) (*TickResponse, error) {
+	return &TickResponse{Name: req.GetName()}, nil
+}
+
+// Tick resumes after the Last-Event-ID sent by a reconnecting client.
+func (s *mockClockService) Tick(req *TickRequest, stream ws.ServerStreamingServer[*TickResponse]) error {
+	if req.GetCount() < 0 {
+		return goose.NewError(http.StatusBadRequest, map[string]string{"message": "negative count"})
+	}
+	start, _ := strconv.Atoi(ws.LastEventID(stream.Context()))
+	for seq := start + 1; seq <= int(req.GetCount()); seq++ {
+		if err := stream.Send(&TickResponse{Name: req.GetName(), Seq: int32(seq)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startServer(t *testing.T, port int) *http.Server {
+	t.Helper()
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: NewClockHandler(&mockClockService{}),
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.Errorf("server error: %v", err)
+		}
+	}()
+	time.Sleep(200 * time.Millisecond)
+	return srv
+}
+
+func TestEventStream(t *testing.T) {
+	srv := startServer(t, 49081)
+	defer srv.Shutdown(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := NewClockStreamClient("http://localhost:49081")
+	stream, err := client.Tick(ctx, &TickRequest{Name: "alarm", Count: 3})
+	if err != nil {
+		t.Fatalf("Tick failed: %v", err)
+	}
+	for seq := int32(1); seq <= 3; seq++ {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if resp.GetName() != "alarm" || resp.GetSeq() != seq {
+			t.Fatalf("unexpected response: %v", resp)
+		}
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+	if id := stream.(*ws.SSEClientStream[*TickResponse]).LastEventID(); id != "3" {
+		t.Fatalf("expected last event id 3, got %q", id)
+	}
+}
+
+func TestEventStreamResume(t *testing.T) {
+	srv := startServer(t, 49082)
+	defer srv.Shutdown(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := NewClockStreamClient("ws://localhost:49082")
+	stream, err := client.Tick(ws.WithLastEventID(ctx, "2"), &TickRequest{Name: "alarm", Count: 4})
+	if err != nil {
+		t.Fatalf("Tick failed: %v", err)
+	}
+	for seq := int32(3); seq <= 4; seq++ {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if resp.GetSeq() != seq {
+			t.Fatalf("expected seq %d, got %d", seq, resp.GetSeq())
+		}
+		if id := stream.(*ws.SSEClientStream[*TickResponse]).LastEventID(); id != strconv.Itoa(int(seq)) {
+			t.Fatalf("expected event id %d, got %q", seq, id)
+		}
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestEventStreamError(t *testing.T) {
+	srv := startServer(t, 49083)
+	defer srv.Shutdown(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := NewClockStreamClient("http://localhost:49083")
+	stream, err := client.Tick(ctx, &TickRequest{Name: "alarm", Count: -1})
+	if err != nil {
+		t.Fatalf("Tick failed: %v", err)
+	}
+	_, err = stream.Recv()
+	var eventErr *ws.EventError
+	if !errors.As(err, &eventErr) {
+		t.Fatalf("expected *ws.EventError, got %v", err)
+	}
+	if eventErr.Data != `{"message":"negative count"}` {
+		t.Fatalf("unexpected error data: %s", eventErr.Data)
+	}
+}
+
+func TestEventStreamValidation(t *testing.T) {
+	var encoded error
+	srv := &http.Server{
+		Addr: ":49086",
+		Handler: NewClockHandler(&mockClockService{}, server.ErrorEncoder(func(ctx context.Context, err error, response http.ResponseWriter) {
+			encoded = err
+			goose.DefaultEncodeError(ctx, err, response)
+		})),
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.Errorf("server error: %v", err)
+		}
+	}()
+	time.Sleep(200 * time.Millisecond)
+	defer srv.Shutdown(context.Background())
+
+	// count is REQUIRED, the request is rejected before the stream starts
+	response, err := http.Get("http://localhost:49086/v1/clocks/alarm/ticks")
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, response.StatusCode)
+	}
+	var behaviorErr *goose.FieldBehaviorError
+	if !errors.As(encoded, &behaviorErr) {
+		t.Fatalf("expected the configured error encoder to encode a *goose.FieldBehaviorError, got %v", encoded)
+	}
+}
+
+func TestPlainHttpEventStream(t *testing.T) {
+	srv := startServer(t, 49084)
+	defer srv.Shutdown(context.Background())
+
+	// what curl or a browser EventSource receives
+	response, err := http.Get("http://localhost:49084/v1/clocks/alarm/ticks?count=2")
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	defer response.Body.Close()
+	if ct := response.Header.Get("Content-Type"); ct != ws.EventStreamContentType {
+		t.Fatalf("unexpected content type: %s", ct)
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("read body failed: %v", err)
+	}
+	var events []string
+	scanner := bufio.NewScanner(strings.NewReader(string(body)))
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "id: ") || strings.HasPrefix(line, "event: ") {
+			events = append(events, line)
+		}
+	}
+	expected := []string{"id: 1", "event: message", "id: 2", "event: message", "event: close"}
+	if strings.Join(events, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected events %v, got %v\n%s", expected, events, body)
+	}
+}
+
+func TestWebsocketStream(t *testing.T) {
+	srv := startServer(t, 49085)
+	defer srv.Shutdown(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// websocket upgrades of the same route are still served over websocket
+	conn, err := ws.DialAndConnect(ctx, "ws://localhost:49085/v1/clocks/alarm/ticks", ws.DialOptions(), ws.DefaultConnConfig(), nil)
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	stream := ws.NewClientStreamV2[*TickRequest, *TickResponse](ctx, conn, protojson.MarshalOptions{}, protojson.UnmarshalOptions{})
+	if err := stream.SendMsg(&TickRequest{Name: "alarm", Count: 2}); err != nil {
+		t.Fatalf("SendMsg failed: %v", err)
+	}
+	for seq := int32(1); seq <= 2; seq++ {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if resp.GetSeq() != seq {
+			t.Fatalf("expected seq %d, got %d", seq, resp.GetSeq())
+		}
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+}
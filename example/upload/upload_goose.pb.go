@@ -22,10 +22,7 @@ type UploadService interface {
 	UploadForRPC(ctx context.Context, req *http.HttpRequest) (*Response, error)
 }
 
-func AppendUploadHttpRoute(router *http1.ServeMux, service UploadService, opts ...server.Option) *http1.ServeMux {
-	if router == nil {
-		router = http1.NewServeMux()
-	}
+func AppendUploadHttpRoute[R goose.Router](router R, service UploadService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := uploadHandler{
 		service: service,
@@ -40,13 +37,18 @@ func AppendUploadHttpRoute(router *http1.ServeMux, service UploadService, opts .
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
 	}
-	router.Handle("PUT /v1/upload/api", http1.HandlerFunc(handler.Upload))
-	router.Handle("PUT /v1/upload/embd", http1.HandlerFunc(handler.UploadEmbed))
-	router.Handle("PUT /v1/upload/rpc", http1.HandlerFunc(handler.UploadForRPC))
+	router.Handle("PUT "+options.Prefix()+"/v1/upload/api", http1.HandlerFunc(handler.Upload))
+	router.Handle("PUT "+options.Prefix()+"/v1/upload/embd", http1.HandlerFunc(handler.UploadEmbed))
+	router.Handle("PUT "+options.Prefix()+"/v1/upload/rpc", http1.HandlerFunc(handler.UploadForRPC))
 	return router
 }
 
+func NewUploadHandler(service UploadService, opts ...server.Option) http1.Handler {
+	return AppendUploadHttpRoute(http1.NewServeMux(), service, opts...)
+}
+
 type uploadHandler struct {
 	service                 UploadService
 	decoder                 uploadRequestDecoder
@@ -55,16 +57,18 @@ type uploadHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h uploadHandler) Upload(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.Upload(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -79,17 +83,18 @@ func (h uploadHandler) Upload(response http1.ResponseWriter, request *http1.Requ
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_upload_v1_Upload_Upload_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_upload_v1_Upload_Upload_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h uploadHandler) UploadEmbed(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.UploadEmbed(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -104,17 +109,18 @@ func (h uploadHandler) UploadEmbed(response http1.ResponseWriter, request *http1
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_upload_v1_Upload_UploadEmbed_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_upload_v1_Upload_UploadEmbed_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h uploadHandler) UploadForRPC(response http1.ResponseWriter, request *http1.Request) {
 	invoke := func(response http1.ResponseWriter, request *http1.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.UploadForRPC(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -129,7 +135,7 @@ func (h uploadHandler) UploadForRPC(response http1.ResponseWriter, request *http
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_upload_v1_Upload_UploadForRPC_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_upload_v1_Upload_UploadForRPC_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type uploadRequestDecoder struct {
@@ -197,7 +203,7 @@ func (encoder uploadResponseEncoder) UploadForRPC(ctx context.Context, w http1.R
 	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
 }
 
-func NewUploadHttpClient(target string, opts ...client.Option) UploadService {
+func NewUploadHttpClient(target string, opts ...client.Option) UploadHttpClient {
 	options := client.NewOptions(opts...)
 	client := &uploadHttpClient{
 		client: options.Client(),
@@ -218,6 +224,14 @@ func NewUploadHttpClient(target string, opts ...client.Option) UploadService {
 	return client
 }
 
+type UploadHttpClient interface {
+	Upload(ctx context.Context, req *httpbody.HttpBody, opts ...client.CallOption) (*Response, error)
+	UploadEmbed(ctx context.Context, req *UploadEmbedRequest, opts ...client.CallOption) (*Response, error)
+	UploadForRPC(ctx context.Context, req *http.HttpRequest, opts ...client.CallOption) (*Response, error)
+}
+
+var _ UploadHttpClient = (*uploadHttpClient)(nil)
+
 type uploadHttpClient struct {
 	client                  *http1.Client
 	encoder                 uploadRequestEncoder
@@ -227,15 +241,17 @@ type uploadHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *uploadHttpClient) Upload(ctx context.Context, req *httpbody.HttpBody) (*Response, error) {
+func (c *uploadHttpClient) Upload(ctx context.Context, req *httpbody.HttpBody, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.Upload(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.Upload(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_upload_v1_Upload_Upload_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_upload_v1_Upload_Upload_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -246,15 +262,17 @@ func (c *uploadHttpClient) Upload(ctx context.Context, req *httpbody.HttpBody) (
 	return resp, nil
 }
 
-func (c *uploadHttpClient) UploadEmbed(ctx context.Context, req *UploadEmbedRequest) (*Response, error) {
+func (c *uploadHttpClient) UploadEmbed(ctx context.Context, req *UploadEmbedRequest, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.UploadEmbed(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.UploadEmbed(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_upload_v1_Upload_UploadEmbed_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_upload_v1_Upload_UploadEmbed_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -265,15 +283,17 @@ func (c *uploadHttpClient) UploadEmbed(ctx context.Context, req *UploadEmbedRequ
 	return resp, nil
 }
 
-func (c *uploadHttpClient) UploadForRPC(ctx context.Context, req *http.HttpRequest) (*Response, error) {
+func (c *uploadHttpClient) UploadForRPC(ctx context.Context, req *http.HttpRequest, opts ...client.CallOption) (*Response, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.UploadForRPC(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.UploadForRPC(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_upload_v1_Upload_UploadForRPC_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_upload_v1_Upload_UploadForRPC_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
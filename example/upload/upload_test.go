@@ -95,7 +95,7 @@ func runServer(server *http.Server, port int, uploadDir string) {
 	}
 }
 
-func newClient(port int) UploadService {
+func newClient(port int) UploadHttpClient {
 	return NewUploadHttpClient(fmt.Sprintf("http://localhost:%d", port))
 }
 
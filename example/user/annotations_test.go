@@ -0,0 +1,156 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/soyacen/goose/client"
+	"github.com/soyacen/goose/goosetest"
+	"google.golang.org/protobuf/proto"
+)
+
+// deadlineUserService records the deadline of the context ListUser is called with and
+// the requests of CreateUser and ListUser.
+type deadlineUserService struct {
+	MockUserService
+	deadline   time.Time
+	createUser *CreateUserRequest
+	listUser   *ListUserRequest
+}
+
+func (s *deadlineUserService) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+	s.createUser = req
+	return s.MockUserService.CreateUser(ctx, req)
+}
+
+func (s *deadlineUserService) ListUser(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error) {
+	s.deadline, _ = ctx.Deadline()
+	s.listUser = req
+	return s.MockUserService.ListUser(ctx, req)
+}
+
+func TestMethodAnnotations(t *testing.T) {
+	service := &deadlineUserService{}
+	cli, _ := goosetest.Start(t, UserService(service), AppendUserHttpRoute, NewUserHttpClient)
+
+	var statusCode int
+	if _, err := cli.CreateUser(context.Background(), &CreateUserRequest{Name: "jerry"}, client.StatusCode(&statusCode)); err != nil {
+		t.Fatal(err)
+	}
+	if statusCode != http.StatusCreated {
+		t.Fatalf("expected the annotated status code %d, got %d", http.StatusCreated, statusCode)
+	}
+
+	var header http.Header
+	if _, err := cli.GetUser(context.Background(), &GetUserRequest{Id: 1}, client.ResponseHeader(&header)); err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get("Cache-Control"); got != "max-age=60" {
+		t.Fatalf("expected the annotated Cache-Control header, got %q", got)
+	}
+
+	start := time.Now()
+	if _, err := cli.ListUser(context.Background(), &ListUserRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	end := time.Now()
+	if service.deadline.Before(start.Add(5*time.Second)) || service.deadline.After(end.Add(5*time.Second)) {
+		t.Fatalf("expected the service to be called with the annotated timeout, got deadline %v", service.deadline)
+	}
+}
+
+func TestFieldAnnotations(t *testing.T) {
+	service := &deadlineUserService{}
+	cli, srv := goosetest.Start(t, UserService(service), AppendUserHttpRoute, NewUserHttpClient)
+
+	if _, err := cli.ListUser(context.Background(), &ListUserRequest{
+		PageNum:    1,
+		Tenant:     "acme",
+		ApiVersion: proto.Int32(2),
+		Session:    "abc",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	request := srv.LastExchange().Request
+	if request.Header.Get("X-Tenant-Id") != "acme" || request.Header.Get("X-Api-Version") != "2" {
+		t.Fatalf("expected the fields to be sent as headers, got %v", request.Header)
+	}
+	if cookie, err := request.Cookie("session"); err != nil || cookie.Value != "abc" {
+		t.Fatalf("expected the session field to be sent as a cookie, got %v", request.Header)
+	}
+	if request.URL.Query().Has("tenant") || request.URL.Query().Has("session") {
+		t.Fatalf("expected the header and cookie fields not to be sent as queries, got %s", request.URL.RawQuery)
+	}
+	got := service.listUser
+	if got.GetPageNum() != 1 || got.GetTenant() != "acme" || got.GetApiVersion() != 2 || got.GetSession() != "abc" {
+		t.Fatalf("unexpected decoded request: %v", got)
+	}
+
+	// unset fields are not sent
+	if _, err := cli.ListUser(context.Background(), &ListUserRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	request = srv.LastExchange().Request
+	if _, ok := request.Header["X-Api-Version"]; ok || request.Header.Get("Cookie") != "" {
+		t.Fatalf("expected no header and cookie for unset fields, got %v", request.Header)
+	}
+	if service.listUser.ApiVersion != nil {
+		t.Fatalf("expected the optional field to stay unset, got %v", service.listUser)
+	}
+
+	// the header fields are left out of a "*" body
+	create := &CreateUserRequest{Name: "jerry", IdempotencyKey: "key-1"}
+	if _, err := cli.CreateUser(context.Background(), create); err != nil {
+		t.Fatal(err)
+	}
+	exchange := srv.LastExchange()
+	if exchange.Request.Header.Get("Idempotency-Key") != "key-1" {
+		t.Fatalf("expected the idempotency key to be sent as a header, got %v", exchange.Request.Header)
+	}
+	if strings.Contains(string(exchange.RequestBody), "key-1") {
+		t.Fatalf("expected the idempotency key not to be sent in the body, got %s", exchange.RequestBody)
+	}
+	if create.GetIdempotencyKey() != "key-1" {
+		t.Fatalf("expected the request not to be modified, got %v", create)
+	}
+	if service.createUser.GetName() != "jerry" || service.createUser.GetIdempotencyKey() != "key-1" {
+		t.Fatalf("unexpected decoded request: %v", service.createUser)
+	}
+
+	// the header replaces the field of a body sent by other clients
+	request, err := http.NewRequest(http.MethodPost, srv.URL+"/v1/user", strings.NewReader(`{"name":"jerry","idempotencyKey":"key-body"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Idempotency-Key", "key-2")
+	response, err := srv.Client().Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if service.createUser.GetIdempotencyKey() != "key-2" {
+		t.Fatalf("expected the idempotency key of the header, got %v", service.createUser)
+	}
+}
+
+func TestFieldAnnotationsInvalidHeader(t *testing.T) {
+	_, srv := goosetest.Start(t, UserService(&MockUserService{}), AppendUserHttpRoute, NewUserHttpClient)
+	request, err := http.NewRequest(http.MethodGet, srv.URL+"/v1/users", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("X-Api-Version", "latest")
+	response, err := srv.Client().Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	// malformed headers are reported as malformed queries are
+	if response.StatusCode == http.StatusOK {
+		t.Fatal("expected the invalid header to be rejected")
+	}
+}
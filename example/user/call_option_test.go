@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/soyacen/goose/client"
+	"github.com/soyacen/goose/goosetest"
+)
+
+func TestCallOptions(t *testing.T) {
+	var served string
+	cli, _ := goosetest.Start(t, UserService(&MockUserService{}), AppendUserHttpRoute, NewUserHttpClient,
+		goosetest.ServerMiddlewares(func(response http.ResponseWriter, request *http.Request, invoker http.HandlerFunc) {
+			served = request.Header.Get("X-Trace")
+			invoker(response, request)
+		}),
+	)
+
+	var header http.Header
+	var statusCode int
+	var called bool
+	resp, err := cli.GetUser(context.Background(), &GetUserRequest{Id: 7},
+		client.RequestHeader("X-Trace", "trace-1"),
+		client.ResponseHeader(&header),
+		client.StatusCode(&statusCode),
+		client.CallMiddlewares(func(cli *http.Client, request *http.Request, invoker client.Invoker) (*http.Response, error) {
+			called = true
+			return invoker(cli, request)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetItem().GetId() != 7 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	if served != "trace-1" {
+		t.Fatalf("expected the call header to reach the server, got %q", served)
+	}
+	if statusCode != http.StatusOK || header.Get("Content-Type") == "" {
+		t.Fatalf("unexpected response status %d and headers %v", statusCode, header)
+	}
+	if !called {
+		t.Fatal("expected the call middleware to be called")
+	}
+}
+
+func TestCallTarget(t *testing.T) {
+	_, srv := goosetest.Start(t, UserService(&MockUserService{}), AppendUserHttpRoute, NewUserHttpClient)
+	// the client targets an unreachable server, the call is sent to the test server
+	cli := NewUserHttpClient("http://127.0.0.1:0", client.Client(srv.Client()))
+	resp, err := cli.GetUser(context.Background(), &GetUserRequest{Id: 7}, client.Target(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetItem().GetId() != 7 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	if srv.LastExchange() == nil {
+		t.Fatal("expected the call to reach the test server")
+	}
+}
@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/soyacen/goose/client"
+	"github.com/soyacen/goose/goosetest"
+)
+
+func TestGoosetest(t *testing.T) {
+	var served string
+	cli, srv := goosetest.Start(t, UserService(&MockUserService{}), AppendUserHttpRoute, NewUserHttpClient,
+		goosetest.ServerMiddlewares(func(response http.ResponseWriter, request *http.Request, invoker http.HandlerFunc) {
+			served = request.Header.Get("X-Trace")
+			invoker(response, request)
+		}),
+		goosetest.ClientMiddlewares(func(cli *http.Client, request *http.Request, invoker client.Invoker) (*http.Response, error) {
+			request.Header.Set("X-Trace", "trace-1")
+			return invoker(cli, request)
+		}),
+	)
+
+	resp, err := cli.GetUser(context.Background(), &GetUserRequest{Id: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetItem().GetId() != 7 {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	if served != "trace-1" {
+		t.Fatalf("expected the client middleware header to reach the server, got %q", served)
+	}
+
+	exchange := srv.LastExchange()
+	if exchange == nil || exchange.Request.Method != http.MethodGet || exchange.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected exchange: %+v", exchange)
+	}
+	if len(exchange.ResponseBody) == 0 {
+		t.Fatal("expected a captured response body")
+	}
+}
@@ -76,7 +76,7 @@ type oaSchema struct {
 	Items                *oaSchema           `json:"items,omitempty"`
 	Required             []string            `json:"required,omitempty"`
 	Ref                  string              `json:"$ref,omitempty"`
-	Enum                 []string            `json:"enum,omitempty"`
+	Enum                 []any               `json:"enum,omitempty"`
 	Nullable             bool                `json:"nullable,omitempty"`
 	AdditionalProperties *oaSchema           `json:"additionalProperties,omitempty"`
 }
@@ -130,7 +130,11 @@ func exampleValue(fieldName string, schema *oaSchema, components *oaComponents)
 func exampleString(fieldName string, schema *oaSchema) string {
 	// Use enum if available
 	if len(schema.Enum) > 0 {
-		return schema.Enum[0]
+		return fmt.Sprint(schema.Enum[0])
+	}
+	if schema.Format == "int64" || schema.Format == "uint64" {
+		// protojson writes 64-bit integers as strings
+		return "1"
 	}
 
 	switch {
@@ -151,7 +155,12 @@ func exampleString(fieldName string, schema *oaSchema) string {
 	}
 }
 
-func exampleInt(fieldName string, _ *oaSchema) int64 {
+func exampleInt(fieldName string, schema *oaSchema) int64 {
+	if len(schema.Enum) > 0 {
+		if value, ok := schema.Enum[0].(float64); ok {
+			return int64(value)
+		}
+	}
 	switch {
 	case strings.Contains(fieldName, "pageNum") || strings.Contains(fieldName, "page_num"):
 		return 1
@@ -525,18 +534,18 @@ func TestOpenAPIContent(t *testing.T) {
 		foundPageNum := false
 		foundPageSize := false
 		for _, p := range listOp.Parameters {
-			if p.In == "query" && p.Name == "pageNum" {
+			if p.In == "query" && p.Name == "page_num" {
 				foundPageNum = true
 			}
-			if p.In == "query" && p.Name == "pageSize" {
+			if p.In == "query" && p.Name == "page_size" {
 				foundPageSize = true
 			}
 		}
 		if !foundPageNum {
-			t.Error("GET /v1/users missing query parameter 'pageNum'")
+			t.Error("GET /v1/users missing query parameter 'page_num'")
 		}
 		if !foundPageSize {
-			t.Error("GET /v1/users missing query parameter 'pageSize'")
+			t.Error("GET /v1/users missing query parameter 'page_size'")
 		}
 	}
 }
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/soyacen/goose"
+	"github.com/soyacen/goose/client"
+	"github.com/soyacen/goose/goosetest"
+)
+
+// createdUserService answers CreateUser with 201 Created and the location of the user.
+type createdUserService struct {
+	MockUserService
+}
+
+func (s *createdUserService) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+	goose.SetStatusCode(ctx, http.StatusCreated)
+	goose.SetResponseHeader(ctx, "Location", "/v1/user/1")
+	goose.AddResponseHeader(ctx, "Set-Cookie", "session=1")
+	return s.MockUserService.CreateUser(ctx, req)
+}
+
+func TestResponseMetadata(t *testing.T) {
+	cli, _ := goosetest.Start(t, UserService(&createdUserService{}), AppendUserHttpRoute, NewUserHttpClient)
+
+	var header http.Header
+	var statusCode int
+	resp, err := cli.CreateUser(context.Background(), &CreateUserRequest{Name: "jerry"},
+		client.ResponseHeader(&header), client.StatusCode(&statusCode))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetItem().GetName() != "jerry" {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	if statusCode != http.StatusCreated {
+		t.Fatalf("expected status code %d, got %d", http.StatusCreated, statusCode)
+	}
+	if header.Get("Location") != "/v1/user/1" || header.Get("Set-Cookie") != "session=1" {
+		t.Fatalf("unexpected response headers: %v", header)
+	}
+}
@@ -7,6 +7,7 @@
 package user
 
 import (
+	_ "github.com/soyacen/goose/annotations"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -75,10 +76,12 @@ func (x *UserItem) GetName() string {
 }
 
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The key the client retries the creation with.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateUserRequest) Reset() {
@@ -118,6 +121,13 @@ func (x *CreateUserRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Item          *UserItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
@@ -547,9 +557,15 @@ func (x *GetUserResponse) GetItem() *UserItem {
 }
 
 type ListUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageNum       int64                  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize      int64                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageNum  int64                  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize int64                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The tenant the users belong to.
+	Tenant string `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// The version of the API the client speaks.
+	ApiVersion *int32 `protobuf:"varint,4,opt,name=api_version,json=apiVersion,proto3,oneof" json:"api_version,omitempty"`
+	// The session of the caller.
+	Session       string `protobuf:"bytes,5,opt,name=session,proto3" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -598,6 +614,27 @@ func (x *ListUserRequest) GetPageSize() int64 {
 	return 0
 }
 
+func (x *ListUserRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetApiVersion() int32 {
+	if x != nil && x.ApiVersion != nil {
+		return *x.ApiVersion
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
 type ListUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageNum       int64                  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
@@ -662,12 +699,14 @@ var File_example_user_user_proto protoreflect.FileDescriptor
 
 const file_example_user_user_proto_rawDesc = "" +
 	"\n" +
-	"\x17example/user/user.proto\x12\x19leo.goose.example.user.v1\x1a\x1cgoogle/api/annotations.proto\".\n" +
+	"\x17example/user/user.proto\x12\x19leo.goose.example.user.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x17goose/annotations.proto\".\n" +
 	"\bUserItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"'\n" +
-	"\x11CreateUserRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"M\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"l\n" +
+	"\x11CreateUserRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12>\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tB\x15\xc2\xf3\x18\x11\n" +
+	"\x0fIdempotency-KeyR\x0eidempotencyKey\"M\n" +
 	"\x12CreateUserResponse\x127\n" +
 	"\x04item\x18\x01 \x01(\v2#.leo.goose.example.user.v1.UserItemR\x04item\"#\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
@@ -689,25 +728,33 @@ const file_example_user_user_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"J\n" +
 	"\x0fGetUserResponse\x127\n" +
-	"\x04item\x18\x01 \x01(\v2#.leo.goose.example.user.v1.UserItemR\x04item\"I\n" +
+	"\x04item\x18\x01 \x01(\v2#.leo.goose.example.user.v1.UserItemR\x04item\"\xe8\x01\n" +
 	"\x0fListUserRequest\x12\x19\n" +
 	"\bpage_num\x18\x01 \x01(\x03R\apageNum\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x03R\bpageSize\"\x83\x01\n" +
+	"\tpage_size\x18\x02 \x01(\x03R\bpageSize\x12)\n" +
+	"\x06tenant\x18\x03 \x01(\tB\x11\xc2\xf3\x18\r\n" +
+	"\vX-Tenant-IdR\x06tenant\x129\n" +
+	"\vapi_version\x18\x04 \x01(\x05B\x13\xc2\xf3\x18\x0f\n" +
+	"\rX-Api-VersionH\x00R\n" +
+	"apiVersion\x88\x01\x01\x12'\n" +
+	"\asession\x18\x05 \x01(\tB\r\xc2\xf3\x18\t\x12\asessionR\asessionB\x0e\n" +
+	"\f_api_version\"\x83\x01\n" +
 	"\x10ListUserResponse\x12\x19\n" +
 	"\bpage_num\x18\x01 \x01(\x03R\apageNum\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x03R\bpageSize\x127\n" +
-	"\x04list\x18\x03 \x03(\v2#.leo.goose.example.user.v1.UserItemR\x04list2\x89\x06\n" +
-	"\x04User\x12~\n" +
+	"\x04list\x18\x03 \x03(\v2#.leo.goose.example.user.v1.UserItemR\x04list2\xc2\x06\n" +
+	"\x04User\x12\x85\x01\n" +
 	"\n" +
-	"CreateUser\x12,.leo.goose.example.user.v1.CreateUserRequest\x1a-.leo.goose.example.user.v1.CreateUserResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/user\x12\x80\x01\n" +
+	"CreateUser\x12,.leo.goose.example.user.v1.CreateUserRequest\x1a-.leo.goose.example.user.v1.CreateUserResponse\"\x1a\xc2\xf3\x18\x03\b\xc9\x01\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/user\x12\x80\x01\n" +
 	"\n" +
-	"DeleteUser\x12,.leo.goose.example.user.v1.DeleteUserRequest\x1a-.leo.goose.example.user.v1.DeleteUserResponse\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/user/{id}\x12\x83\x01\n" +
+	"DeleteUser\x12,.leo.goose.example.user.v1.DeleteUserRequest\x1a-.leo.goose.example.user.v1.DeleteUserResponse\"\x15\x82\xd3\xe4\x93\x02\x0f*\r/v1/user/{id}\x12\x89\x01\n" +
 	"\n" +
-	"ModifyUser\x12,.leo.goose.example.user.v1.ModifyUserRequest\x1a-.leo.goose.example.user.v1.ModifyUserResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\x1a\r/v1/user/{id}\x12\x86\x01\n" +
+	"ModifyUser\x12,.leo.goose.example.user.v1.ModifyUserRequest\x1a-.leo.goose.example.user.v1.ModifyUserResponse\"\x1e\xc2\xf3\x18\x02 \x01\x82\xd3\xe4\x93\x02\x12:\x01*\x1a\r/v1/user/{id}\x12\x86\x01\n" +
 	"\n" +
-	"UpdateUser\x12,.leo.goose.example.user.v1.UpdateUserRequest\x1a-.leo.goose.example.user.v1.UpdateUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x04item2\r/v1/user/{id}\x12w\n" +
-	"\aGetUser\x12).leo.goose.example.user.v1.GetUserRequest\x1a*.leo.goose.example.user.v1.GetUserResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/user/{id}\x12v\n" +
-	"\bListUser\x12*.leo.goose.example.user.v1.ListUserRequest\x1a+.leo.goose.example.user.v1.ListUserResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/usersB/Z-github.com/soyacen/goose/example/user/v1;userb\x06proto3"
+	"UpdateUser\x12,.leo.goose.example.user.v1.UpdateUserRequest\x1a-.leo.goose.example.user.v1.UpdateUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x04item2\r/v1/user/{id}\x12\x99\x01\n" +
+	"\aGetUser\x12).leo.goose.example.user.v1.GetUserRequest\x1a*.leo.goose.example.user.v1.GetUserResponse\"7\xc2\xf3\x18\f\x1a\n" +
+	"max-age=60\x82\xd3\xe4\x93\x02!Z\x10\x12\x0e/v1/users/{id}\x12\r/v1/user/{id}\x12~\n" +
+	"\bListUser\x12*.leo.goose.example.user.v1.ListUserRequest\x1a+.leo.goose.example.user.v1.ListUserResponse\"\x19\xc2\xf3\x18\x04\x12\x02\b\x05\x82\xd3\xe4\x93\x02\v\x12\t/v1/usersB/Z-github.com/soyacen/goose/example/user/v1;userb\x06proto3"
 
 var (
 	file_example_user_user_proto_rawDescOnce sync.Once
@@ -767,6 +814,7 @@ func file_example_user_user_proto_init() {
 	if File_example_user_user_proto != nil {
 		return
 	}
+	file_example_user_user_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -13,21 +13,20 @@ import (
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	http "net/http"
 	url "net/url"
+	time "time"
 )
 
 type UserService interface {
 	CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error)
 	DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error)
+	// Deprecated: Do not use.
 	ModifyUser(ctx context.Context, req *ModifyUserRequest) (*ModifyUserResponse, error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UpdateUserResponse, error)
 	GetUser(ctx context.Context, req *GetUserRequest) (*GetUserResponse, error)
 	ListUser(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error)
 }
 
-func AppendUserHttpRoute(router *http.ServeMux, service UserService, opts ...server.Option) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendUserHttpRoute[R goose.Router](router R, service UserService, opts ...server.Option) R {
 	options := server.NewOptions(opts...)
 	handler := userHandler{
 		service: service,
@@ -42,16 +41,22 @@ func AppendUserHttpRoute(router *http.ServeMux, service UserService, opts ...ser
 		shouldFailFast:          options.ShouldFailFast(),
 		onValidationErrCallback: options.OnValidationErrCallback(),
 		middleware:              server.Chain(options.Middlewares()...),
-	}
-	router.Handle("POST /v1/user", http.HandlerFunc(handler.CreateUser))
-	router.Handle("DELETE /v1/user/{id}", http.HandlerFunc(handler.DeleteUser))
-	router.Handle("PUT /v1/user/{id}", http.HandlerFunc(handler.ModifyUser))
-	router.Handle("PATCH /v1/user/{id}", http.HandlerFunc(handler.UpdateUser))
-	router.Handle("GET /v1/user/{id}", http.HandlerFunc(handler.GetUser))
-	router.Handle("GET /v1/users", http.HandlerFunc(handler.ListUser))
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("POST "+options.Prefix()+"/v1/user", http.HandlerFunc(handler.CreateUser))
+	router.Handle("DELETE "+options.Prefix()+"/v1/user/{id}", http.HandlerFunc(handler.DeleteUser))
+	router.Handle("PUT "+options.Prefix()+"/v1/user/{id}", http.HandlerFunc(handler.ModifyUser))
+	router.Handle("PATCH "+options.Prefix()+"/v1/user/{id}", http.HandlerFunc(handler.UpdateUser))
+	router.Handle("GET "+options.Prefix()+"/v1/user/{id}", http.HandlerFunc(handler.GetUser))
+	router.Handle("GET "+options.Prefix()+"/v1/users/{id}", http.HandlerFunc(handler.GetUser_1))
+	router.Handle("GET "+options.Prefix()+"/v1/users", http.HandlerFunc(handler.ListUser))
 	return router
 }
 
+func NewUserHandler(service UserService, opts ...server.Option) http.Handler {
+	return AppendUserHttpRoute(http.NewServeMux(), service, opts...)
+}
+
 type userHandler struct {
 	service                 UserService
 	decoder                 userRequestDecoder
@@ -60,16 +65,20 @@ type userHandler struct {
 	shouldFailFast          bool
 	onValidationErrCallback goose.OnValidationErrCallback
 	middleware              server.Middleware
+	prefix                  string
 }
 
 func (h userHandler) CreateUser(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), &goose.ResponseMetadata{
+			StatusCode: 201,
+		})
 		req, err := h.decoder.CreateUser(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -84,17 +93,18 @@ func (h userHandler) CreateUser(response http.ResponseWriter, request *http.Requ
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_CreateUser_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_CreateUser_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h userHandler) DeleteUser(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.DeleteUser(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -109,17 +119,18 @@ func (h userHandler) DeleteUser(response http.ResponseWriter, request *http.Requ
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_DeleteUser_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_DeleteUser_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h userHandler) ModifyUser(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.ModifyUser(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -134,17 +145,18 @@ func (h userHandler) ModifyUser(response http.ResponseWriter, request *http.Requ
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_ModifyUser_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_ModifyUser_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h userHandler) UpdateUser(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
 		req, err := h.decoder.UpdateUser(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -159,17 +171,20 @@ func (h userHandler) UpdateUser(response http.ResponseWriter, request *http.Requ
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_UpdateUser_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_UpdateUser_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h userHandler) GetUser(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx := goose.InjectResponseMetadata(request.Context(), &goose.ResponseMetadata{
+			Header: http.Header{"Cache-Control": {"max-age=60"}},
+		})
 		req, err := h.decoder.GetUser(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -184,17 +199,48 @@ func (h userHandler) GetUser(response http.ResponseWriter, request *http.Request
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_GetUser_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_GetUser_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+func (h userHandler) GetUser_1(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), &goose.ResponseMetadata{
+			Header: http.Header{"Cache-Control": {"max-age=60"}},
+		})
+		req, err := h.decoder.GetUser_1(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.GetUser(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.GetUser_1(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_GetUser_1_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h userHandler) ListUser(response http.ResponseWriter, request *http.Request) {
 	invoke := func(response http.ResponseWriter, request *http.Request) {
-		ctx := request.Context()
+		ctx, cancel := context.WithTimeout(request.Context(), 5*time.Second)
+		defer cancel()
+		ctx = goose.InjectResponseMetadata(ctx, new(goose.ResponseMetadata))
 		req, err := h.decoder.ListUser(ctx, request)
 		if err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
 		}
+		goose.ClearOutputOnly(req)
 		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
 			h.errorEncoder(ctx, err, response)
 			return
@@ -209,7 +255,7 @@ func (h userHandler) ListUser(response http.ResponseWriter, request *http.Reques
 			return
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_ListUser_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_user_v1_User_ListUser_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 type userRequestDecoder struct {
@@ -228,6 +274,14 @@ func (decoder userRequestDecoder) CreateUser(ctx context.Context, request *http.
 	if err := server.DecodeRequest(ctx, request, req, decoder.unmarshalOptions); err != nil {
 		return nil, err
 	}
+	headers := goose.FormFromHeader(request, "Idempotency-Key")
+	var headerErr error
+	if headers.Has("Idempotency-Key") {
+		req.IdempotencyKey = headers.Get("Idempotency-Key")
+	}
+	if headerErr != nil {
+		return nil, headerErr
+	}
 	return req, nil
 }
 func (decoder userRequestDecoder) DeleteUser(ctx context.Context, request *http.Request) (*DeleteUserRequest, error) {
@@ -307,6 +361,23 @@ func (decoder userRequestDecoder) GetUser(ctx context.Context, request *http.Req
 	}
 	return req, nil
 }
+func (decoder userRequestDecoder) GetUser_1(ctx context.Context, request *http.Request) (*GetUserRequest, error) {
+	req := &GetUserRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := goose.FormFromPath(request, "id")
+	var varErr error
+	req.Id, varErr = goose.GetForm[int64](varErr, vars, "id", goose.GetInt)
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
 func (decoder userRequestDecoder) ListUser(ctx context.Context, request *http.Request) (*ListUserRequest, error) {
 	req := &ListUserRequest{}
 	ok, err := server.CustomDecodeRequest(ctx, request, req)
@@ -316,13 +387,32 @@ func (decoder userRequestDecoder) ListUser(ctx context.Context, request *http.Re
 	if ok {
 		return req, nil
 	}
-	queries := request.URL.Query()
+	queries := goose.FormFromQuery(request)
 	var queryErr error
 	req.PageNum, queryErr = goose.GetForm[int64](queryErr, queries, "page_num", goose.GetInt)
 	req.PageSize, queryErr = goose.GetForm[int64](queryErr, queries, "page_size", goose.GetInt)
 	if queryErr != nil {
 		return nil, queryErr
 	}
+	headers := goose.FormFromHeader(request, "X-Tenant-Id", "X-Api-Version")
+	var headerErr error
+	if headers.Has("X-Tenant-Id") {
+		req.Tenant = headers.Get("X-Tenant-Id")
+	}
+	if headers.Has("X-Api-Version") {
+		req.ApiVersion, headerErr = goose.GetForm[*int32](headerErr, headers, "X-Api-Version", goose.GetIntPtr)
+	}
+	if headerErr != nil {
+		return nil, headerErr
+	}
+	cookies := goose.FormFromCookie(request, "session")
+	var cookieErr error
+	if cookies.Has("session") {
+		req.Session = cookies.Get("session")
+	}
+	if cookieErr != nil {
+		return nil, cookieErr
+	}
 	return req, nil
 }
 
@@ -346,11 +436,14 @@ func (encoder userResponseEncoder) UpdateUser(ctx context.Context, w http.Respon
 func (encoder userResponseEncoder) GetUser(ctx context.Context, w http.ResponseWriter, resp *GetUserResponse) error {
 	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
 }
+func (encoder userResponseEncoder) GetUser_1(ctx context.Context, w http.ResponseWriter, resp *GetUserResponse) error {
+	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
+}
 func (encoder userResponseEncoder) ListUser(ctx context.Context, w http.ResponseWriter, resp *ListUserResponse) error {
 	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
 }
 
-func NewUserHttpClient(target string, opts ...client.Option) UserService {
+func NewUserHttpClient(target string, opts ...client.Option) UserHttpClient {
 	options := client.NewOptions(opts...)
 	client := &userHttpClient{
 		client: options.Client(),
@@ -371,6 +464,18 @@ func NewUserHttpClient(target string, opts ...client.Option) UserService {
 	return client
 }
 
+type UserHttpClient interface {
+	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...client.CallOption) (*CreateUserResponse, error)
+	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...client.CallOption) (*DeleteUserResponse, error)
+	// Deprecated: Do not use.
+	ModifyUser(ctx context.Context, req *ModifyUserRequest, opts ...client.CallOption) (*ModifyUserResponse, error)
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...client.CallOption) (*UpdateUserResponse, error)
+	GetUser(ctx context.Context, req *GetUserRequest, opts ...client.CallOption) (*GetUserResponse, error)
+	ListUser(ctx context.Context, req *ListUserRequest, opts ...client.CallOption) (*ListUserResponse, error)
+}
+
+var _ UserHttpClient = (*userHttpClient)(nil)
+
 type userHttpClient struct {
 	client                  *http.Client
 	encoder                 userRequestEncoder
@@ -380,15 +485,17 @@ type userHttpClient struct {
 	middleware              client.Middleware
 }
 
-func (c *userHttpClient) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
+func (c *userHttpClient) CreateUser(ctx context.Context, req *CreateUserRequest, opts ...client.CallOption) (*CreateUserResponse, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.CreateUser(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.CreateUser(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_CreateUser_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_CreateUser_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -399,15 +506,17 @@ func (c *userHttpClient) CreateUser(ctx context.Context, req *CreateUserRequest)
 	return resp, nil
 }
 
-func (c *userHttpClient) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
+func (c *userHttpClient) DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...client.CallOption) (*DeleteUserResponse, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.DeleteUser(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.DeleteUser(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_DeleteUser_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_DeleteUser_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -418,15 +527,17 @@ func (c *userHttpClient) DeleteUser(ctx context.Context, req *DeleteUserRequest)
 	return resp, nil
 }
 
-func (c *userHttpClient) ModifyUser(ctx context.Context, req *ModifyUserRequest) (*ModifyUserResponse, error) {
+func (c *userHttpClient) ModifyUser(ctx context.Context, req *ModifyUserRequest, opts ...client.CallOption) (*ModifyUserResponse, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.ModifyUser(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.ModifyUser(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_ModifyUser_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_ModifyUser_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -437,15 +548,17 @@ func (c *userHttpClient) ModifyUser(ctx context.Context, req *ModifyUserRequest)
 	return resp, nil
 }
 
-func (c *userHttpClient) UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UpdateUserResponse, error) {
+func (c *userHttpClient) UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...client.CallOption) (*UpdateUserResponse, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.UpdateUser(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.UpdateUser(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_UpdateUser_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_UpdateUser_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -456,15 +569,17 @@ func (c *userHttpClient) UpdateUser(ctx context.Context, req *UpdateUserRequest)
 	return resp, nil
 }
 
-func (c *userHttpClient) GetUser(ctx context.Context, req *GetUserRequest) (*GetUserResponse, error) {
+func (c *userHttpClient) GetUser(ctx context.Context, req *GetUserRequest, opts ...client.CallOption) (*GetUserResponse, error) {
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.GetUser(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.GetUser(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_GetUser_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_GetUser_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -475,15 +590,19 @@ func (c *userHttpClient) GetUser(ctx context.Context, req *GetUserRequest) (*Get
 	return resp, nil
 }
 
-func (c *userHttpClient) ListUser(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error) {
+func (c *userHttpClient) ListUser(ctx context.Context, req *ListUserRequest, opts ...client.CallOption) (*ListUserResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
 	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
 		return nil, err
 	}
-	request, err := c.encoder.ListUser(ctx, req)
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.ListUser(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_ListUser_Desc.RouteInfo)
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_user_v1_User_ListUser_Desc.RouteInfo, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -511,11 +630,14 @@ func (encoder *userRequestEncoder) CreateUser(ctx context.Context, req *CreateUs
 	method := "POST"
 	header := http.Header{}
 	var body bytes.Buffer
-	if err := client.EncodeMessage(ctx, req, header, &body, encoder.marshalOptions); err != nil {
+	if err := client.EncodeMessage(ctx, client.OmitFields(req, "idempotency_key"), header, &body, encoder.marshalOptions); err != nil {
 		return nil, err
 	}
 	path := "/v1/user"
 	target.Path = path
+	headers := url.Values{}
+	headers["Idempotency-Key"] = append(headers["Idempotency-Key"], req.GetIdempotencyKey())
+	goose.AddHeaderForm(header, headers)
 	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
 	if err != nil {
 		return nil, err
@@ -663,6 +785,15 @@ func (encoder *userRequestEncoder) ListUser(ctx context.Context, req *ListUserRe
 	queries["page_num"] = append(queries["page_num"], goose.FormatInt(req.GetPageNum(), 10))
 	queries["page_size"] = append(queries["page_size"], goose.FormatInt(req.GetPageSize(), 10))
 	target.RawQuery = queries.Encode()
+	headers := url.Values{}
+	headers["X-Tenant-Id"] = append(headers["X-Tenant-Id"], req.GetTenant())
+	if req.ApiVersion != nil {
+		headers["X-Api-Version"] = append(headers["X-Api-Version"], goose.FormatInt(req.GetApiVersion(), 10))
+	}
+	goose.AddHeaderForm(header, headers)
+	cookies := url.Values{}
+	cookies["session"] = append(cookies["session"], req.GetSession())
+	goose.AddCookieForm(header, cookies)
 	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
 	if err != nil {
 		return nil, err
@@ -783,6 +914,14 @@ var _leo_goose_example_user_v1_User_GetUser_Desc = &goose.Desc{
 	},
 }
 
+var _leo_goose_example_user_v1_User_GetUser_1_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/users/{id}",
+		FullMethod: "/leo.goose.example.user.v1.User/GetUser",
+	},
+}
+
 var _leo_goose_example_user_v1_User_ListUser_Desc = &goose.Desc{
 	RouteInfo: &goose.RouteInfo{
 		HttpMethod: "GET",
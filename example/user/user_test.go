@@ -4,9 +4,14 @@ import (
 	"context"
 	errors "errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/soyacen/goose"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // ---- Mock Service ----
@@ -54,7 +59,7 @@ func runServer(server *http.Server, port int) {
 	}
 }
 
-func newClient(port int) UserService {
+func newClient(port int) UserHttpClient {
 	return NewUserHttpClient(fmt.Sprintf("http://localhost:%d", port))
 }
 
@@ -76,6 +81,28 @@ func TestCreateUser(t *testing.T) {
 	}
 }
 
+func TestCreateUserRequiredName(t *testing.T) {
+	server := new(http.Server)
+	defer server.Shutdown(context.Background())
+	go runServer(server, 8089)
+	time.Sleep(1 * time.Second)
+
+	response, err := http.Post("http://localhost:8089/v1/user", "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status code is %d, name is required", response.StatusCode)
+	}
+
+	_, err = newClient(8089).CreateUser(context.Background(), &CreateUserRequest{})
+	var behaviorErr *goose.FieldBehaviorError
+	if !errors.As(err, &behaviorErr) || behaviorErr.Fields[0] != "name" {
+		t.Fatalf("expected the client to reject the missing name, got %v", err)
+	}
+}
+
 func TestDeleteUser(t *testing.T) {
 	server := new(http.Server)
 	defer server.Shutdown(context.Background())
@@ -140,6 +167,36 @@ func TestGetUser(t *testing.T) {
 	}
 }
 
+func TestGetUserAdditionalBinding(t *testing.T) {
+	server := new(http.Server)
+	defer server.Shutdown(context.Background())
+	go runServer(server, 8087)
+	time.Sleep(1 * time.Second)
+
+	response, err := http.Get("http://localhost:8087/v1/users/3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("status code is %d", response.StatusCode)
+	}
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := &GetUserResponse{}
+	if err := protojson.Unmarshal(data, resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetItem().GetName() != "bob" || resp.GetItem().GetId() != 3 {
+		t.Fatal("resp is not equal")
+	}
+	if _leo_goose_example_user_v1_User_GetUser_1_Desc.RouteInfo.Pattern != "/v1/users/{id}" {
+		t.Fatalf("unexpected pattern %s", _leo_goose_example_user_v1_User_GetUser_1_Desc.RouteInfo.Pattern)
+	}
+}
+
 func TestListUser(t *testing.T) {
 	server := new(http.Server)
 	defer server.Shutdown(context.Background())
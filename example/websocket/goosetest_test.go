@@ -0,0 +1,50 @@
+package websocket
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/soyacen/goose/goosetest"
+)
+
+func TestGoosetestStream(t *testing.T) {
+	svc := new(MockWebsocketStreamServer)
+	svc.ServerStreamMock.On(&Request{Name: "bob"}, []*Response{{Message: "hi bob"}, {Message: "bye bob"}}, nil)
+
+	var upgraded bool
+	cli, srv := goosetest.StartStream(t, WebsocketStreamServer(svc), AppendWebsocketWebsocketRoute, NewWebsocketStreamClient,
+		goosetest.ServerMiddlewares(func(response http.ResponseWriter, request *http.Request, invoker http.HandlerFunc) {
+			upgraded = request.Header.Get("Upgrade") == "websocket"
+			invoker(response, request)
+		}),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := cli.ServerStream(ctx, &Request{Name: "bob"})
+	if err != nil {
+		t.Fatalf("ServerStream failed: %v", err)
+	}
+	for _, message := range []string{"hi bob", "bye bob"} {
+		resp, err := stream.Recv()
+		if err != nil || resp.GetMessage() != message {
+			t.Fatalf("Recv = %v, %v; want %s", resp, err, message)
+		}
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+	if !upgraded {
+		t.Fatal("expected the server middleware to see the websocket upgrade")
+	}
+	if !svc.ServerStreamMock.CalledWith(&Request{Name: "bob"}) {
+		t.Fatalf("ServerStream not called with the request: %v", svc.ServerStreamMock.Calls())
+	}
+	if srv.WsURL != "ws://"+goosetest.Host {
+		t.Fatalf("unexpected websocket url: %s", srv.WsURL)
+	}
+}
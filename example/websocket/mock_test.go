@@ -0,0 +1,114 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/soyacen/goose/mock"
+)
+
+func TestMockServer(t *testing.T) {
+	svc := new(MockChatServer)
+	svc.CreateRoomMock.Return(&Room{Id: "1", Title: "general"}, nil)
+	svc.TalkMock.Return([]*Response{{Message: "welcome"}}, nil)
+
+	mux := NewChatHandler(svc)
+	srv := &http.Server{Addr: ":39085", Handler: mux}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.Errorf("server error: %v", err)
+		}
+	}()
+	defer srv.Shutdown(context.Background())
+	time.Sleep(200 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	room, err := NewChatHttpClient("http://localhost:39085").CreateRoom(ctx, &Room{Title: "general"})
+	if err != nil {
+		t.Fatalf("CreateRoom failed: %v", err)
+	}
+	if room.GetId() != "1" {
+		t.Fatalf("unexpected room: %v", room)
+	}
+	if !svc.CreateRoomMock.CalledWith(&Room{Title: "general"}) {
+		t.Fatalf("CreateRoom not called with the request: %v", svc.CreateRoomMock.Calls())
+	}
+
+	stream, err := NewChatStreamClient("ws://localhost:39085").Talk(ctx)
+	if err != nil {
+		t.Fatalf("Talk connect failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil || resp.GetMessage() != "welcome" {
+		t.Fatalf("Recv = %v, %v; want welcome", resp, err)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+	if svc.TalkMock.CallCount() != 1 {
+		t.Fatalf("expected 1 Talk call, got %d", svc.TalkMock.CallCount())
+	}
+
+	if _, err := svc.GetRoom(ctx, &GetRoomRequest{Id: "1"}); !errors.Is(err, mock.ErrNotStubbed) {
+		t.Fatalf("expected mock.ErrNotStubbed, got %v", err)
+	}
+}
+
+func TestMockClient(t *testing.T) {
+	ctx := context.Background()
+
+	var client ChatService = &MockChatService{
+		GetRoomFunc: func(ctx context.Context, req *GetRoomRequest) (*Room, error) {
+			return &Room{Id: req.GetId()}, nil
+		},
+	}
+	room, err := client.GetRoom(ctx, &GetRoomRequest{Id: "2"})
+	if err != nil || room.GetId() != "2" {
+		t.Fatalf("GetRoom = %v, %v; want room 2", room, err)
+	}
+
+	streamClient := new(MockWebsocketStreamClient)
+	streamClient.ServerStreamMock.On(&Request{Name: "bob"}, []*Response{{Message: "hi bob"}}, nil)
+	stream, err := streamClient.ServerStream(ctx, &Request{Name: "bob"})
+	if err != nil {
+		t.Fatalf("ServerStream failed: %v", err)
+	}
+	if resp, err := stream.Recv(); err != nil || resp.GetMessage() != "hi bob" {
+		t.Fatalf("Recv = %v, %v; want hi bob", resp, err)
+	}
+	if _, err := streamClient.ServerStream(ctx, &Request{Name: "alice"}); !errors.Is(err, mock.ErrNotStubbed) {
+		t.Fatalf("expected mock.ErrNotStubbed, got %v", err)
+	}
+
+	streamClient.ClientStreamMock.Return(&Response{Message: "received 2 messages"}, nil)
+	clientStream, err := streamClient.ClientStream(ctx)
+	if err != nil {
+		t.Fatalf("ClientStream failed: %v", err)
+	}
+	_ = clientStream.Send(&Request{Name: "a"})
+	_ = clientStream.Send(&Request{Name: "b"})
+	if resp, err := clientStream.CloseAndRecv(); err != nil || resp.GetMessage() != "received 2 messages" {
+		t.Fatalf("CloseAndRecv = %v, %v", resp, err)
+	}
+	if sent := clientStream.(*mock.ClientStream[*Request, *Response]).Sent(); len(sent) != 2 {
+		t.Fatalf("expected 2 sent requests, got %d", len(sent))
+	}
+}
+
+func TestServiceWithMockStream(t *testing.T) {
+	svc := &mockStreamService{logger: slog.Default()}
+	stream := mock.NewServerStream[*Request, *Response](context.Background(), &Request{Name: "a"}, &Request{Name: "b"})
+	if err := svc.ClientStream(stream); err != nil {
+		t.Fatalf("ClientStream failed: %v", err)
+	}
+	if sent := stream.Sent(); len(sent) != 1 || sent[0].GetMessage() != "received 2 messages" {
+		t.Fatalf("unexpected responses: %v", sent)
+	}
+}
@@ -110,6 +110,102 @@ func (x *Response) GetMessage() string {
 	return ""
 }
 
+type Room struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	mi := &file_example_websocket_websocket_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_example_websocket_websocket_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_example_websocket_websocket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Room) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Room) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GetRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomRequest) Reset() {
+	*x = GetRoomRequest{}
+	mi := &file_example_websocket_websocket_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomRequest) ProtoMessage() {}
+
+func (x *GetRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_websocket_websocket_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
+	return file_example_websocket_websocket_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 var File_example_websocket_websocket_proto protoreflect.FileDescriptor
 
 const file_example_websocket_websocket_proto_rawDesc = "" +
@@ -118,11 +214,22 @@ const file_example_websocket_websocket_proto_rawDesc = "" +
 	"\aRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"$\n" +
 	"\bResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x88\x03\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\",\n" +
+	"\x04Room\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\" \n" +
+	"\x0eGetRoomRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\x88\x03\n" +
 	"\tWebsocket\x12~\n" +
 	"\fClientStream\x12'.leo.goose.example.websocket.v1.Request\x1a(.leo.goose.example.websocket.v1.Response\"\x19\x82\xd3\xe4\x93\x02\x13\"\x11/ws/client-stream(\x01\x12~\n" +
 	"\fServerStream\x12'.leo.goose.example.websocket.v1.Request\x1a(.leo.goose.example.websocket.v1.Response\"\x19\x82\xd3\xe4\x93\x02\x13\"\x11/ws/server-stream0\x01\x12{\n" +
-	"\tBidStream\x12'.leo.goose.example.websocket.v1.Request\x1a(.leo.goose.example.websocket.v1.Response\"\x17\x82\xd3\xe4\x93\x02\x11\"\x0f/ws/bidi-stream(\x010\x01B9Z7github.com/soyacen/goose/example/websocket/v1;websocketb\x06proto3"
+	"\tBidStream\x12'.leo.goose.example.websocket.v1.Request\x1a(.leo.goose.example.websocket.v1.Response\"\x17\x82\xd3\xe4\x93\x02\x11\"\x0f/ws/bidi-stream(\x010\x012\xe0\x02\n" +
+	"\x04Chat\x12n\n" +
+	"\n" +
+	"CreateRoom\x12$.leo.goose.example.websocket.v1.Room\x1a$.leo.goose.example.websocket.v1.Room\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/rooms\x12w\n" +
+	"\aGetRoom\x12..leo.goose.example.websocket.v1.GetRoomRequest\x1a$.leo.goose.example.websocket.v1.Room\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/rooms/{id}\x12o\n" +
+	"\x04Talk\x12'.leo.goose.example.websocket.v1.Request\x1a(.leo.goose.example.websocket.v1.Response\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/talk(\x010\x01B9Z7github.com/soyacen/goose/example/websocket/v1;websocketb\x06proto3"
 
 var (
 	file_example_websocket_websocket_proto_rawDescOnce sync.Once
@@ -136,20 +243,28 @@ func file_example_websocket_websocket_proto_rawDescGZIP() []byte {
 	return file_example_websocket_websocket_proto_rawDescData
 }
 
-var file_example_websocket_websocket_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_example_websocket_websocket_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_example_websocket_websocket_proto_goTypes = []any{
-	(*Request)(nil),  // 0: leo.goose.example.websocket.v1.Request
-	(*Response)(nil), // 1: leo.goose.example.websocket.v1.Response
+	(*Request)(nil),        // 0: leo.goose.example.websocket.v1.Request
+	(*Response)(nil),       // 1: leo.goose.example.websocket.v1.Response
+	(*Room)(nil),           // 2: leo.goose.example.websocket.v1.Room
+	(*GetRoomRequest)(nil), // 3: leo.goose.example.websocket.v1.GetRoomRequest
 }
 var file_example_websocket_websocket_proto_depIdxs = []int32{
 	0, // 0: leo.goose.example.websocket.v1.Websocket.ClientStream:input_type -> leo.goose.example.websocket.v1.Request
 	0, // 1: leo.goose.example.websocket.v1.Websocket.ServerStream:input_type -> leo.goose.example.websocket.v1.Request
 	0, // 2: leo.goose.example.websocket.v1.Websocket.BidStream:input_type -> leo.goose.example.websocket.v1.Request
-	1, // 3: leo.goose.example.websocket.v1.Websocket.ClientStream:output_type -> leo.goose.example.websocket.v1.Response
-	1, // 4: leo.goose.example.websocket.v1.Websocket.ServerStream:output_type -> leo.goose.example.websocket.v1.Response
-	1, // 5: leo.goose.example.websocket.v1.Websocket.BidStream:output_type -> leo.goose.example.websocket.v1.Response
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	2, // 3: leo.goose.example.websocket.v1.Chat.CreateRoom:input_type -> leo.goose.example.websocket.v1.Room
+	3, // 4: leo.goose.example.websocket.v1.Chat.GetRoom:input_type -> leo.goose.example.websocket.v1.GetRoomRequest
+	0, // 5: leo.goose.example.websocket.v1.Chat.Talk:input_type -> leo.goose.example.websocket.v1.Request
+	1, // 6: leo.goose.example.websocket.v1.Websocket.ClientStream:output_type -> leo.goose.example.websocket.v1.Response
+	1, // 7: leo.goose.example.websocket.v1.Websocket.ServerStream:output_type -> leo.goose.example.websocket.v1.Response
+	1, // 8: leo.goose.example.websocket.v1.Websocket.BidStream:output_type -> leo.goose.example.websocket.v1.Response
+	2, // 9: leo.goose.example.websocket.v1.Chat.CreateRoom:output_type -> leo.goose.example.websocket.v1.Room
+	2, // 10: leo.goose.example.websocket.v1.Chat.GetRoom:output_type -> leo.goose.example.websocket.v1.Room
+	1, // 11: leo.goose.example.websocket.v1.Chat.Talk:output_type -> leo.goose.example.websocket.v1.Response
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -166,9 +281,9 @@ func file_example_websocket_websocket_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_example_websocket_websocket_proto_rawDesc), len(file_example_websocket_websocket_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_example_websocket_websocket_proto_goTypes,
 		DependencyIndexes: file_example_websocket_websocket_proto_depIdxs,
@@ -3,9 +3,13 @@
 package websocket
 
 import (
+	bytes "bytes"
 	context "context"
+	errors "errors"
 	websocket "github.com/coder/websocket"
 	goose "github.com/soyacen/goose"
+	client "github.com/soyacen/goose/client"
+	resolver "github.com/soyacen/goose/client/resolver"
 	server "github.com/soyacen/goose/server"
 	ws "github.com/soyacen/goose/ws"
 	protojson "google.golang.org/protobuf/encoding/protojson"
@@ -26,34 +30,28 @@ type WebsocketStreamClient interface {
 	BidStream(ctx context.Context) (ws.BidiStreamingClient[*Request, *Response], error)
 }
 
-func AppendWebsocketWebsocketRoute(
-	router *http.ServeMux,
-	service WebsocketStreamServer,
-	middleware server.Middleware,
-	marshalOpts protojson.MarshalOptions,
-	unmarshalOpts protojson.UnmarshalOptions,
-	acptOpts *websocket.AcceptOptions,
-	cfg *ws.ConnConfig,
-	logger *slog.Logger,
-) *http.ServeMux {
-	if router == nil {
-		router = http.NewServeMux()
-	}
+func AppendWebsocketWebsocketRoute[R goose.Router](router R, service WebsocketStreamServer, opts ...ws.Option) R {
+	options := ws.NewOptions(opts...)
 	handler := &websocketStreamHandler{
 		service:          service,
-		middleware:       middleware,
-		marshalOptions:   marshalOpts,
-		unmarshalOptions: unmarshalOpts,
-		acptOpts:         acptOpts,
-		cfg:              cfg,
-		logger:           logger,
-	}
-	router.Handle(_leo_goose_example_websocket_v1_Websocket_ClientStream_Desc.RouteInfo.Pattern, http.HandlerFunc(handler.ClientStream))
-	router.Handle(_leo_goose_example_websocket_v1_Websocket_ServerStream_Desc.RouteInfo.Pattern, http.HandlerFunc(handler.ServerStream))
-	router.Handle(_leo_goose_example_websocket_v1_Websocket_BidStream_Desc.RouteInfo.Pattern, http.HandlerFunc(handler.BidStream))
+		middleware:       server.Chain(options.Middlewares()...),
+		marshalOptions:   options.MarshalOptions(),
+		unmarshalOptions: options.UnmarshalOptions(),
+		acptOpts:         options.AcceptOptions(),
+		cfg:              options.ConnConfig(),
+		logger:           options.Logger(),
+		prefix:           options.Prefix(),
+	}
+	router.Handle(options.Prefix()+_leo_goose_example_websocket_v1_Websocket_ClientStream_Desc.RouteInfo.Pattern, http.HandlerFunc(handler.ClientStream))
+	router.Handle(options.Prefix()+_leo_goose_example_websocket_v1_Websocket_ServerStream_Desc.RouteInfo.Pattern, http.HandlerFunc(handler.ServerStream))
+	router.Handle(options.Prefix()+_leo_goose_example_websocket_v1_Websocket_BidStream_Desc.RouteInfo.Pattern, http.HandlerFunc(handler.BidStream))
 	return router
 }
 
+func NewWebsocketHandler(service WebsocketStreamServer, opts ...ws.Option) http.Handler {
+	return AppendWebsocketWebsocketRoute(http.NewServeMux(), service, opts...)
+}
+
 type websocketStreamHandler struct {
 	service          WebsocketStreamServer
 	middleware       server.Middleware
@@ -62,6 +60,7 @@ type websocketStreamHandler struct {
 	acptOpts         *websocket.AcceptOptions
 	cfg              *ws.ConnConfig
 	logger           *slog.Logger
+	prefix           string
 }
 
 func (h websocketStreamHandler) ClientStream(response http.ResponseWriter, request *http.Request) {
@@ -83,7 +82,7 @@ func (h websocketStreamHandler) ClientStream(response http.ResponseWriter, reque
 				"service", "Websocket", "method", "ClientStream", "error", err)
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Websocket_ClientStream_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Websocket_ClientStream_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h websocketStreamHandler) ServerStream(response http.ResponseWriter, request *http.Request) {
@@ -119,7 +118,7 @@ func (h websocketStreamHandler) ServerStream(response http.ResponseWriter, reque
 				"service", "Websocket", "method", "ServerStream", "error", err)
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Websocket_ServerStream_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Websocket_ServerStream_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 func (h websocketStreamHandler) BidStream(response http.ResponseWriter, request *http.Request) {
@@ -141,7 +140,7 @@ func (h websocketStreamHandler) BidStream(response http.ResponseWriter, request
 				"service", "Websocket", "method", "BidStream", "error", err)
 		}
 	}
-	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Websocket_BidStream_Desc.RouteInfo)
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Websocket_BidStream_Desc.RouteInfo.WithPrefix(h.prefix))
 }
 
 var _ WebsocketStreamClient = (*websocketStreamClient)(nil)
@@ -153,29 +152,28 @@ type websocketStreamClient struct {
 	logger           *slog.Logger
 	marshalOptions   protojson.MarshalOptions
 	unmarshalOptions protojson.UnmarshalOptions
+	resolver         resolver.Resolver
 }
 
-func NewWebsocketStreamClient(url string,
-	logger *slog.Logger,
-	marshalOpts protojson.MarshalOptions,
-	unmarshalOpts protojson.UnmarshalOptions,
-	dialOpts *websocket.DialOptions,
-) WebsocketStreamClient {
-	if logger == nil {
-		logger = slog.Default()
-	}
+func NewWebsocketStreamClient(url string, opts ...ws.Option) WebsocketStreamClient {
+	options := ws.NewOptions(opts...)
 	return &websocketStreamClient{
 		url:              url,
-		logger:           logger,
-		connCfg:          ws.DefaultConnConfig(),
-		marshalOptions:   marshalOpts,
-		unmarshalOptions: unmarshalOpts,
-		dialOpts:         dialOpts,
+		dialOpts:         options.DialOptions(),
+		connCfg:          options.ConnConfig(),
+		logger:           options.Logger(),
+		marshalOptions:   options.MarshalOptions(),
+		unmarshalOptions: options.UnmarshalOptions(),
+		resolver:         options.Resolver(),
 	}
 }
 
 func (c *websocketStreamClient) ClientStream(ctx context.Context) (ws.ClientStreamingClient[*Request, *Response], error) {
-	u, err := url.JoinPath(c.url, _leo_goose_example_websocket_v1_Websocket_ClientStream_Desc.RouteInfo.Pattern)
+	target, err := ws.ResolveTarget(ctx, c.resolver, c.url)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.JoinPath(target, _leo_goose_example_websocket_v1_Websocket_ClientStream_Desc.RouteInfo.Pattern)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +185,11 @@ func (c *websocketStreamClient) ClientStream(ctx context.Context) (ws.ClientStre
 }
 
 func (c *websocketStreamClient) ServerStream(ctx context.Context, in *Request) (ws.ServerStreamingClient[*Response], error) {
-	u, err := url.JoinPath(c.url, _leo_goose_example_websocket_v1_Websocket_ServerStream_Desc.RouteInfo.Pattern)
+	target, err := ws.ResolveTarget(ctx, c.resolver, c.url)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.JoinPath(target, _leo_goose_example_websocket_v1_Websocket_ServerStream_Desc.RouteInfo.Pattern)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +206,11 @@ func (c *websocketStreamClient) ServerStream(ctx context.Context, in *Request) (
 }
 
 func (c *websocketStreamClient) BidStream(ctx context.Context) (ws.BidiStreamingClient[*Request, *Response], error) {
-	u, err := url.JoinPath(c.url, _leo_goose_example_websocket_v1_Websocket_BidStream_Desc.RouteInfo.Pattern)
+	target, err := ws.ResolveTarget(ctx, c.resolver, c.url)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.JoinPath(target, _leo_goose_example_websocket_v1_Websocket_BidStream_Desc.RouteInfo.Pattern)
 	if err != nil {
 		return nil, err
 	}
@@ -238,3 +244,456 @@ var _leo_goose_example_websocket_v1_Websocket_BidStream_Desc = &goose.Desc{
 		FullMethod: "/leo.goose.example.websocket.v1.Websocket/BidStream",
 	},
 }
+
+type ChatService interface {
+	CreateRoom(ctx context.Context, req *Room) (*Room, error)
+	GetRoom(ctx context.Context, req *GetRoomRequest) (*Room, error)
+}
+
+func AppendChatHttpRoute[R goose.Router](router R, service ChatService, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	handler := chatHandler{
+		service: service,
+		decoder: chatRequestDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		encoder: chatResponseEncoder{
+			marshalOptions:   options.MarshalOptions(),
+			unmarshalOptions: options.UnmarshalOptions(),
+		},
+		errorEncoder:            options.ErrorEncoder(),
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              server.Chain(options.Middlewares()...),
+		prefix:                  options.Prefix(),
+	}
+	router.Handle("POST "+options.Prefix()+"/v1/rooms", http.HandlerFunc(handler.CreateRoom))
+	router.Handle("GET "+options.Prefix()+"/v1/rooms/{id}", http.HandlerFunc(handler.GetRoom))
+	return router
+}
+
+type chatHandler struct {
+	service                 ChatService
+	decoder                 chatRequestDecoder
+	encoder                 chatResponseEncoder
+	errorEncoder            goose.ErrorEncoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              server.Middleware
+	prefix                  string
+}
+
+func (h chatHandler) CreateRoom(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.CreateRoom(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.CreateRoom(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.CreateRoom(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Chat_CreateRoom_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+func (h chatHandler) GetRoom(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx := goose.InjectResponseMetadata(request.Context(), new(goose.ResponseMetadata))
+		req, err := h.decoder.GetRoom(ctx, request)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		goose.ClearOutputOnly(req)
+		if err := goose.ValidateRequest(ctx, req, h.shouldFailFast, h.onValidationErrCallback); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		resp, err := h.service.GetRoom(ctx, req)
+		if err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+		if err := h.encoder.GetRoom(ctx, response, resp); err != nil {
+			h.errorEncoder(ctx, err, response)
+			return
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Chat_GetRoom_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+type chatRequestDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (decoder chatRequestDecoder) CreateRoom(ctx context.Context, request *http.Request) (*Room, error) {
+	req := &Room{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	if err := server.DecodeRequest(ctx, request, req, decoder.unmarshalOptions); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+func (decoder chatRequestDecoder) GetRoom(ctx context.Context, request *http.Request) (*GetRoomRequest, error) {
+	req := &GetRoomRequest{}
+	ok, err := server.CustomDecodeRequest(ctx, request, req)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return req, nil
+	}
+	vars := goose.FormFromPath(request, "id")
+	var varErr error
+	req.Id = vars.Get("id")
+	if varErr != nil {
+		return nil, varErr
+	}
+	return req, nil
+}
+
+type chatResponseEncoder struct {
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+}
+
+func (encoder chatResponseEncoder) CreateRoom(ctx context.Context, w http.ResponseWriter, resp *Room) error {
+	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
+}
+func (encoder chatResponseEncoder) GetRoom(ctx context.Context, w http.ResponseWriter, resp *Room) error {
+	return server.EncodeResponse(ctx, w, resp, encoder.marshalOptions)
+}
+
+func NewChatHttpClient(target string, opts ...client.Option) ChatHttpClient {
+	options := client.NewOptions(opts...)
+	client := &chatHttpClient{
+		client: options.Client(),
+		encoder: chatRequestEncoder{
+			target:         target,
+			marshalOptions: options.MarshalOptions(),
+			resolver:       options.Resolver(),
+		},
+		decoder: chatResponseDecoder{
+			unmarshalOptions: options.UnmarshalOptions(),
+			errorDecoder:     options.ErrorDecoder(),
+			errorFactory:     options.ErrorFactory(),
+		},
+		shouldFailFast:          options.ShouldFailFast(),
+		onValidationErrCallback: options.OnValidationErrCallback(),
+		middleware:              client.Chain(options.Middlewares()...),
+	}
+	return client
+}
+
+type ChatHttpClient interface {
+	CreateRoom(ctx context.Context, req *Room, opts ...client.CallOption) (*Room, error)
+	GetRoom(ctx context.Context, req *GetRoomRequest, opts ...client.CallOption) (*Room, error)
+}
+
+var _ ChatHttpClient = (*chatHttpClient)(nil)
+
+type chatHttpClient struct {
+	client                  *http.Client
+	encoder                 chatRequestEncoder
+	decoder                 chatResponseDecoder
+	shouldFailFast          bool
+	onValidationErrCallback goose.OnValidationErrCallback
+	middleware              client.Middleware
+}
+
+func (c *chatHttpClient) CreateRoom(ctx context.Context, req *Room, opts ...client.CallOption) (*Room, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.CreateRoom(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_websocket_v1_Chat_CreateRoom_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.CreateRoom(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *chatHttpClient) GetRoom(ctx context.Context, req *GetRoomRequest, opts ...client.CallOption) (*Room, error) {
+	if err := goose.ValidateRequest(ctx, req, c.shouldFailFast, c.onValidationErrCallback); err != nil {
+		return nil, err
+	}
+	encoder := c.encoder
+	encoder.target = client.CallTarget(encoder.target, opts...)
+	request, err := encoder.GetRoom(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Invoke(c.middleware, c.client, request, _leo_goose_example_websocket_v1_Chat_GetRoom_Desc.RouteInfo, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.decoder.GetRoom(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type chatRequestEncoder struct {
+	target         string
+	marshalOptions protojson.MarshalOptions
+	resolver       resolver.Resolver
+}
+
+func (encoder *chatRequestEncoder) CreateRoom(ctx context.Context, req *Room) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "POST"
+	header := http.Header{}
+	var body bytes.Buffer
+	if err := client.EncodeMessage(ctx, req, header, &body, encoder.marshalOptions); err != nil {
+		return nil, err
+	}
+	path := "/v1/rooms"
+	target.Path = path
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+func (encoder *chatRequestEncoder) GetRoom(ctx context.Context, req *GetRoomRequest) (*http.Request, error) {
+	if req == nil {
+		return nil, errors.New("request is nil")
+	}
+	target, err := resolver.Resolve(ctx, encoder.resolver, encoder.target)
+	if err != nil {
+		return nil, err
+	}
+	method := "GET"
+	header := http.Header{}
+	var body bytes.Buffer
+	path := "/v1/rooms/{id}"
+	pairs := map[string]string{
+		"id": req.GetId(),
+	}
+	path = goose.URLPath(path, pairs)
+	path, err = url.JoinPath(target.Path, path)
+	if err != nil {
+		return nil, err
+	}
+	target.Path = path
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+	goose.CopyHeader(request.Header, header)
+	return request, nil
+}
+
+type chatResponseDecoder struct {
+	unmarshalOptions protojson.UnmarshalOptions
+	errorDecoder     goose.ErrorDecoder
+	errorFactory     goose.ErrorFactory
+}
+
+func (decoder *chatResponseDecoder) CreateRoom(ctx context.Context, response *http.Response) (*Room, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &Room{}
+	if err := client.DecodeMessage(ctx, response, resp, decoder.unmarshalOptions); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (decoder *chatResponseDecoder) GetRoom(ctx context.Context, response *http.Response) (*Room, error) {
+	if respErr, ok := decoder.errorDecoder(ctx, response, decoder.errorFactory); ok {
+		return nil, respErr
+	}
+	resp := &Room{}
+	if err := client.DecodeMessage(ctx, response, resp, decoder.unmarshalOptions); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type ChatStreamServer interface {
+	Talk(ws.BidiStreamingServer[*Request, *Response]) error
+}
+
+type ChatStreamClient interface {
+	Talk(ctx context.Context) (ws.BidiStreamingClient[*Request, *Response], error)
+}
+
+func AppendChatWebsocketRoute[R goose.Router](router R, service ChatStreamServer, opts ...ws.Option) R {
+	options := ws.NewOptions(opts...)
+	handler := &chatStreamHandler{
+		service:          service,
+		middleware:       server.Chain(options.Middlewares()...),
+		marshalOptions:   options.MarshalOptions(),
+		unmarshalOptions: options.UnmarshalOptions(),
+		acptOpts:         options.AcceptOptions(),
+		cfg:              options.ConnConfig(),
+		logger:           options.Logger(),
+		prefix:           options.Prefix(),
+	}
+	router.Handle(options.Prefix()+_leo_goose_example_websocket_v1_Chat_Talk_Desc.RouteInfo.Pattern, http.HandlerFunc(handler.Talk))
+	return router
+}
+
+type chatStreamHandler struct {
+	service          ChatStreamServer
+	middleware       server.Middleware
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+	acptOpts         *websocket.AcceptOptions
+	cfg              *ws.ConnConfig
+	logger           *slog.Logger
+	prefix           string
+}
+
+func (h chatStreamHandler) Talk(response http.ResponseWriter, request *http.Request) {
+	invoke := func(response http.ResponseWriter, request *http.Request) {
+		ctx, conn, cancel, err := ws.AcceptConn(response, request, h.acptOpts, h.cfg, h.logger)
+		if err != nil {
+			h.logger.Error("failed to accept websocket connection",
+				"service", "Chat", "method", "Talk", "error", err)
+			return
+		}
+		defer cancel()
+		stream := ws.NewServerStream[*Request, *Response](ctx, conn, h.marshalOptions, h.unmarshalOptions)
+		if err := h.service.Talk(stream); err != nil && !ws.IsNormalClose(err) {
+			h.logger.Error("failed to handle bidi stream",
+				"service", "Chat", "method", "Talk", "error", err)
+		}
+		if err := stream.CloseSend(); err != nil && !ws.IsNormalClose(err) {
+			h.logger.Error("failed to close send stream",
+				"service", "Chat", "method", "Talk", "error", err)
+		}
+	}
+	server.Invoke(h.middleware, response, request, invoke, _leo_goose_example_websocket_v1_Chat_Talk_Desc.RouteInfo.WithPrefix(h.prefix))
+}
+
+var _ ChatStreamClient = (*chatStreamClient)(nil)
+
+type chatStreamClient struct {
+	url              string
+	dialOpts         *websocket.DialOptions
+	connCfg          *ws.ConnConfig
+	logger           *slog.Logger
+	marshalOptions   protojson.MarshalOptions
+	unmarshalOptions protojson.UnmarshalOptions
+	resolver         resolver.Resolver
+}
+
+func NewChatStreamClient(url string, opts ...ws.Option) ChatStreamClient {
+	options := ws.NewOptions(opts...)
+	return &chatStreamClient{
+		url:              url,
+		dialOpts:         options.DialOptions(),
+		connCfg:          options.ConnConfig(),
+		logger:           options.Logger(),
+		marshalOptions:   options.MarshalOptions(),
+		unmarshalOptions: options.UnmarshalOptions(),
+		resolver:         options.Resolver(),
+	}
+}
+
+func (c *chatStreamClient) Talk(ctx context.Context) (ws.BidiStreamingClient[*Request, *Response], error) {
+	target, err := ws.ResolveTarget(ctx, c.resolver, c.url)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.JoinPath(target, _leo_goose_example_websocket_v1_Chat_Talk_Desc.RouteInfo.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := ws.DialAndConnect(ctx, u, c.dialOpts, c.connCfg, c.logger)
+	if err != nil {
+		return nil, err
+	}
+	return ws.NewClientStreamV2[*Request, *Response](ctx, conn, c.marshalOptions, c.unmarshalOptions), nil
+}
+
+type ChatServer interface {
+	ChatService
+	ChatStreamServer
+}
+
+func AppendChatRoute[R goose.Router](router R, service ChatServer, opts ...server.Option) R {
+	options := server.NewOptions(opts...)
+	router = AppendChatHttpRoute(router, service, opts...)
+	wsOpts := []ws.Option{
+		ws.MarshalOptions(options.MarshalOptions()),
+		ws.UnmarshalOptions(options.UnmarshalOptions()),
+		ws.Middlewares(options.Middlewares()...),
+		ws.Prefix(options.Prefix()),
+		ws.ErrorEncoder(options.ErrorEncoder()),
+		ws.OnValidationErrCallback(options.OnValidationErrCallback()),
+	}
+	if options.ShouldFailFast() {
+		wsOpts = append(wsOpts, ws.FailFast())
+	}
+	router = AppendChatWebsocketRoute(router, service, wsOpts...)
+	return router
+}
+
+func NewChatHandler(service ChatServer, opts ...server.Option) http.Handler {
+	return AppendChatRoute(http.NewServeMux(), service, opts...)
+}
+
+var _leo_goose_example_websocket_v1_Chat_CreateRoom_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "POST",
+		Pattern:    "/v1/rooms",
+		FullMethod: "/leo.goose.example.websocket.v1.Chat/CreateRoom",
+	},
+}
+
+var _leo_goose_example_websocket_v1_Chat_GetRoom_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/rooms/{id}",
+		FullMethod: "/leo.goose.example.websocket.v1.Chat/GetRoom",
+	},
+}
+
+var _leo_goose_example_websocket_v1_Chat_Talk_Desc = &goose.Desc{
+	RouteInfo: &goose.RouteInfo{
+		HttpMethod: "GET",
+		Pattern:    "/v1/talk",
+		FullMethod: "/leo.goose.example.websocket.v1.Chat/Talk",
+	},
+}
@@ -80,14 +80,16 @@ func (s *mockStreamService) BidStream(stream ws.BidiStreamingServer[*Request, *R
 func startServer(t *testing.T, port int) *http.Server {
 	t.Helper()
 	logger := slog.Default()
-	connCfg := ws.DefaultConnConfig()
-	marshalOpts := protojson.MarshalOptions{}
-	unmarshalOpts := protojson.UnmarshalOptions{}
 
 	svc := &mockStreamService{logger: logger}
 
 	mux := http.NewServeMux()
-	AppendWebsocketWebsocketRoute(mux, svc, server.Chain(), marshalOpts, unmarshalOpts, ws.AcceptOptions(), connCfg, logger)
+	AppendWebsocketWebsocketRoute(mux, svc,
+		ws.Middlewares(server.Chain()),
+		ws.AcceptOpts(ws.AcceptOptions()),
+		ws.Config(ws.DefaultConnConfig()),
+		ws.Logger(logger),
+	)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", port),
@@ -106,10 +108,12 @@ func startServer(t *testing.T, port int) *http.Server {
 
 func newTestClient(t *testing.T, port int) WebsocketStreamClient {
 	t.Helper()
-	logger := slog.Default()
-	marshalOpts := protojson.MarshalOptions{}
-	unmarshalOpts := protojson.UnmarshalOptions{}
-	return NewWebsocketStreamClient(fmt.Sprintf("ws://localhost:%d", port), logger, marshalOpts, unmarshalOpts, ws.DialOptions())
+	return NewWebsocketStreamClient(fmt.Sprintf("ws://localhost:%d", port),
+		ws.Logger(slog.Default()),
+		ws.MarshalOptions(protojson.MarshalOptions{}),
+		ws.UnmarshalOptions(protojson.UnmarshalOptions{}),
+		ws.DialOpts(ws.DialOptions()),
+	)
 }
 
 func TestClientStream(t *testing.T) {
@@ -214,3 +218,73 @@ func TestBidStream(t *testing.T) {
 		t.Fatalf("CloseSend failed: %v", err)
 	}
 }
+
+type mockChatService struct {
+	mockStreamService
+}
+
+var _ ChatServer = (*mockChatService)(nil)
+
+func (s *mockChatService) CreateRoom(ctx context.Context, req *Room) (*Room, error) {
+	return &Room{Id: "1", Title: req.GetTitle()}, nil
+}
+
+func (s *mockChatService) GetRoom(ctx context.Context, req *GetRoomRequest) (*Room, error) {
+	return &Room{Id: req.GetId(), Title: "general"}, nil
+}
+
+func (s *mockChatService) Talk(stream ws.BidiStreamingServer[*Request, *Response]) error {
+	return s.BidStream(stream)
+}
+
+func TestMixedService(t *testing.T) {
+	mux := NewChatHandler(&mockChatService{mockStreamService{logger: slog.Default()}})
+	srv := &http.Server{Addr: ":39084", Handler: mux}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.Errorf("server error: %v", err)
+		}
+	}()
+	defer srv.Shutdown(context.Background())
+	time.Sleep(200 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// unary methods are served over http
+	httpClient := NewChatHttpClient("http://localhost:39084")
+	room, err := httpClient.CreateRoom(ctx, &Room{Title: "general"})
+	if err != nil {
+		t.Fatalf("CreateRoom failed: %v", err)
+	}
+	if room.GetId() != "1" || room.GetTitle() != "general" {
+		t.Fatalf("unexpected room: %v", room)
+	}
+	room, err = httpClient.GetRoom(ctx, &GetRoomRequest{Id: "2"})
+	if err != nil {
+		t.Fatalf("GetRoom failed: %v", err)
+	}
+	if room.GetId() != "2" {
+		t.Fatalf("unexpected room: %v", room)
+	}
+
+	// streaming methods are served over websocket
+	streamClient := NewChatStreamClient("ws://localhost:39084")
+	stream, err := streamClient.Talk(ctx)
+	if err != nil {
+		t.Fatalf("Talk connect failed: %v", err)
+	}
+	if err := stream.Send(&Request{Name: "hi"}); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv failed: %v", err)
+	}
+	if resp.Message != "ack: hi" {
+		t.Fatalf("expected %q, got %q", "ack: hi", resp.Message)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend failed: %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+package goose
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// FieldBehaviorError reports the fields annotated as REQUIRED by google.api.field_behavior
+// that a request does not set. It is encoded as a 400 Bad Request.
+type FieldBehaviorError struct {
+	// Fields are the paths of the missing fields, e.g. user.display_name or items[0].name.
+	Fields []string
+}
+
+// Error returns a string representation of the error, listing the missing fields.
+//
+// Returns:
+//   - string: Formatted error message
+func (e *FieldBehaviorError) Error() string {
+	return fmt.Sprintf("goose: missing required fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// StatusCode returns http.StatusBadRequest.
+//
+// Returns:
+//   - int: The HTTP status code
+func (e *FieldBehaviorError) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// fieldBehaviors caches the google.api.field_behavior annotations of fields, keyed by
+// protoreflect.FieldDescriptor.
+var fieldBehaviors sync.Map
+
+// fieldBehavior describes the annotations of a field the check acts on.
+type fieldBehavior struct {
+	required   bool
+	outputOnly bool
+}
+
+// CheckFieldBehavior checks the google.api.field_behavior annotations of msg and the
+// messages it holds: fields annotated as REQUIRED must be set, fields annotated as
+// OUTPUT_ONLY are skipped. A scalar of proto3 holding its zero value counts as not set.
+// msg is not modified, see ClearOutputOnly.
+//
+// Parameters:
+//   - msg: Proto.Message to check
+//   - fast: Whether to stop at the first missing field
+//
+// Returns:
+//   - error: A *FieldBehaviorError listing the missing fields, nil if none
+func CheckFieldBehavior(msg proto.Message, fast bool) error {
+	if msg == nil {
+		return nil
+	}
+	var missing []string
+	checkFieldBehavior(msg.ProtoReflect(), "", fast, &missing)
+	if len(missing) == 0 {
+		return nil
+	}
+	return &FieldBehaviorError{Fields: missing}
+}
+
+// checkFieldBehavior checks m, whose path is prefix, appending the paths of its missing
+// fields to missing. It reports whether the check should go on.
+func checkFieldBehavior(m protoreflect.Message, prefix string, fast bool, missing *[]string) bool {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		behavior := behaviorOf(fd)
+		if behavior.outputOnly {
+			continue
+		}
+		if !m.Has(fd) {
+			if behavior.required {
+				*missing = append(*missing, prefix+string(fd.Name()))
+				if fast {
+					return false
+				}
+			}
+			continue
+		}
+		if fd.Message() == nil || (fd.IsMap() && fd.MapValue().Message() == nil) {
+			continue
+		}
+		path := prefix + string(fd.Name())
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				if !checkFieldBehavior(list.Get(j).Message(), fmt.Sprintf("%s[%d].", path, j), fast, missing) {
+					return false
+				}
+			}
+		case fd.IsMap():
+			goOn := true
+			m.Get(fd).Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				goOn = checkFieldBehavior(value.Message(), fmt.Sprintf("%s[%v].", path, key.Interface()), fast, missing)
+				return goOn
+			})
+			if !goOn {
+				return false
+			}
+		default:
+			if !checkFieldBehavior(m.Get(fd).Message(), path+".", fast, missing) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ClearOutputOnly clears the fields of msg and the messages it holds that are annotated
+// as OUTPUT_ONLY by google.api.field_behavior, the server ignores them in requests.
+// The generated handlers call it before ValidateRequest.
+//
+// Parameters:
+//   - msg: Proto.Message whose OUTPUT_ONLY fields are cleared in place
+func ClearOutputOnly(msg proto.Message) {
+	if msg == nil {
+		return
+	}
+	clearOutputOnly(msg.ProtoReflect())
+}
+
+func clearOutputOnly(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case behaviorOf(fd).outputOnly:
+			m.Clear(fd)
+		case fd.IsList() && fd.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				clearOutputOnly(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+				clearOutputOnly(value.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			clearOutputOnly(value.Message())
+		}
+		return true
+	})
+}
+
+// behaviorOf returns the google.api.field_behavior annotations of fd.
+func behaviorOf(fd protoreflect.FieldDescriptor) fieldBehavior {
+	if cached, ok := fieldBehaviors.Load(fd); ok {
+		return cached.(fieldBehavior)
+	}
+	var behavior fieldBehavior
+	if options, ok := fd.Options().(*descriptorpb.FieldOptions); ok && options != nil {
+		for _, b := range proto.GetExtension(options, annotations.E_FieldBehavior).([]annotations.FieldBehavior) {
+			switch b {
+			case annotations.FieldBehavior_REQUIRED:
+				behavior.required = true
+			case annotations.FieldBehavior_OUTPUT_ONLY:
+				behavior.outputOnly = true
+			}
+		}
+	}
+	fieldBehaviors.Store(fd, behavior)
+	return behavior
+}
@@ -0,0 +1,157 @@
+package goose
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// behaviorMessages returns the messages of:
+//
+//	message Item {
+//	  string id = 1 [(google.api.field_behavior) = OUTPUT_ONLY];
+//	  string name = 2 [(google.api.field_behavior) = REQUIRED];
+//	}
+//	message Request {
+//	  string parent = 1 [(google.api.field_behavior) = REQUIRED];
+//	  Item item = 2;
+//	  repeated Item items = 3;
+//	}
+func behaviorMessages(t *testing.T) (item protoreflect.MessageDescriptor, request protoreflect.MessageDescriptor) {
+	t.Helper()
+	field := func(name string, number int32, behavior annotations.FieldBehavior) *descriptorpb.FieldDescriptorProto {
+		options := &descriptorpb.FieldOptions{}
+		proto.SetExtension(options, annotations.E_FieldBehavior, []annotations.FieldBehavior{behavior})
+		return &descriptorpb.FieldDescriptorProto{
+			Name:    proto.String(name),
+			Number:  proto.Int32(number),
+			Label:   descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:    descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			Options: options,
+		}
+	}
+	message := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(".test.behavior.Item"),
+		}
+	}
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test/behavior.proto"),
+		Package:    proto.String("test.behavior"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/api/field_behavior.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Item"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("id", 1, annotations.FieldBehavior_OUTPUT_ONLY),
+					field("name", 2, annotations.FieldBehavior_REQUIRED),
+				},
+			},
+			{
+				Name: proto.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("parent", 1, annotations.FieldBehavior_REQUIRED),
+					message("item", 2, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+					message("items", 3, descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("failed to build behavior.proto: %v", err)
+	}
+	return fd.Messages().ByName("Item"), fd.Messages().ByName("Request")
+}
+
+func newItem(md protoreflect.MessageDescriptor, id string, name string) protoreflect.Value {
+	item := dynamicpb.NewMessage(md)
+	if id != "" {
+		item.Set(md.Fields().ByName("id"), protoreflect.ValueOfString(id))
+	}
+	if name != "" {
+		item.Set(md.Fields().ByName("name"), protoreflect.ValueOfString(name))
+	}
+	return protoreflect.ValueOfMessage(item)
+}
+
+func TestCheckFieldBehavior(t *testing.T) {
+	itemDesc, requestDesc := behaviorMessages(t)
+	fields := requestDesc.Fields()
+
+	req := dynamicpb.NewMessage(requestDesc)
+	req.Set(fields.ByName("parent"), protoreflect.ValueOfString("shelves/1"))
+	req.Set(fields.ByName("item"), newItem(itemDesc, "1", "goose"))
+	if err := CheckFieldBehavior(req, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !req.Get(fields.ByName("item")).Message().Has(itemDesc.Fields().ByName("id")) {
+		t.Error("expected the check not to modify the request")
+	}
+
+	req = dynamicpb.NewMessage(requestDesc)
+	req.Set(fields.ByName("item"), newItem(itemDesc, "", ""))
+	items := req.Mutable(fields.ByName("items")).List()
+	items.Append(newItem(itemDesc, "", "goose"))
+	items.Append(newItem(itemDesc, "2", ""))
+	err := CheckFieldBehavior(req, false)
+	var behaviorErr *FieldBehaviorError
+	if !errors.As(err, &behaviorErr) {
+		t.Fatalf("expected a *FieldBehaviorError, got %v", err)
+	}
+	if want := []string{"parent", "item.name", "items[1].name"}; !reflect.DeepEqual(behaviorErr.Fields, want) {
+		t.Errorf("fields = %v, want %v", behaviorErr.Fields, want)
+	}
+	if behaviorErr.StatusCode() != http.StatusBadRequest {
+		t.Errorf("status code = %d, want 400", behaviorErr.StatusCode())
+	}
+
+	err = CheckFieldBehavior(req, true)
+	if !errors.As(err, &behaviorErr) || !reflect.DeepEqual(behaviorErr.Fields, []string{"parent"}) {
+		t.Errorf("fast check should stop at the first missing field, got %v", err)
+	}
+}
+
+func TestClearOutputOnly(t *testing.T) {
+	itemDesc, requestDesc := behaviorMessages(t)
+	fields := requestDesc.Fields()
+	id := itemDesc.Fields().ByName("id")
+
+	req := dynamicpb.NewMessage(requestDesc)
+	req.Set(fields.ByName("item"), newItem(itemDesc, "1", "goose"))
+	req.Mutable(fields.ByName("items")).List().Append(newItem(itemDesc, "2", "gopher"))
+	ClearOutputOnly(req)
+	item := req.Get(fields.ByName("item")).Message()
+	if item.Has(id) || !item.Has(itemDesc.Fields().ByName("name")) {
+		t.Error("expected only the output only id of item to be cleared")
+	}
+	if req.Get(fields.ByName("items")).List().Get(0).Message().Has(id) {
+		t.Error("expected the output only id of items to be cleared")
+	}
+	ClearOutputOnly(nil)
+}
+
+func TestValidateRequestFieldBehavior(t *testing.T) {
+	_, requestDesc := behaviorMessages(t)
+	var called error
+	err := ValidateRequest(context.Background(), dynamicpb.NewMessage(requestDesc), true, func(ctx context.Context, err error) {
+		called = err
+	})
+	if err == nil || called != err {
+		t.Errorf("expected the missing parent to be reported to the callback, got %v and %v", err, called)
+	}
+}
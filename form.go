@@ -3,6 +3,8 @@ package goose
 import (
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
 )
 
 // FormGetter defines a generic function type for form data retrieval
@@ -56,6 +58,129 @@ func FormFromPath(r *http.Request, keys ...string) url.Values {
 	return form
 }
 
+// FormFromQuery returns the query values of an HTTP request with nested keys in dot notation.
+// Keys in deepObject notation such as "filter[owner][id]" are rewritten to "filter.owner.id",
+// so that both notations bind the same nested message field.
+//
+// Parameters:
+//
+//	r: HTTP request object used to retrieve query values
+//
+// Returns:
+//
+//	url.Values: query values keyed in dot notation
+func FormFromQuery(r *http.Request) url.Values {
+	query := r.URL.Query()
+	for key, values := range query {
+		dotKey, ok := dotNotation(key)
+		if !ok {
+			continue
+		}
+		delete(query, key)
+		query[dotKey] = append(query[dotKey], values...)
+	}
+	return query
+}
+
+// FormFromHeader extracts the values of the specified headers of an HTTP request and
+// constructs them into url.Values format, keyed by the given names.
+//
+// Parameters:
+//
+//	r: HTTP request object used to retrieve the headers
+//	keys: list of header names to extract
+//
+// Returns:
+//
+//	url.Values: form data containing the values of the headers present in the request
+func FormFromHeader(r *http.Request, keys ...string) url.Values {
+	form := url.Values{}
+	for _, key := range keys {
+		if values := r.Header.Values(key); len(values) > 0 {
+			form[key] = values
+		}
+	}
+	return form
+}
+
+// FormFromCookie extracts the values of the specified cookies of an HTTP request and
+// constructs them into url.Values format, keyed by the given names.
+//
+// Parameters:
+//
+//	r: HTTP request object used to retrieve the cookies
+//	names: list of cookie names to extract
+//
+// Returns:
+//
+//	url.Values: form data containing the values of the cookies present in the request
+func FormFromCookie(r *http.Request, names ...string) url.Values {
+	form := url.Values{}
+	for _, name := range names {
+		for _, cookie := range r.CookiesNamed(name) {
+			form.Add(name, cookie.Value)
+		}
+	}
+	return form
+}
+
+// AddHeaderForm adds the values of form to the headers of a request, keyed by the header
+// names. Empty values are not sent.
+//
+// Parameters:
+//   - header: The headers of the request
+//   - form: The header values keyed by the header names
+func AddHeaderForm(header http.Header, form url.Values) {
+	for key, values := range form {
+		for _, value := range values {
+			if value != "" {
+				header.Add(key, value)
+			}
+		}
+	}
+}
+
+// AddCookieForm adds the values of form to the Cookie header of a request, keyed by the
+// cookie names. Empty values are not sent.
+//
+// Parameters:
+//   - header: The headers of the request
+//   - form: The cookie values keyed by the cookie names
+func AddCookieForm(header http.Header, form url.Values) {
+	var cookies []string
+	for name, values := range form {
+		for _, value := range values {
+			if value != "" {
+				cookies = append(cookies, (&http.Cookie{Name: name, Value: value}).String())
+			}
+		}
+	}
+	if len(cookies) > 0 {
+		sort.Strings(cookies)
+		header.Add("Cookie", strings.Join(cookies, "; "))
+	}
+}
+
+// dotNotation rewrites a deepObject key "a[b][c]" to "a.b.c", it reports false for other keys.
+func dotNotation(key string) (string, bool) {
+	i := strings.IndexByte(key, '[')
+	if i <= 0 || !strings.HasSuffix(key, "]") {
+		return "", false
+	}
+	var b strings.Builder
+	b.WriteString(key[:i])
+	for rest := key[i:]; rest != ""; {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end <= 1 {
+			return "", false
+		}
+		b.WriteByte('.')
+		b.WriteString(rest[1:end])
+		rest = rest[end+1:]
+	}
+	return b.String(), true
+}
+
 // FormFromMap converts a map[string]string to url.Values format
 // Parameters:
 //   - m: String key-value pair mapping to be converted
@@ -2,6 +2,7 @@ package goose
 
 import (
 	"errors"
+	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
@@ -158,3 +159,73 @@ func TestFormFromPath(t *testing.T) {
 		}
 	})
 }
+
+func TestFormFromQuery(t *testing.T) {
+	// deepObject 风格的键会被转换为点号风格
+	req := httptest.NewRequest("GET", "/test?filter[status]=ACTIVE&filter.owner.id=3&filter[owner][name]=bob&ids[]=1&page=2", nil)
+	form := FormFromQuery(req)
+	expected := map[string]string{
+		"filter.status":     "ACTIVE",
+		"filter.owner.id":   "3",
+		"filter.owner.name": "bob",
+		"ids[]":             "1",
+		"page":              "2",
+	}
+	if len(form) != len(expected) {
+		t.Fatalf("Expected %d keys, got %v", len(expected), form)
+	}
+	for key, value := range expected {
+		if form.Get(key) != value {
+			t.Errorf("Expected %s to be '%s', got '%s'", key, value, form.Get(key))
+		}
+	}
+}
+
+func TestFormFromHeader(t *testing.T) {
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Tenant-Id", "acme")
+	req.Header.Add("X-Version", "1")
+	req.Header.Add("X-Version", "2")
+	form := FormFromHeader(req, "x-tenant-id", "X-Version", "X-Missing")
+	if form.Get("x-tenant-id") != "acme" {
+		t.Errorf("Expected x-tenant-id to be 'acme', got '%s'", form.Get("x-tenant-id"))
+	}
+	if len(form["X-Version"]) != 2 {
+		t.Errorf("Expected 2 values of X-Version, got %v", form["X-Version"])
+	}
+	// 请求中不存在的头不会出现在结果中
+	if form.Has("X-Missing") {
+		t.Errorf("Expected X-Missing to be absent, got %v", form)
+	}
+}
+
+func TestFormFromCookie(t *testing.T) {
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.AddCookie(&http.Cookie{Name: "session", Value: "abc"})
+	req.AddCookie(&http.Cookie{Name: "other", Value: "def"})
+	form := FormFromCookie(req, "session", "missing")
+	if form.Get("session") != "abc" {
+		t.Errorf("Expected session to be 'abc', got '%s'", form.Get("session"))
+	}
+	if form.Has("missing") || form.Has("other") {
+		t.Errorf("Expected only the requested cookies, got %v", form)
+	}
+}
+
+func TestAddHeaderAndCookieForm(t *testing.T) {
+	header := http.Header{}
+	AddHeaderForm(header, url.Values{"X-Tenant-Id": {"acme"}, "X-Empty": {""}})
+	AddCookieForm(header, url.Values{"session": {"abc"}, "theme": {"dark mode"}, "empty": {""}})
+	if header.Get("X-Tenant-Id") != "acme" {
+		t.Errorf("Expected X-Tenant-Id to be 'acme', got '%s'", header.Get("X-Tenant-Id"))
+	}
+	if _, ok := header["X-Empty"]; ok {
+		t.Errorf("Expected empty values not to be sent, got %v", header)
+	}
+	// 生成的 Cookie 头可被服务端解析
+	req := &http.Request{Header: header}
+	form := FormFromCookie(req, "session", "theme", "empty")
+	if form.Get("session") != "abc" || form.Get("theme") != "dark mode" || form.Has("empty") {
+		t.Errorf("Unexpected cookies %v from header %v", form, header)
+	}
+}
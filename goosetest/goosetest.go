@@ -0,0 +1,265 @@
+// Package goosetest runs generated services in-process for end to end tests.
+//
+// A service is served over an in-memory listener, its generated client talks to it through
+// an http.Client dialing that listener, and every exchange is captured. The service is passed
+// as its interface type so the type of the routes and the client can be inferred:
+//
+//	cli, srv := goosetest.Start(t, UserService(&MockUserService{}), AppendUserHttpRoute, NewUserHttpClient)
+//	resp, err := cli.GetUser(ctx, &GetUserRequest{Id: 1})
+//	exchange := srv.LastExchange()
+package goosetest
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/soyacen/goose/client"
+	"github.com/soyacen/goose/server"
+	"github.com/soyacen/goose/ws"
+)
+
+// Host is the host of the URLs of the in-memory servers.
+const Host = "goosetest"
+
+// Exchange is a request served by a test server and the response written for it.
+type Exchange struct {
+	// Request is the served request, its body can be read again.
+	Request *http.Request
+	// RequestBody is the body of the request.
+	RequestBody []byte
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// Header is the header of the response.
+	Header http.Header
+	// ResponseBody is the body of the response, the events of a server-sent events stream included.
+	ResponseBody []byte
+}
+
+// Server is a test server serving a router over an in-memory listener.
+type Server struct {
+	// URL is the http URL of the server.
+	URL string
+	// WsURL is the websocket URL of the server.
+	WsURL string
+
+	listener  *pipeListener
+	server    *http.Server
+	client    *http.Client
+	mu        sync.Mutex
+	exchanges []*Exchange
+}
+
+// NewServer serves the routes registered by register until the end of the test.
+//
+// Parameters:
+//   - t: The test, the server is closed by its cleanup
+//   - register: A function registering the routes of the services on router
+//
+// Returns:
+//   - *Server: The running test server
+func NewServer(t testing.TB, register func(router *http.ServeMux) *http.ServeMux) *Server {
+	t.Helper()
+	listener := newPipeListener()
+	s := &Server{
+		URL:      "http://" + Host,
+		WsURL:    "ws://" + Host,
+		listener: listener,
+		client: &http.Client{
+			Transport: &http.Transport{DialContext: listener.DialContext},
+		},
+	}
+	s.server = &http.Server{Handler: s.capture(register(http.NewServeMux()))}
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.Errorf("goosetest: server error: %v", err)
+		}
+	}()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Start serves service with the generated Append<X>HttpRoute function and returns the client
+// created by the generated New<X>HttpClient function.
+//
+// Parameters:
+//   - t: The test, the server is closed by its cleanup
+//   - service: The implementation of the service
+//   - appendRoute: The generated Append<X>HttpRoute function
+//   - newClient: The generated New<X>HttpClient function
+//   - opts: Options of the server and the client
+//
+// Returns:
+//   - C: The client of the service
+//   - *Server: The running test server
+func Start[S any, C any](
+	t testing.TB,
+	service S,
+	appendRoute func(router *http.ServeMux, service S, opts ...server.Option) *http.ServeMux,
+	newClient func(target string, opts ...client.Option) C,
+	opts ...Option,
+) (C, *Server) {
+	t.Helper()
+	o := newOptions(opts...)
+	s := NewServer(t, func(router *http.ServeMux) *http.ServeMux {
+		return appendRoute(router, service, o.serverOptions...)
+	})
+	clientOpts := append(append([]client.Option{}, o.clientOptions...), client.Client(s.Client()))
+	return newClient(s.URL, clientOpts...), s
+}
+
+// StartStream serves service with the generated Append<X>WebsocketRoute function and returns the
+// client created by the generated New<X>StreamClient function.
+//
+// Parameters:
+//   - t: The test, the server is closed by its cleanup
+//   - service: The implementation of the stream service
+//   - appendRoute: The generated Append<X>WebsocketRoute function
+//   - newClient: The generated New<X>StreamClient function
+//   - opts: Options of the server and the client
+//
+// Returns:
+//   - C: The stream client of the service
+//   - *Server: The running test server
+func StartStream[S any, C any](
+	t testing.TB,
+	service S,
+	appendRoute func(router *http.ServeMux, service S, opts ...ws.Option) *http.ServeMux,
+	newClient func(url string, opts ...ws.Option) C,
+	opts ...Option,
+) (C, *Server) {
+	t.Helper()
+	o := newOptions(opts...)
+	s := NewServer(t, func(router *http.ServeMux) *http.ServeMux {
+		return appendRoute(router, service, o.wsOptions...)
+	})
+	wsOpts := append(append([]ws.Option{}, o.wsOptions...), s.WsOptions()...)
+	return newClient(s.WsURL, wsOpts...), s
+}
+
+// Client returns the http client dialing the in-memory listener of the server.
+func (s *Server) Client() *http.Client {
+	return s.client
+}
+
+// WsOptions returns the options making websocket and server-sent events clients dial the
+// in-memory listener of the server.
+func (s *Server) WsOptions() []ws.Option {
+	dialOpts := ws.DialOptions()
+	dialOpts.HTTPClient = s.client
+	return []ws.Option{ws.DialOpts(dialOpts), ws.Client(s.client)}
+}
+
+// Exchanges returns the exchanges served so far, in the order they completed.
+func (s *Server) Exchanges() []*Exchange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Exchange(nil), s.exchanges...)
+}
+
+// LastExchange returns the last completed exchange, nil if none.
+func (s *Server) LastExchange() *Exchange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.exchanges) == 0 {
+		return nil
+	}
+	return s.exchanges[len(s.exchanges)-1]
+}
+
+// Reset forgets the captured exchanges.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exchanges = nil
+}
+
+// Close shuts the server down and closes the idle connections of its client.
+func (s *Server) Close() {
+	_ = s.server.Close()
+	s.client.CloseIdleConnections()
+}
+
+// capture wraps handler to record the exchanges it serves.
+func (s *Server) capture(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		if err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		captured := request.Clone(request.Context())
+		captured.Body = io.NopCloser(bytes.NewReader(body))
+
+		writer := &captureWriter{ResponseWriter: response}
+		handler.ServeHTTP(writer, request)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.exchanges = append(s.exchanges, &Exchange{
+			Request:      captured,
+			RequestBody:  body,
+			StatusCode:   writer.statusCode(),
+			Header:       response.Header().Clone(),
+			ResponseBody: writer.body.Bytes(),
+		})
+	})
+}
+
+// captureWriter copies the response written through it.
+type captureWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *captureWriter) WriteHeader(statusCode int) {
+	if w.status == 0 {
+		w.status = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush lets server-sent events streams flush through the writer.
+func (w *captureWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack lets websocket upgrades take over the connection.
+func (w *captureWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("goosetest: response writer does not support hijacking")
+	}
+	if w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return hijacker.Hijack()
+}
+
+func (w *captureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *captureWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
@@ -0,0 +1,69 @@
+package goosetest
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNewServer(t *testing.T) {
+	srv := NewServer(t, func(router *http.ServeMux) *http.ServeMux {
+		router.HandleFunc("POST /echo", func(response http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			response.Header().Set("Content-Type", "text/plain")
+			response.WriteHeader(http.StatusCreated)
+			_, _ = response.Write(body)
+		})
+		return router
+	})
+
+	response, err := srv.Client().Post(srv.URL+"/echo?name=goose", "text/plain", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	defer response.Body.Close()
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusCreated || string(body) != "hello" {
+		t.Fatalf("unexpected response: %d %s", response.StatusCode, body)
+	}
+
+	exchange := srv.LastExchange()
+	if exchange == nil {
+		t.Fatal("expected a captured exchange")
+	}
+	if exchange.Request.URL.Query().Get("name") != "goose" || string(exchange.RequestBody) != "hello" {
+		t.Fatalf("unexpected captured request: %v %s", exchange.Request.URL, exchange.RequestBody)
+	}
+	if captured, _ := io.ReadAll(exchange.Request.Body); string(captured) != "hello" {
+		t.Fatalf("expected captured request body to be readable, got %q", captured)
+	}
+	if exchange.StatusCode != http.StatusCreated || exchange.Header.Get("Content-Type") != "text/plain" || string(exchange.ResponseBody) != "hello" {
+		t.Fatalf("unexpected captured response: %d %v %s", exchange.StatusCode, exchange.Header, exchange.ResponseBody)
+	}
+
+	srv.Reset()
+	if len(srv.Exchanges()) != 0 || srv.LastExchange() != nil {
+		t.Fatal("expected no exchange after Reset")
+	}
+}
+
+func TestServerClose(t *testing.T) {
+	srv := NewServer(t, func(router *http.ServeMux) *http.ServeMux { return router })
+	srv.Close()
+	if _, err := srv.Client().Get(srv.URL + "/"); err == nil {
+		t.Fatal("expected an error after Close")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	o := newOptions(
+		ServerMiddlewares(func(response http.ResponseWriter, request *http.Request, invoker http.HandlerFunc) {
+			invoker(response, request)
+		}),
+		ClientMiddlewares(nil),
+	)
+	if len(o.serverOptions) != 1 || len(o.wsOptions) != 1 || len(o.clientOptions) != 1 {
+		t.Fatalf("unexpected options: %+v", o)
+	}
+}
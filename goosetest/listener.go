@@ -0,0 +1,67 @@
+package goosetest
+
+import (
+	"context"
+	"net"
+	"sync"
+)
+
+// pipeAddr is the address of the in-memory listener.
+type pipeAddr struct{}
+
+func (pipeAddr) Network() string { return "pipe" }
+
+func (pipeAddr) String() string { return Host }
+
+// pipeListener is a net.Listener whose connections are in-memory pipes created by DialContext.
+type pipeListener struct {
+	conns chan net.Conn
+	done  chan struct{}
+	once  sync.Once
+}
+
+func newPipeListener() *pipeListener {
+	return &pipeListener{
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+// Accept waits for the next connection dialed by DialContext.
+func (l *pipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops accepting connections, the connections already accepted are left open.
+func (l *pipeListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+	return nil
+}
+
+// Addr returns the address of the listener.
+func (l *pipeListener) Addr() net.Addr {
+	return pipeAddr{}
+}
+
+// DialContext connects to the listener whatever the network and address, it is used
+// as the DialContext of the http.Transport of the test clients.
+func (l *pipeListener) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	server, client := net.Pipe()
+	select {
+	case l.conns <- server:
+		return client, nil
+	case <-l.done:
+		server.Close()
+		client.Close()
+		return nil, net.ErrClosed
+	case <-ctx.Done():
+		server.Close()
+		client.Close()
+		return nil, ctx.Err()
+	}
+}
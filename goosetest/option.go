@@ -0,0 +1,105 @@
+package goosetest
+
+import (
+	"github.com/soyacen/goose/client"
+	"github.com/soyacen/goose/server"
+	"github.com/soyacen/goose/ws"
+)
+
+// options holds the configuration of a test server and its client
+type options struct {
+	serverOptions []server.Option // Options of the http routes
+	clientOptions []client.Option // Options of the http client
+	wsOptions     []ws.Option     // Options of the websocket routes and client
+}
+
+// Option defines a function type for modifying test options
+type Option func(o *options)
+
+// apply applies the given options to the current options struct
+//
+// Parameters:
+//   - opts: A variadic list of Option functions to apply
+//
+// Returns:
+//   - *options: The modified options struct
+func (o *options) apply(opts ...Option) *options {
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// ServerOptions appends options passed to the Append<X>HttpRoute function of the service
+//
+// Parameters:
+//   - opts: The server options to append
+//
+// Returns:
+//   - Option: A function that appends the server options
+func ServerOptions(opts ...server.Option) Option {
+	return func(o *options) {
+		o.serverOptions = append(o.serverOptions, opts...)
+	}
+}
+
+// ClientOptions appends options passed to the New<X>HttpClient function of the service.
+// The http client is always replaced by the one talking to the in-memory listener.
+//
+// Parameters:
+//   - opts: The client options to append
+//
+// Returns:
+//   - Option: A function that appends the client options
+func ClientOptions(opts ...client.Option) Option {
+	return func(o *options) {
+		o.clientOptions = append(o.clientOptions, opts...)
+	}
+}
+
+// WsOptions appends options passed to both the Append<X>WebsocketRoute and the
+// New<X>StreamClient functions of the service
+//
+// Parameters:
+//   - opts: The websocket options to append
+//
+// Returns:
+//   - Option: A function that appends the websocket options
+func WsOptions(opts ...ws.Option) Option {
+	return func(o *options) {
+		o.wsOptions = append(o.wsOptions, opts...)
+	}
+}
+
+// ServerMiddlewares injects middlewares into the routes of the service
+//
+// Parameters:
+//   - middlewares: The server middlewares to inject
+//
+// Returns:
+//   - Option: A function that injects the server middlewares
+func ServerMiddlewares(middlewares ...server.Middleware) Option {
+	return func(o *options) {
+		o.serverOptions = append(o.serverOptions, server.Middlewares(middlewares...))
+		o.wsOptions = append(o.wsOptions, ws.Middlewares(middlewares...))
+	}
+}
+
+// ClientMiddlewares injects middlewares into the http client of the service
+//
+// Parameters:
+//   - middlewares: The client middlewares to inject
+//
+// Returns:
+//   - Option: A function that injects the client middlewares
+func ClientMiddlewares(middlewares ...client.Middleware) Option {
+	return func(o *options) {
+		o.clientOptions = append(o.clientOptions, client.Middlewares(middlewares...))
+	}
+}
+
+// newOptions creates the options of a test server and applies the provided options
+func newOptions(opts ...Option) *options {
+	o := &options{}
+	return o.apply(opts...)
+}
@@ -0,0 +1,143 @@
+// Package mock provides the runtime of the mocks generated by protoc-gen-goose with mock=true.
+package mock
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotStubbed is returned by a mocked method called with neither a function nor a matching stub.
+var ErrNotStubbed = errors.New("mock: method is not stubbed")
+
+// NotStubbed returns the error of the method called with neither a function nor a matching stub.
+//
+// Parameters:
+//   - method: The full name of the method
+//
+// Returns:
+//   - error: An error wrapping ErrNotStubbed
+func NotStubbed(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, method)
+}
+
+// stub is a result returned for the requests equal to req.
+type stub[Res any] struct {
+	req proto.Message
+	res Res
+	err error
+}
+
+// Method records the calls of a mocked method and holds its stubbed results.
+// Requests are matched with proto.Equal. The zero value is ready to use.
+type Method[Req proto.Message, Res any] struct {
+	mu         sync.Mutex
+	calls      []Req
+	stubs      []stub[Res]
+	hasDefault bool
+	defaultRes Res
+	defaultErr error
+}
+
+// Record records a call of the method.
+//
+// Parameters:
+//   - req: The request of the call, nil for methods streaming their requests
+func (m *Method[Req, Res]) Record(req Req) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, req)
+}
+
+// On stubs the result of the calls whose request equals req.
+// Stubs are matched in the order they were added.
+//
+// Parameters:
+//   - req: The request to match
+//   - res: The result to return
+//   - err: The error to return
+func (m *Method[Req, Res]) On(req Req, res Res, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stubs = append(m.stubs, stub[Res]{req: req, res: res, err: err})
+}
+
+// Return stubs the result of the calls matching no stub added by On.
+//
+// Parameters:
+//   - res: The result to return
+//   - err: The error to return
+func (m *Method[Req, Res]) Return(res Res, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hasDefault, m.defaultRes, m.defaultErr = true, res, err
+}
+
+// Stubbed returns the stubbed result of a call.
+//
+// Parameters:
+//   - req: The request of the call
+//
+// Returns:
+//   - Res: The stubbed result
+//   - error: The stubbed error
+//   - bool: False if no stub matches req and no default result is set
+func (m *Method[Req, Res]) Stubbed(req Req) (Res, error, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range m.stubs {
+		if proto.Equal(s.req, req) {
+			return s.res, s.err, true
+		}
+	}
+	return m.defaultRes, m.defaultErr, m.hasDefault
+}
+
+// Calls returns the requests of the recorded calls.
+//
+// Returns:
+//   - []Req: A copy of the recorded requests in call order
+func (m *Method[Req, Res]) Calls() []Req {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Req(nil), m.calls...)
+}
+
+// CallCount returns the number of recorded calls.
+//
+// Returns:
+//   - int: The number of calls
+func (m *Method[Req, Res]) CallCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.calls)
+}
+
+// CalledWith reports whether a recorded call has a request equal to req.
+//
+// Parameters:
+//   - req: The request to match
+//
+// Returns:
+//   - bool: True if a call matches
+func (m *Method[Req, Res]) CalledWith(req Req) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, call := range m.calls {
+		if proto.Equal(call, req) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset forgets the recorded calls and the stubbed results.
+func (m *Method[Req, Res]) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var zero Res
+	m.calls, m.stubs = nil, nil
+	m.hasDefault, m.defaultRes, m.defaultErr = false, zero, nil
+}
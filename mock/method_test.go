@@ -0,0 +1,61 @@
+package mock
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMethod_Stubbed(t *testing.T) {
+	var m Method[*wrapperspb.StringValue, *wrapperspb.Int64Value]
+	if _, _, ok := m.Stubbed(wrapperspb.String("a")); ok {
+		t.Fatalf("zero Method reported a stub")
+	}
+
+	errB := errors.New("b")
+	m.On(wrapperspb.String("a"), wrapperspb.Int64(1), nil)
+	m.On(wrapperspb.String("b"), nil, errB)
+	m.Return(wrapperspb.Int64(0), nil)
+
+	if res, err, ok := m.Stubbed(wrapperspb.String("a")); !ok || err != nil || res.GetValue() != 1 {
+		t.Errorf("Stubbed(a) = %v, %v, %v; want 1, nil, true", res, err, ok)
+	}
+	if _, err, ok := m.Stubbed(wrapperspb.String("b")); !ok || err != errB {
+		t.Errorf("Stubbed(b) = %v, %v; want b, true", err, ok)
+	}
+	if res, err, ok := m.Stubbed(wrapperspb.String("c")); !ok || err != nil || res.GetValue() != 0 {
+		t.Errorf("Stubbed(c) = %v, %v, %v; want default result", res, err, ok)
+	}
+}
+
+func TestMethod_Calls(t *testing.T) {
+	var m Method[*wrapperspb.StringValue, *wrapperspb.Int64Value]
+	m.Record(wrapperspb.String("a"))
+	m.Record(wrapperspb.String("b"))
+
+	if m.CallCount() != 2 {
+		t.Errorf("CallCount = %d, want 2", m.CallCount())
+	}
+	if calls := m.Calls(); len(calls) != 2 || calls[1].GetValue() != "b" {
+		t.Errorf("Calls = %v, want [a b]", calls)
+	}
+	if !m.CalledWith(wrapperspb.String("a")) {
+		t.Errorf("CalledWith(a) = false, want true")
+	}
+	if m.CalledWith(wrapperspb.String("c")) {
+		t.Errorf("CalledWith(c) = true, want false")
+	}
+
+	m.Reset()
+	if m.CallCount() != 0 {
+		t.Errorf("CallCount after Reset = %d, want 0", m.CallCount())
+	}
+}
+
+func TestNotStubbed(t *testing.T) {
+	err := NotStubbed("/pkg.Service/Method")
+	if !errors.Is(err, ErrNotStubbed) {
+		t.Errorf("NotStubbed does not wrap ErrNotStubbed")
+	}
+}
@@ -0,0 +1,207 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/soyacen/goose/ws"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var (
+	_ ws.ServerStreamingClient[*anypb.Any]             = (*ClientStream[*anypb.Any, *anypb.Any])(nil)
+	_ ws.ClientStreamingClient[*anypb.Any, *anypb.Any] = (*ClientStream[*anypb.Any, *anypb.Any])(nil)
+	_ ws.BidiStreamingClient[*anypb.Any, *anypb.Any]   = (*ClientStream[*anypb.Any, *anypb.Any])(nil)
+)
+
+// ClientStream is an in-memory client side of a streaming RPC. It receives the responses it
+// was created with, then io.EOF, and records the requests sent to it.
+type ClientStream[Req proto.Message, Res proto.Message] struct {
+	ctx       context.Context
+	mu        sync.Mutex
+	responses []Res
+	sent      []Req
+	closed    bool
+}
+
+// NewClientStream creates a client stream receiving the given responses.
+//
+// Parameters:
+//   - ctx: The context of the stream
+//   - responses: The responses received by Recv, in order
+//
+// Returns:
+//   - *ClientStream[Req, Res]: The client stream
+func NewClientStream[Req proto.Message, Res proto.Message](ctx context.Context, responses ...Res) *ClientStream[Req, Res] {
+	return &ClientStream[Req, Res]{ctx: ctx, responses: responses}
+}
+
+// Context returns the context of the stream.
+func (s *ClientStream[Req, Res]) Context() context.Context { return s.ctx }
+
+// Send records m, it fails with io.EOF after CloseSend.
+func (s *ClientStream[Req, Res]) Send(m Req) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return io.EOF
+	}
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// SendMsg records m, see Send.
+func (s *ClientStream[Req, Res]) SendMsg(m proto.Message) error {
+	req, ok := m.(Req)
+	if !ok {
+		return fmt.Errorf("mock: unexpected request type %T", m)
+	}
+	return s.Send(req)
+}
+
+// Recv returns the next response, io.EOF once all of them have been received.
+func (s *ClientStream[Req, Res]) Recv() (Res, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.responses) == 0 {
+		var zero Res
+		return zero, io.EOF
+	}
+	res := s.responses[0]
+	s.responses = s.responses[1:]
+	return res, nil
+}
+
+// RecvMsg merges the next response into m, see Recv.
+func (s *ClientStream[Req, Res]) RecvMsg(m proto.Message) error {
+	res, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	proto.Merge(m, res)
+	return nil
+}
+
+// CloseSend closes the send direction of the stream.
+func (s *ClientStream[Req, Res]) CloseSend() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+// CloseAndRecv closes the send direction of the stream and returns the next response.
+func (s *ClientStream[Req, Res]) CloseAndRecv() (Res, error) {
+	if err := s.CloseSend(); err != nil {
+		var zero Res
+		return zero, err
+	}
+	return s.Recv()
+}
+
+// Sent returns the requests sent to the stream.
+func (s *ClientStream[Req, Res]) Sent() []Req {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Req(nil), s.sent...)
+}
+
+var (
+	_ ws.ServerStreamingServer[*anypb.Any]             = (*ServerStream[*anypb.Any, *anypb.Any])(nil)
+	_ ws.ClientStreamingServer[*anypb.Any, *anypb.Any] = (*ServerStream[*anypb.Any, *anypb.Any])(nil)
+	_ ws.BidiStreamingServer[*anypb.Any, *anypb.Any]   = (*ServerStream[*anypb.Any, *anypb.Any])(nil)
+)
+
+// ServerStream is an in-memory server side of a streaming RPC, for testing service implementations.
+// It receives the requests it was created with, then io.EOF, and records the responses sent to it.
+type ServerStream[Req proto.Message, Res proto.Message] struct {
+	ctx      context.Context
+	mu       sync.Mutex
+	requests []Req
+	sent     []Res
+	closed   bool
+}
+
+// NewServerStream creates a server stream receiving the given requests.
+//
+// Parameters:
+//   - ctx: The context of the stream
+//   - requests: The requests received by Recv, in order
+//
+// Returns:
+//   - *ServerStream[Req, Res]: The server stream
+func NewServerStream[Req proto.Message, Res proto.Message](ctx context.Context, requests ...Req) *ServerStream[Req, Res] {
+	return &ServerStream[Req, Res]{ctx: ctx, requests: requests}
+}
+
+// Context returns the context of the stream.
+func (s *ServerStream[Req, Res]) Context() context.Context { return s.ctx }
+
+// Send records m, it fails with io.ErrClosedPipe after CloseSend.
+func (s *ServerStream[Req, Res]) Send(m Res) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return io.ErrClosedPipe
+	}
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// SendMsg records m, see Send.
+func (s *ServerStream[Req, Res]) SendMsg(m proto.Message) error {
+	res, ok := m.(Res)
+	if !ok {
+		return fmt.Errorf("mock: unexpected response type %T", m)
+	}
+	return s.Send(res)
+}
+
+// SendAndClose records m and closes the send direction of the stream.
+func (s *ServerStream[Req, Res]) SendAndClose(m Res) error {
+	if err := s.Send(m); err != nil {
+		return err
+	}
+	return s.CloseSend()
+}
+
+// Recv returns the next request, io.EOF once all of them have been received.
+func (s *ServerStream[Req, Res]) Recv() (Req, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		var zero Req
+		return zero, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+// RecvMsg merges the next request into m, see Recv.
+func (s *ServerStream[Req, Res]) RecvMsg(m proto.Message) error {
+	req, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	proto.Merge(m, req)
+	return nil
+}
+
+// CloseSend closes the send direction of the stream.
+func (s *ServerStream[Req, Res]) CloseSend() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+// Sent returns the responses sent to the stream.
+func (s *ServerStream[Req, Res]) Sent() []Res {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Res(nil), s.sent...)
+}
@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestClientStream(t *testing.T) {
+	stream := NewClientStream[*wrapperspb.StringValue](context.Background(), wrapperspb.Int64(1), wrapperspb.Int64(2))
+	if err := stream.Send(wrapperspb.String("a")); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil || res.GetValue() != 1 {
+		t.Fatalf("CloseAndRecv = %v, %v; want 1, nil", res, err)
+	}
+	if err := stream.Send(wrapperspb.String("b")); err != io.EOF {
+		t.Errorf("Send after CloseSend = %v, want io.EOF", err)
+	}
+	var m wrapperspb.Int64Value
+	if err := stream.RecvMsg(&m); err != nil || m.GetValue() != 2 {
+		t.Fatalf("RecvMsg = %v, %v; want 2, nil", m.GetValue(), err)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("Recv = %v, want io.EOF", err)
+	}
+	if sent := stream.Sent(); len(sent) != 1 || sent[0].GetValue() != "a" {
+		t.Errorf("Sent = %v, want [a]", sent)
+	}
+}
+
+func TestServerStream(t *testing.T) {
+	stream := NewServerStream[*wrapperspb.StringValue, *wrapperspb.Int64Value](context.Background(), wrapperspb.String("a"))
+	req, err := stream.Recv()
+	if err != nil || req.GetValue() != "a" {
+		t.Fatalf("Recv = %v, %v; want a, nil", req, err)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("Recv = %v, want io.EOF", err)
+	}
+	if err := stream.SendAndClose(wrapperspb.Int64(1)); err != nil {
+		t.Fatalf("SendAndClose failed: %v", err)
+	}
+	if err := stream.Send(wrapperspb.Int64(2)); err != io.ErrClosedPipe {
+		t.Errorf("Send after close = %v, want io.ErrClosedPipe", err)
+	}
+	if sent := stream.Sent(); len(sent) != 1 || sent[0].GetValue() != 1 {
+		t.Errorf("Sent = %v, want [1]", sent)
+	}
+}
@@ -1,10 +1,14 @@
 package goose
 
-import "strings"
+import (
+	"net/http"
+	"strings"
+)
 
 // URLPath constructs a URL path by replacing placeholders in the template path with actual values.
 // Placeholders are defined using curly braces, e.g., "/users/{id}".
 // The function supports both regular placeholders and spread placeholders ending with "...".
+// A placeholder may be followed by a custom verb, e.g. "/v1/{name}:publish".
 //
 // Parameters:
 //   - path: The URL template path containing placeholders
@@ -16,6 +20,7 @@ import "strings"
 // Example:
 //   URLPath("/users/{id}", map[string]string{"id": "123"}) returns "/users/123"
 //   URLPath("/files/{path...}", map[string]string{"path": "dir/file.txt"}) returns "/files/dir/file.txt"
+//   URLPath("/v1/{name}:publish", map[string]string{"name": "shelves/1"}) returns "/v1/shelves/1:publish"
 func URLPath(path string, pairs map[string]string) string {
 	sections := strings.Split(path, "/")
 	for i := 0; i < len(sections); i++ {
@@ -26,15 +31,37 @@ func URLPath(path string, pairs map[string]string) string {
 		if !strings.HasPrefix(section, "{") {
 			continue
 		}
-		if !strings.HasSuffix(section, "}") {
+		end := strings.IndexByte(section, '}')
+		if end < 0 {
 			continue
 		}
-		if strings.HasSuffix(section, "...}") {
-			section = section[1 : len(section)-4]
-		} else {
-			section = section[1 : len(section)-1]
+		verb := section[end+1:]
+		if verb != "" && !strings.HasPrefix(verb, ":") {
+			continue
 		}
-		sections[i] = pairs[section]
+		section = strings.TrimSuffix(section[1:end], "...")
+		sections[i] = pairs[section] + verb
 	}
 	return strings.Join(sections, "/")
 }
+
+// MatchVerb reports whether the value of the path wildcard ends with the custom verb,
+// e.g. "/v1/{name=shelves/*}:publish" is routed as "/v1/shelves/{name_1}" and the
+// value of "name_1" must end with ":publish". On a match the verb is trimmed off the
+// wildcard value, so that later calls of PathValue return the bare value.
+//
+// Parameters:
+//   - r: The HTTP request matched by the router
+//   - wildcard: The name of the last wildcard of the route
+//   - verb: The custom verb without the leading colon
+//
+// Returns:
+//   - bool: true if the request carries the custom verb
+func MatchVerb(r *http.Request, wildcard string, verb string) bool {
+	value, ok := strings.CutSuffix(r.PathValue(wildcard), ":"+verb)
+	if !ok || value == "" {
+		return false
+	}
+	r.SetPathValue(wildcard, value)
+	return true
+}
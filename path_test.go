@@ -0,0 +1,45 @@
+package goose
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestURLPath(t *testing.T) {
+	tests := []struct {
+		path  string
+		pairs map[string]string
+		want  string
+	}{
+		{"/users/{id}", map[string]string{"id": "123"}, "/users/123"},
+		{"/files/{path...}", map[string]string{"path": "dir/file.txt"}, "/files/dir/file.txt"},
+		{"/v1/{name}:publish", map[string]string{"name": "shelves/1"}, "/v1/shelves/1:publish"},
+		{"/v1/books:batchGet", nil, "/v1/books:batchGet"},
+	}
+	for _, tt := range tests {
+		if got := URLPath(tt.path, tt.pairs); got != tt.want {
+			t.Errorf("URLPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestMatchVerb(t *testing.T) {
+	r := httptest.NewRequest("POST", "/v1/shelves/1:publish", nil)
+	r.SetPathValue("name_1", "1:publish")
+	if MatchVerb(r, "name_1", "archive") {
+		t.Fatal("archive should not match")
+	}
+	if r.PathValue("name_1") != "1:publish" {
+		t.Fatalf("path value changed to %q", r.PathValue("name_1"))
+	}
+	if !MatchVerb(r, "name_1", "publish") {
+		t.Fatal("publish should match")
+	}
+	if r.PathValue("name_1") != "1" {
+		t.Fatalf("path value = %q, want %q", r.PathValue("name_1"), "1")
+	}
+	r.SetPathValue("name_1", ":publish")
+	if MatchVerb(r, "name_1", "publish") {
+		t.Fatal("empty value should not match")
+	}
+}
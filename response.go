@@ -0,0 +1,78 @@
+package goose
+
+import (
+	"context"
+	"net/http"
+)
+
+type responseMetadataKey struct{}
+
+// ResponseMetadata holds the headers and the status code a service sets on its response
+// by SetResponseHeader, AddResponseHeader and SetStatusCode. The generated handlers inject
+// it into the context of the service and the response encoders of the server package write
+// it before the body, errors are encoded without it.
+type ResponseMetadata struct {
+	// Header are the headers added to the response.
+	Header http.Header
+	// StatusCode is the status code of the response, the default one of the encoder if 0.
+	StatusCode int
+}
+
+// ExtractResponseMetadata extracts the response metadata from the context.
+func ExtractResponseMetadata(ctx context.Context) (*ResponseMetadata, bool) {
+	val, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata)
+	return val, ok
+}
+
+// InjectResponseMetadata injects the response metadata into the context.
+func InjectResponseMetadata(ctx context.Context, metadata *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, metadata)
+}
+
+// SetResponseHeader sets the header key of the response to value, replacing its values.
+// It does nothing if ctx is not the context of a generated handler.
+func SetResponseHeader(ctx context.Context, key, value string) {
+	if metadata, ok := ExtractResponseMetadata(ctx); ok {
+		metadata.header().Set(key, value)
+	}
+}
+
+// AddResponseHeader adds value to the header key of the response, e.g. for Set-Cookie.
+// It does nothing if ctx is not the context of a generated handler.
+func AddResponseHeader(ctx context.Context, key, value string) {
+	if metadata, ok := ExtractResponseMetadata(ctx); ok {
+		metadata.header().Add(key, value)
+	}
+}
+
+// SetStatusCode sets the status code of the response, e.g. http.StatusCreated.
+// It does nothing if ctx is not the context of a generated handler.
+func SetStatusCode(ctx context.Context, code int) {
+	if metadata, ok := ExtractResponseMetadata(ctx); ok {
+		metadata.StatusCode = code
+	}
+}
+
+func (metadata *ResponseMetadata) header() http.Header {
+	if metadata.Header == nil {
+		metadata.Header = make(http.Header)
+	}
+	return metadata.Header
+}
+
+// WriteResponseHeader writes the headers and the status code of the response metadata of
+// ctx to response, code is written if the metadata sets no status code. The response
+// encoders call it in place of response.WriteHeader.
+func WriteResponseHeader(ctx context.Context, response http.ResponseWriter, code int) {
+	if metadata, ok := ExtractResponseMetadata(ctx); ok {
+		header := response.Header()
+		// the headers set by the service replace the ones of the encoder
+		for key, values := range metadata.Header {
+			header[key] = append([]string(nil), values...)
+		}
+		if metadata.StatusCode != 0 {
+			code = metadata.StatusCode
+		}
+	}
+	response.WriteHeader(code)
+}
@@ -0,0 +1,49 @@
+package goose
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResponseMetadata(t *testing.T) {
+	ctx := InjectResponseMetadata(context.Background(), new(ResponseMetadata))
+	SetResponseHeader(ctx, "Location", "/v1/users/1")
+	AddResponseHeader(ctx, "Set-Cookie", "a=1")
+	AddResponseHeader(ctx, "Set-Cookie", "b=2")
+	SetStatusCode(ctx, http.StatusCreated)
+
+	rec := httptest.NewRecorder()
+	rec.Header().Set(ContentTypeKey, JsonContentType)
+	rec.Header().Set("Location", "/default")
+	WriteResponseHeader(ctx, rec, http.StatusOK)
+	if rec.Code != http.StatusCreated {
+		t.Errorf("expected status code %d, got %d", http.StatusCreated, rec.Code)
+	}
+	if got := rec.Header().Values("Location"); len(got) != 1 || got[0] != "/v1/users/1" {
+		t.Errorf("expected the header set by the service to replace the default one, got %v", got)
+	}
+	if got := rec.Header().Values("Set-Cookie"); len(got) != 2 {
+		t.Errorf("expected 2 cookies, got %v", got)
+	}
+	if got := rec.Header().Get(ContentTypeKey); got != JsonContentType {
+		t.Errorf("expected the content type of the encoder, got %q", got)
+	}
+}
+
+func TestResponseMetadataWithoutHandler(t *testing.T) {
+	ctx := context.Background()
+	// outside of a generated handler the helpers do nothing
+	SetResponseHeader(ctx, "Location", "/v1/users/1")
+	SetStatusCode(ctx, http.StatusCreated)
+
+	rec := httptest.NewRecorder()
+	WriteResponseHeader(ctx, rec, http.StatusOK)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status code %d, got %d", http.StatusOK, rec.Code)
+	}
+	if got := rec.Header().Get("Location"); got != "" {
+		t.Errorf("expected no Location header, got %q", got)
+	}
+}
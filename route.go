@@ -1,9 +1,21 @@
 package goose
 
-import "context"
+import (
+	"context"
+	"net/http"
+	"strings"
+)
 
 type routeInfoKey struct{}
 
+// Router registers the handlers of routes, *http.ServeMux satisfies it.
+type Router interface {
+	// Handle registers the handler for the given pattern.
+	Handle(pattern string, handler http.Handler)
+}
+
+var _ Router = (*http.ServeMux)(nil)
+
 // RouteInfo is a struct that holds information about a route.
 type RouteInfo struct {
 	// HttpMethod is the HTTP method of the route.
@@ -24,3 +36,24 @@ func ExtractRouteInfo(ctx context.Context) (*RouteInfo, bool) {
 func InjectRouteInfo(ctx context.Context, routeInfo *RouteInfo) context.Context {
 	return context.WithValue(ctx, routeInfoKey{}, routeInfo)
 }
+
+// WithPrefix returns a copy of the route info whose pattern is mounted under prefix,
+// the route info itself if prefix is empty. prefix is expected to be cleaned by CleanPrefix.
+func (info *RouteInfo) WithPrefix(prefix string) *RouteInfo {
+	if prefix == "" {
+		return info
+	}
+	prefixed := *info
+	prefixed.Pattern = prefix + info.Pattern
+	return &prefixed
+}
+
+// CleanPrefix returns prefix with a leading slash and without trailing slashes,
+// an empty string if prefix is the root.
+func CleanPrefix(prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return ""
+	}
+	return "/" + prefix
+}
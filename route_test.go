@@ -0,0 +1,32 @@
+package goose
+
+import "testing"
+
+func TestCleanPrefix(t *testing.T) {
+	tests := map[string]string{
+		"":          "",
+		"/":         "",
+		"api":       "/api",
+		"/api/":     "/api",
+		"//api/v1/": "/api/v1",
+	}
+	for prefix, want := range tests {
+		if got := CleanPrefix(prefix); got != want {
+			t.Errorf("CleanPrefix(%q) = %q, want %q", prefix, got, want)
+		}
+	}
+}
+
+func TestRouteInfoWithPrefix(t *testing.T) {
+	info := &RouteInfo{HttpMethod: "GET", Pattern: "/v1/users/{id}", FullMethod: "/user.v1.User/GetUser"}
+	if got := info.WithPrefix(""); got != info {
+		t.Errorf("WithPrefix(\"\") should return the route info itself")
+	}
+	got := info.WithPrefix("/api")
+	if got.Pattern != "/api/v1/users/{id}" || got.HttpMethod != "GET" || got.FullMethod != info.FullMethod {
+		t.Errorf("unexpected prefixed route info: %+v", got)
+	}
+	if info.Pattern != "/v1/users/{id}" {
+		t.Errorf("WithPrefix modified the route info: %+v", info)
+	}
+}
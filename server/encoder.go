@@ -12,7 +12,8 @@ import (
 )
 
 // EncodeResponse encodes a protobuf message as JSON into an HTTP response.
-// Sets Content-Type to application/json and status code to 200 OK.
+// Sets Content-Type to application/json and status code to 200 OK, unless the service
+// sets them by goose.SetResponseHeader and goose.SetStatusCode.
 //
 // Parameters:
 //
@@ -27,7 +28,7 @@ import (
 func EncodeResponse(ctx context.Context, response http.ResponseWriter, resp proto.Message, marshalOptions protojson.MarshalOptions) error {
 	// Set response headers for JSON content and HTTP 200 status
 	response.Header().Set(goose.ContentTypeKey, goose.JsonContentType)
-	response.WriteHeader(http.StatusOK)
+	goose.WriteResponseHeader(ctx, response, http.StatusOK)
 
 	// Marshal the protocol buffer message into JSON
 	data, err := marshalOptions.Marshal(resp)
@@ -44,7 +45,8 @@ func EncodeResponse(ctx context.Context, response http.ResponseWriter, resp prot
 }
 
 // EncodeHttpBody encodes an httpbody.HttpBody into an HTTP response.
-// Sets Content-Type from the HttpBody and status code to 200 OK.
+// Sets Content-Type from the HttpBody and status code to 200 OK, unless the service
+// sets them by goose.SetResponseHeader and goose.SetStatusCode.
 //
 // Parameters:
 //
@@ -58,7 +60,7 @@ func EncodeResponse(ctx context.Context, response http.ResponseWriter, resp prot
 func EncodeHttpBody(ctx context.Context, response http.ResponseWriter, resp *httpbody.HttpBody) error {
 	// Set response headers
 	response.Header().Set(goose.ContentTypeKey, resp.GetContentType())
-	response.WriteHeader(http.StatusOK)
+	goose.WriteResponseHeader(ctx, response, http.StatusOK)
 
 	// Write response data
 	if _, err := response.Write(resp.GetData()); err != nil {
@@ -68,7 +70,8 @@ func EncodeHttpBody(ctx context.Context, response http.ResponseWriter, resp *htt
 }
 
 // EncodeHttpResponse encodes an rpchttp.HttpResponse into an HTTP response.
-// Sets headers, status code and body from the HttpResponse.
+// Sets headers, status code and body from the HttpResponse, the headers and status code
+// set by goose.SetResponseHeader and goose.SetStatusCode take precedence.
 //
 // Parameters:
 //
@@ -87,7 +90,7 @@ func EncodeHttpResponse(ctx context.Context, response http.ResponseWriter, resp
 	}
 
 	// Write HTTP status code before body
-	response.WriteHeader(int(resp.GetStatus()))
+	goose.WriteResponseHeader(ctx, response, int(resp.GetStatus()))
 
 	// Write response body and return any write errors
 	if _, err := response.Write(resp.GetBody()); err != nil {
@@ -100,3 +100,39 @@ func TestEncodeHttpResponse(t *testing.T) {
 		t.Errorf("body = %q, want %q", body, "abc")
 	}
 }
+
+func TestEncodeResponseMetadata(t *testing.T) {
+	ctx := goose.InjectResponseMetadata(context.Background(), new(goose.ResponseMetadata))
+	goose.SetStatusCode(ctx, http.StatusCreated)
+	goose.SetResponseHeader(ctx, "ETag", `"v1"`)
+
+	rr := httptest.NewRecorder()
+	if err := EncodeResponse(ctx, rr, &httpbody.HttpBody{}, protojson.MarshalOptions{}); err != nil {
+		t.Fatalf("EncodeResponse error: %v", err)
+	}
+	if rr.Code != http.StatusCreated {
+		t.Errorf("status = %d, want %d", rr.Code, http.StatusCreated)
+	}
+	if got := rr.Header().Get("ETag"); got != `"v1"` {
+		t.Errorf("ETag header = %q, want %q", got, `"v1"`)
+	}
+	if got := rr.Header().Get(goose.ContentTypeKey); got != goose.JsonContentType {
+		t.Errorf("Content-Type = %q, want %q", got, goose.JsonContentType)
+	}
+
+	rr = httptest.NewRecorder()
+	if err := EncodeHttpBody(ctx, rr, &httpbody.HttpBody{ContentType: "text/plain"}); err != nil {
+		t.Fatalf("EncodeHttpBody error: %v", err)
+	}
+	if rr.Code != http.StatusCreated || rr.Header().Get("ETag") != `"v1"` {
+		t.Errorf("unexpected status %d and headers %v", rr.Code, rr.Header())
+	}
+
+	rr = httptest.NewRecorder()
+	if err := EncodeHttpResponse(ctx, rr, &rpchttp.HttpResponse{Status: 200}); err != nil {
+		t.Fatalf("EncodeHttpResponse error: %v", err)
+	}
+	if rr.Code != http.StatusCreated || rr.Header().Get("ETag") != `"v1"` {
+		t.Errorf("unexpected status %d and headers %v", rr.Code, rr.Header())
+	}
+}
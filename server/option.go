@@ -24,6 +24,9 @@ type Options interface {
 
 	// OnValidationErrCallback returns the validation error callback
 	OnValidationErrCallback() goose.OnValidationErrCallback
+
+	// Prefix returns the prefix of the route patterns
+	Prefix() string
 }
 
 // options holds the configuration options for the server
@@ -34,6 +37,7 @@ type options struct {
 	middlewares             []Middleware                  // Middlewares applied to requests
 	shouldFailFast          bool                          // Flag indicating if fail-fast mode is enabled
 	onValidationErrCallback goose.OnValidationErrCallback // Callback for validation errors
+	prefix                  string                        // Prefix of the route patterns
 }
 
 // Option defines a function type for modifying server options
@@ -101,6 +105,14 @@ func (o *options) OnValidationErrCallback() goose.OnValidationErrCallback {
 	return o.onValidationErrCallback
 }
 
+// Prefix returns the prefix of the route patterns
+//
+// Returns:
+//   - string: The prefix, empty if routes are mounted at the root
+func (o *options) Prefix() string {
+	return o.prefix
+}
+
 // UnmarshalOptions sets the protojson unmarshal options used for decoding requests
 //
 // Parameters:
@@ -176,6 +188,19 @@ func FailFast() Option {
 	}
 }
 
+// Prefix mounts the routes under prefix, the pattern of their route info is prefixed too
+//
+// Parameters:
+//   - prefix: The prefix of the route patterns, such as "/api"
+//
+// Returns:
+//   - Option: A function that sets the prefix
+func Prefix(prefix string) Option {
+	return func(o *options) {
+		o.prefix = goose.CleanPrefix(prefix)
+	}
+}
+
 // NewOptions creates a new Options instance with default values and applies the provided options
 //
 // Parameters:
@@ -63,3 +63,12 @@ func TestOptions_Apply(t *testing.T) {
 		t.Errorf("Apply did not set MarshalOptions.UseProtoNames")
 	}
 }
+
+func TestOptions_Prefix(t *testing.T) {
+	if prefix := NewOptions().Prefix(); prefix != "" {
+		t.Errorf("default Prefix = %q, want empty", prefix)
+	}
+	if prefix := NewOptions(Prefix("/api/v1/")).Prefix(); prefix != "/api/v1" {
+		t.Errorf("Prefix = %q, want /api/v1", prefix)
+	}
+}
@@ -129,6 +129,21 @@ func GetBoolSlice[Bool ~bool](form url.Values, key string) ([]Bool, error) {
 	return ParseBoolSlice[Bool](form[key])
 }
 
+// GetBoolMap retrieves and parses a map with boolean values from URL form values.
+// Entries are keyed as "key.<map key>", e.g. "flags.beta=true".
+// If no entry exists, returns nil map.
+//
+// Parameters:
+//   - form: the URL form values
+//   - key: the key of the map field
+//
+// Returns:
+//   - map[K]Bool: the parsed boolean map
+//   - error: if any key or value fails to parse
+func GetBoolMap[K MapKey, Bool ~bool](form url.Values, key string) (map[K]Bool, error) {
+	return getMap[K](form, key, ParseBool[Bool])
+}
+
 // GetBoolValue retrieves a boolean value wrapped in protobuf BoolValue.
 //
 // Parameters:
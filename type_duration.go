@@ -0,0 +1,134 @@
+package goose
+
+import (
+	"net/url"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// FormatDuration converts a protobuf Duration to its protojson string representation, e.g. "1.5s".
+//
+// Parameters:
+//
+//	d - protobuf Duration to convert
+//
+// Returns:
+//
+//	string - protojson representation of the duration,
+//	         returns an empty string if d is nil or out of the Duration range
+func FormatDuration(d *durationpb.Duration) string {
+	if d == nil {
+		return ""
+	}
+	data, err := protojson.Marshal(d)
+	if err != nil {
+		return ""
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// FormatDurationSlice converts a slice of protobuf Durations to a slice of their protojson string representations.
+//
+// Parameters:
+//
+//	s - slice of protobuf Durations to convert
+//
+// Returns:
+//
+//	[]string - slice of protojson representations,
+//	           returns nil if input slice is nil
+func FormatDurationSlice(s []*durationpb.Duration) []string {
+	if s == nil {
+		return nil
+	}
+	r := make([]string, 0, len(s))
+	for _, d := range s {
+		r = append(r, FormatDuration(d))
+	}
+	return r
+}
+
+// ParseDuration converts a protojson duration string, e.g. "1.5s" or "-3s", to a protobuf Duration.
+// An empty string is parsed as a nil Duration.
+//
+// Parameters:
+//   - s: the string to be parsed
+//
+// Returns:
+//   - *durationpb.Duration: the parsed duration
+//   - error: if parsing fails
+func ParseDuration(s string) (*durationpb.Duration, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d := &durationpb.Duration{}
+	if err := protojson.Unmarshal([]byte(strconv.Quote(s)), d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ParseDurationSlice converts a slice of protojson duration strings to a slice of protobuf Durations.
+// Returns nil if the input slice is nil. Empty strings are skipped, a nil element would be
+// marshaled as a zero duration.
+//
+// Parameters:
+//   - s: the string slice to be parsed
+//
+// Returns:
+//   - []*durationpb.Duration: the parsed duration slice
+//   - error: if any element fails to parse
+func ParseDurationSlice(s []string) ([]*durationpb.Duration, error) {
+	if s == nil {
+		return nil, nil
+	}
+	r := make([]*durationpb.Duration, 0, len(s))
+	for _, str := range s {
+		if str == "" {
+			continue
+		}
+		d, err := ParseDuration(str)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, d)
+	}
+	return r, nil
+}
+
+// GetDuration retrieves and parses a protojson duration string from URL form values.
+// If the key doesn't exist or its value is empty, returns nil.
+//
+// Parameters:
+//   - form: the URL form values
+//   - key: the key to look up in form values
+//
+// Returns:
+//   - *durationpb.Duration: the parsed duration
+//   - error: if parsing fails
+func GetDuration(form url.Values, key string) (*durationpb.Duration, error) {
+	return ParseDuration(form.Get(key))
+}
+
+// GetDurationSlice retrieves and parses a slice of protojson duration strings from URL form values.
+// If the key doesn't exist, returns nil slice.
+//
+// Parameters:
+//   - form: the URL form values
+//   - key: the key to look up in form values
+//
+// Returns:
+//   - []*durationpb.Duration: the parsed duration slice
+//   - error: if any element fails to parse
+func GetDurationSlice(form url.Values, key string) ([]*durationpb.Duration, error) {
+	if _, ok := form[key]; !ok {
+		return nil, nil
+	}
+	return ParseDurationSlice(form[key])
+}
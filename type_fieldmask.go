@@ -0,0 +1,107 @@
+package goose
+
+import (
+	"net/url"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FormatFieldMask converts a protobuf FieldMask to its comma-separated paths, e.g. "name,address.city".
+//
+// Parameters:
+//
+//	m - protobuf FieldMask to convert
+//
+// Returns:
+//
+//	string - comma-separated paths, returns an empty string if m is nil
+func FormatFieldMask(m *fieldmaskpb.FieldMask) string {
+	return strings.Join(m.GetPaths(), ",")
+}
+
+// FormatFieldMaskSlice converts a slice of protobuf FieldMasks to a slice of their comma-separated paths.
+//
+// Parameters:
+//
+//	s - slice of protobuf FieldMasks to convert
+//
+// Returns:
+//
+//	[]string - slice of comma-separated paths,
+//	           returns nil if input slice is nil
+func FormatFieldMaskSlice(s []*fieldmaskpb.FieldMask) []string {
+	if s == nil {
+		return nil
+	}
+	r := make([]string, 0, len(s))
+	for _, m := range s {
+		r = append(r, FormatFieldMask(m))
+	}
+	return r
+}
+
+// ParseFieldMask converts comma-separated paths to a protobuf FieldMask.
+// Surrounding spaces of each path are trimmed and empty paths are dropped,
+// an empty string is parsed as a nil FieldMask.
+//
+// Parameters:
+//   - s: the string to be parsed
+//
+// Returns:
+//   - *fieldmaskpb.FieldMask: the parsed field mask
+func ParseFieldMask(s string) *fieldmaskpb.FieldMask {
+	if s == "" {
+		return nil
+	}
+	m := &fieldmaskpb.FieldMask{}
+	for _, path := range strings.Split(s, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			m.Paths = append(m.Paths, path)
+		}
+	}
+	return m
+}
+
+// GetFieldMask retrieves a protobuf FieldMask from URL form values.
+// All values of the key are merged, so both "mask=a,b" and "mask=a&mask=b" are accepted.
+// If the key doesn't exist, returns nil.
+//
+// Parameters:
+//   - form: the URL form values
+//   - key: the key to look up in form values
+//
+// Returns:
+//   - *fieldmaskpb.FieldMask: the parsed field mask
+//   - error: always nil, present to match the FormGetter signature
+func GetFieldMask(form url.Values, key string) (*fieldmaskpb.FieldMask, error) {
+	if _, ok := form[key]; !ok {
+		return nil, nil
+	}
+	return ParseFieldMask(strings.Join(form[key], ",")), nil
+}
+
+// GetFieldMaskSlice retrieves a slice of protobuf FieldMasks from URL form values,
+// each value of the key is parsed as one FieldMask, empty values are skipped.
+// If the key doesn't exist, returns nil slice.
+//
+// Parameters:
+//   - form: the URL form values
+//   - key: the key to look up in form values
+//
+// Returns:
+//   - []*fieldmaskpb.FieldMask: the parsed field mask slice
+//   - error: always nil, present to match the FormGetter signature
+func GetFieldMaskSlice(form url.Values, key string) ([]*fieldmaskpb.FieldMask, error) {
+	if _, ok := form[key]; !ok {
+		return nil, nil
+	}
+	r := make([]*fieldmaskpb.FieldMask, 0, len(form[key]))
+	for _, s := range form[key] {
+		if s == "" {
+			continue
+		}
+		r = append(r, ParseFieldMask(s))
+	}
+	return r, nil
+}
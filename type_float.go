@@ -152,6 +152,24 @@ func GetFloatSlice[Float constraints.Float](form url.Values, key string) ([]Floa
 	return ParseFloatSlice[Float](form[key], 64)
 }
 
+// GetFloatMap retrieves and parses a map with float values from URL form values.
+// Entries are keyed as "key.<map key>", e.g. "weights.a=0.5".
+// If no entry exists, returns nil map.
+// Uses ParseFloat with 64 bit size for conversion.
+//
+// Parameters:
+//
+//	form - the URL form values
+//	key - the key of the map field
+//
+// Returns:
+//
+//	map[K]Float - the parsed float map
+//	error - if any key or value fails to parse
+func GetFloatMap[K MapKey, Float constraints.Float](form url.Values, key string) (map[K]Float, error) {
+	return getMap[K](form, key, func(s string) (Float, error) { return ParseFloat[Float](s, 64) })
+}
+
 // GetFloat32Value retrieves a float32 value wrapped in protobuf FloatValue.
 //
 // Parameters:
@@ -151,6 +151,24 @@ func GetIntSlice[Signed constraints.Signed](form url.Values, key string) ([]Sign
 	return ParseIntSlice[Signed](form[key], 10, 64)
 }
 
+// GetIntMap retrieves and parses a map with signed integer values from URL form values.
+// Entries are keyed as "key.<map key>", e.g. "limits.cpu=2".
+// If no entry exists, returns nil map.
+// Uses ParseInt with base 10 and 64 bit size for conversion.
+//
+// Parameters:
+//
+//	form - the URL form values
+//	key - the key of the map field
+//
+// Returns:
+//
+//	map[K]Signed - the parsed integer map
+//	error - if any key or value fails to parse
+func GetIntMap[K MapKey, Signed constraints.Signed](form url.Values, key string) (map[K]Signed, error) {
+	return getMap[K](form, key, func(s string) (Signed, error) { return ParseInt[Signed](s, 10, 64) })
+}
+
 // GetInt32Value retrieves an int32 value wrapped in protobuf Int32Value.
 //
 // Parameters:
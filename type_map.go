@@ -0,0 +1,130 @@
+package goose
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MapKey is the constraint of the Go types generated for protobuf map keys.
+type MapKey interface {
+	string | bool | int32 | int64 | uint32 | uint64
+}
+
+// FormatMapKey converts a protobuf map key to its string representation.
+//
+// Parameters:
+//
+//	k - map key to convert
+//
+// Returns:
+//
+//	string - string representation of the map key
+func FormatMapKey[K MapKey](k K) string {
+	switch v := any(k).(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	}
+	return ""
+}
+
+// ParseMapKey converts a string to a protobuf map key of the specified type.
+//
+// Parameters:
+//   - s: the string to be parsed
+//
+// Returns:
+//   - K: the parsed map key
+//   - error: if parsing fails
+func ParseMapKey[K MapKey](s string) (K, error) {
+	var k K
+	var err error
+	switch p := any(&k).(type) {
+	case *string:
+		*p = s
+	case *bool:
+		*p, err = strconv.ParseBool(s)
+	case *int32:
+		*p, err = ParseInt[int32](s, 10, 32)
+	case *int64:
+		*p, err = ParseInt[int64](s, 10, 64)
+	case *uint32:
+		*p, err = ParseUint[uint32](s, 10, 32)
+	case *uint64:
+		*p, err = ParseUint[uint64](s, 10, 64)
+	}
+	return k, err
+}
+
+// MapFormKey returns the form key of a map entry, entries of the map field "labels"
+// are keyed as "labels.<key>", e.g. "labels.env". FormFromQuery rewrites the
+// equivalent deepObject notation "labels[env]" to the same key.
+//
+// Parameters:
+//
+//	key - form key of the map field
+//	mapKey - formatted key of the map entry
+//
+// Returns:
+//
+//	string - form key of the map entry
+func MapFormKey(key string, mapKey string) string {
+	return key + "." + mapKey
+}
+
+// HasMapForm reports whether form holds an entry of the map field key, entries are keyed
+// as MapFormKey writes them and the map field itself never is a key of the form.
+//
+// Parameters:
+//
+//	form - URL form values
+//	key - form key of the map field
+//
+// Returns:
+//
+//	bool - true if one of the keys of form is the key of an entry of the map field
+func HasMapForm(form url.Values, key string) bool {
+	prefix := MapFormKey(key, "")
+	for formKey := range form {
+		if strings.HasPrefix(formKey, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getMap collects the entries of a map field from URL form values.
+// If no entry exists, returns nil map.
+func getMap[K MapKey, V any](form url.Values, key string, parse func(s string) (V, error)) (map[K]V, error) {
+	prefix := MapFormKey(key, "")
+	var m map[K]V
+	for formKey, values := range form {
+		mapKey, ok := strings.CutPrefix(formKey, prefix)
+		if !ok || len(values) == 0 {
+			continue
+		}
+		k, err := ParseMapKey[K](mapKey)
+		if err != nil {
+			return nil, err
+		}
+		v, err := parse(values[0])
+		if err != nil {
+			return nil, err
+		}
+		if m == nil {
+			m = make(map[K]V)
+		}
+		m[k] = v
+	}
+	return m, nil
+}
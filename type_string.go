@@ -1,6 +1,10 @@
 package goose
 
-import "google.golang.org/protobuf/types/known/wrapperspb"
+import (
+	"net/url"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
 
 // ParseBytesSlice converts a slice of strings to a slice of byte slices.
 // Returns nil if the input slice is nil.
@@ -23,6 +27,23 @@ func ParseBytesSlice(s []string) [][]byte {
 	return r
 }
 
+// GetStringMap retrieves a map with string values from URL form values.
+// Entries are keyed as "key.<map key>", e.g. "labels.env=prod".
+// If no entry exists, returns nil map.
+//
+// Parameters:
+//
+//	form - the URL form values
+//	key - the key of the map field
+//
+// Returns:
+//
+//	map[K]string - the string map
+//	error - if any key fails to parse
+func GetStringMap[K MapKey](form url.Values, key string) (map[K]string, error) {
+	return getMap[K](form, key, func(s string) (string, error) { return s, nil })
+}
+
 // WrapStringSlice converts a slice of string values into a slice of StringValue wrappers.
 //
 // Parameters:
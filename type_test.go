@@ -7,6 +7,10 @@ import (
 	"reflect"
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -1100,3 +1104,242 @@ func TestGetFloat64ValueSlice(t *testing.T) {
 		t.Errorf("GetFloat64ValueSlice(a) = %v, %v; want %v, nil", got, err, want)
 	}
 }
+
+func TestGetTimestamp(t *testing.T) {
+	form := url.Values{}
+	form.Set("a", "2024-01-02T03:04:05.5+08:00")
+	got, err := GetTimestamp(form, "a")
+	want := &timestamppb.Timestamp{Seconds: 1704135845, Nanos: 500000000}
+	if err != nil || !proto.Equal(got, want) {
+		t.Errorf("GetTimestamp(a) = %v, %v; want %v, nil", got, err, want)
+	}
+	if got, err := GetTimestamp(form, "notfound"); err != nil || got != nil {
+		t.Errorf("GetTimestamp(notfound) = %v, %v; want nil, nil", got, err)
+	}
+	form.Set("b", "2024-01-02")
+	if _, err := GetTimestamp(form, "b"); err == nil {
+		t.Errorf("GetTimestamp(b) should return error")
+	}
+}
+
+func TestGetTimestampSlice(t *testing.T) {
+	form := url.Values{}
+	form["a"] = []string{"1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"}
+	got, err := GetTimestampSlice(form, "a")
+	want := []*timestamppb.Timestamp{{Seconds: 1}, {Seconds: 2}}
+	if err != nil || len(got) != len(want) || !proto.Equal(got[0], want[0]) || !proto.Equal(got[1], want[1]) {
+		t.Errorf("GetTimestampSlice(a) = %v, %v; want %v, nil", got, err, want)
+	}
+	if got, err := GetTimestampSlice(form, "notfound"); err != nil || got != nil {
+		t.Errorf("GetTimestampSlice(notfound) = %v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestGetWellKnownSliceEmptyValues(t *testing.T) {
+	// empty values are skipped instead of becoming nil elements, which protojson marshals
+	// as the epoch or a zero duration
+	form := url.Values{}
+	form["t"] = []string{"1970-01-01T00:00:01Z", ""}
+	form["d"] = []string{"", "1s"}
+	form["m"] = []string{"name", ""}
+	timestamps, err := GetTimestampSlice(form, "t")
+	if err != nil || len(timestamps) != 1 || !proto.Equal(timestamps[0], &timestamppb.Timestamp{Seconds: 1}) {
+		t.Errorf("GetTimestampSlice(t) = %v, %v; want [1s], nil", timestamps, err)
+	}
+	durations, err := GetDurationSlice(form, "d")
+	if err != nil || len(durations) != 1 || !proto.Equal(durations[0], &durationpb.Duration{Seconds: 1}) {
+		t.Errorf("GetDurationSlice(d) = %v, %v; want [1s], nil", durations, err)
+	}
+	masks, err := GetFieldMaskSlice(form, "m")
+	if err != nil || len(masks) != 1 || !proto.Equal(masks[0], &fieldmaskpb.FieldMask{Paths: []string{"name"}}) {
+		t.Errorf("GetFieldMaskSlice(m) = %v, %v; want [name], nil", masks, err)
+	}
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	if got := FormatTimestamp(&timestamppb.Timestamp{Seconds: 1704135845, Nanos: 500000000}); got != "2024-01-01T19:04:05.5Z" {
+		t.Errorf("FormatTimestamp() = %v, want 2024-01-01T19:04:05.5Z", got)
+	}
+	if got := FormatTimestamp(nil); got != "" {
+		t.Errorf("FormatTimestamp(nil) = %v, want empty string", got)
+	}
+}
+
+func TestGetDuration(t *testing.T) {
+	form := url.Values{}
+	form.Set("a", "1.5s")
+	got, err := GetDuration(form, "a")
+	want := &durationpb.Duration{Seconds: 1, Nanos: 500000000}
+	if err != nil || !proto.Equal(got, want) {
+		t.Errorf("GetDuration(a) = %v, %v; want %v, nil", got, err, want)
+	}
+	if got, err := GetDuration(form, "notfound"); err != nil || got != nil {
+		t.Errorf("GetDuration(notfound) = %v, %v; want nil, nil", got, err)
+	}
+	form.Set("b", "1h")
+	if _, err := GetDuration(form, "b"); err == nil {
+		t.Errorf("GetDuration(b) should return error")
+	}
+}
+
+func TestGetDurationSlice(t *testing.T) {
+	form := url.Values{}
+	form["a"] = []string{"1s", "-0.001s"}
+	got, err := GetDurationSlice(form, "a")
+	want := []*durationpb.Duration{{Seconds: 1}, {Nanos: -1000000}}
+	if err != nil || len(got) != len(want) || !proto.Equal(got[0], want[0]) || !proto.Equal(got[1], want[1]) {
+		t.Errorf("GetDurationSlice(a) = %v, %v; want %v, nil", got, err, want)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		name string
+		d    *durationpb.Duration
+		want string
+	}{
+		{"nil", nil, ""},
+		{"seconds", &durationpb.Duration{Seconds: 3}, "3s"},
+		{"fraction", &durationpb.Duration{Seconds: 1, Nanos: 500000000}, "1.500s"},
+		{"negative", &durationpb.Duration{Nanos: -1000000}, "-0.001s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatDuration(tt.d); got != tt.want {
+				t.Errorf("FormatDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetFieldMask(t *testing.T) {
+	form := url.Values{}
+	form.Set("a", "name, address.city,")
+	got, err := GetFieldMask(form, "a")
+	want := &fieldmaskpb.FieldMask{Paths: []string{"name", "address.city"}}
+	if err != nil || !proto.Equal(got, want) {
+		t.Errorf("GetFieldMask(a) = %v, %v; want %v, nil", got, err, want)
+	}
+	form["b"] = []string{"name", "age"}
+	got, err = GetFieldMask(form, "b")
+	want = &fieldmaskpb.FieldMask{Paths: []string{"name", "age"}}
+	if err != nil || !proto.Equal(got, want) {
+		t.Errorf("GetFieldMask(b) = %v, %v; want %v, nil", got, err, want)
+	}
+	if got, err := GetFieldMask(form, "notfound"); err != nil || got != nil {
+		t.Errorf("GetFieldMask(notfound) = %v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestGetFieldMaskSlice(t *testing.T) {
+	form := url.Values{}
+	form["a"] = []string{"name,age", "id"}
+	got, err := GetFieldMaskSlice(form, "a")
+	want := []*fieldmaskpb.FieldMask{{Paths: []string{"name", "age"}}, {Paths: []string{"id"}}}
+	if err != nil || len(got) != len(want) || !proto.Equal(got[0], want[0]) || !proto.Equal(got[1], want[1]) {
+		t.Errorf("GetFieldMaskSlice(a) = %v, %v; want %v, nil", got, err, want)
+	}
+}
+
+func TestFormatFieldMask(t *testing.T) {
+	if got := FormatFieldMask(&fieldmaskpb.FieldMask{Paths: []string{"name", "address.city"}}); got != "name,address.city" {
+		t.Errorf("FormatFieldMask() = %v, want name,address.city", got)
+	}
+	if got := FormatFieldMask(nil); got != "" {
+		t.Errorf("FormatFieldMask(nil) = %v, want empty string", got)
+	}
+}
+
+func TestGetStringMap(t *testing.T) {
+	form := url.Values{}
+	form.Set("labels.env", "prod")
+	form.Set("labels.team.name", "core")
+	form.Set("labelsx", "ignored")
+	got, err := GetStringMap[string](form, "labels")
+	want := map[string]string{"env": "prod", "team.name": "core"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("GetStringMap(labels) = %v, %v; want %v, nil", got, err, want)
+	}
+	if got, err := GetStringMap[string](form, "notfound"); err != nil || got != nil {
+		t.Errorf("GetStringMap(notfound) = %v, %v; want nil, nil", got, err)
+	}
+}
+
+func TestHasMapForm(t *testing.T) {
+	form := url.Values{}
+	form.Set("filter.labels.env", "prod")
+	form.Set("labelsx", "ignored")
+	if !HasMapForm(form, "filter.labels") {
+		t.Error("HasMapForm(filter.labels) = false, want true")
+	}
+	if HasMapForm(form, "filter.label") {
+		t.Error("HasMapForm(filter.label) = true, want false")
+	}
+	if HasMapForm(form, "labels") {
+		t.Error("HasMapForm(labels) = true, want false")
+	}
+}
+
+func TestGetIntMap(t *testing.T) {
+	form := url.Values{}
+	form.Set("a.1", "10")
+	form.Set("a.-2", "20")
+	got, err := GetIntMap[int64, int32](form, "a")
+	want := map[int64]int32{1: 10, -2: 20}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("GetIntMap(a) = %v, %v; want %v, nil", got, err, want)
+	}
+	form.Set("b.x", "1")
+	if _, err := GetIntMap[int32, int32](form, "b"); err == nil {
+		t.Errorf("GetIntMap[int32](b) should return error")
+	}
+	form.Set("c.x", "y")
+	if _, err := GetIntMap[string, int32](form, "c"); err == nil {
+		t.Errorf("GetIntMap(c) should return error")
+	}
+}
+
+func TestGetUintMap(t *testing.T) {
+	form := url.Values{}
+	form.Set("a.true", "1")
+	got, err := GetUintMap[bool, uint64](form, "a")
+	want := map[bool]uint64{true: 1}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("GetUintMap(a) = %v, %v; want %v, nil", got, err, want)
+	}
+}
+
+func TestGetFloatMap(t *testing.T) {
+	form := url.Values{}
+	form.Set("a.x", "0.5")
+	got, err := GetFloatMap[string, float64](form, "a")
+	want := map[string]float64{"x": 0.5}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("GetFloatMap(a) = %v, %v; want %v, nil", got, err, want)
+	}
+}
+
+func TestGetBoolMap(t *testing.T) {
+	form := url.Values{}
+	form.Set("a.7", "true")
+	got, err := GetBoolMap[uint32, bool](form, "a")
+	want := map[uint32]bool{7: true}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("GetBoolMap(a) = %v, %v; want %v, nil", got, err, want)
+	}
+}
+
+func TestFormatMapKey(t *testing.T) {
+	if got := FormatMapKey("env"); got != "env" {
+		t.Errorf("FormatMapKey(env) = %v, want env", got)
+	}
+	if got := FormatMapKey(int32(-3)); got != "-3" {
+		t.Errorf("FormatMapKey(-3) = %v, want -3", got)
+	}
+	if got := FormatMapKey(uint64(3)); got != "3" {
+		t.Errorf("FormatMapKey(3) = %v, want 3", got)
+	}
+	if got := FormatMapKey(true); got != "true" {
+		t.Errorf("FormatMapKey(true) = %v, want true", got)
+	}
+}
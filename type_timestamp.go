@@ -0,0 +1,128 @@
+package goose
+
+import (
+	"net/url"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FormatTimestamp converts a protobuf Timestamp to its RFC 3339 string representation.
+//
+// Parameters:
+//
+//	t - protobuf Timestamp to convert
+//
+// Returns:
+//
+//	string - RFC 3339 representation in UTC, returns an empty string if t is nil
+func FormatTimestamp(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().Format(time.RFC3339Nano)
+}
+
+// FormatTimestampSlice converts a slice of protobuf Timestamps to a slice of their RFC 3339 string representations.
+//
+// Parameters:
+//
+//	s - slice of protobuf Timestamps to convert
+//
+// Returns:
+//
+//	[]string - slice of RFC 3339 representations,
+//	           returns nil if input slice is nil
+func FormatTimestampSlice(s []*timestamppb.Timestamp) []string {
+	if s == nil {
+		return nil
+	}
+	r := make([]string, 0, len(s))
+	for _, t := range s {
+		r = append(r, FormatTimestamp(t))
+	}
+	return r
+}
+
+// ParseTimestamp converts an RFC 3339 string to a protobuf Timestamp.
+// An empty string is parsed as a nil Timestamp.
+//
+// Parameters:
+//   - s: the string to be parsed, e.g. "2006-01-02T15:04:05.999999999Z"
+//
+// Returns:
+//   - *timestamppb.Timestamp: the parsed timestamp
+//   - error: if parsing fails or the time is out of the Timestamp range
+func ParseTimestamp(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, err
+	}
+	v := timestamppb.New(t)
+	if err := v.CheckValid(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ParseTimestampSlice converts a slice of RFC 3339 strings to a slice of protobuf Timestamps.
+// Returns nil if the input slice is nil. Empty strings are skipped, a nil element would be
+// marshaled as the epoch.
+//
+// Parameters:
+//   - s: the string slice to be parsed
+//
+// Returns:
+//   - []*timestamppb.Timestamp: the parsed timestamp slice
+//   - error: if any element fails to parse
+func ParseTimestampSlice(s []string) ([]*timestamppb.Timestamp, error) {
+	if s == nil {
+		return nil, nil
+	}
+	r := make([]*timestamppb.Timestamp, 0, len(s))
+	for _, str := range s {
+		if str == "" {
+			continue
+		}
+		t, err := ParseTimestamp(str)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, t)
+	}
+	return r, nil
+}
+
+// GetTimestamp retrieves and parses an RFC 3339 timestamp from URL form values.
+// If the key doesn't exist or its value is empty, returns nil.
+//
+// Parameters:
+//   - form: the URL form values
+//   - key: the key to look up in form values
+//
+// Returns:
+//   - *timestamppb.Timestamp: the parsed timestamp
+//   - error: if parsing fails
+func GetTimestamp(form url.Values, key string) (*timestamppb.Timestamp, error) {
+	return ParseTimestamp(form.Get(key))
+}
+
+// GetTimestampSlice retrieves and parses a slice of RFC 3339 timestamps from URL form values.
+// If the key doesn't exist, returns nil slice.
+//
+// Parameters:
+//   - form: the URL form values
+//   - key: the key to look up in form values
+//
+// Returns:
+//   - []*timestamppb.Timestamp: the parsed timestamp slice
+//   - error: if any element fails to parse
+func GetTimestampSlice(form url.Values, key string) ([]*timestamppb.Timestamp, error) {
+	if _, ok := form[key]; !ok {
+		return nil, nil
+	}
+	return ParseTimestampSlice(form[key])
+}
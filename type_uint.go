@@ -151,6 +151,24 @@ func GetUintSlice[Unsigned constraints.Unsigned](form url.Values, key string) ([
 	return ParseUintSlice[Unsigned](form[key], 10, 64)
 }
 
+// GetUintMap retrieves and parses a map with unsigned integer values from URL form values.
+// Entries are keyed as "key.<map key>", e.g. "limits.cpu=2".
+// If no entry exists, returns nil map.
+// Uses ParseUint with base 10 and 64 bit size for conversion.
+//
+// Parameters:
+//
+//	form - the URL form values
+//	key - the key of the map field
+//
+// Returns:
+//
+//	map[K]Unsigned - the parsed unsigned integer map
+//	error - if any key or value fails to parse
+func GetUintMap[K MapKey, Unsigned constraints.Unsigned](form url.Values, key string) (map[K]Unsigned, error) {
+	return getMap[K](form, key, func(s string) (Unsigned, error) { return ParseUint[Unsigned](s, 10, 64) })
+}
+
 // GetUint32Value retrieves a uint32 value wrapped in protobuf UInt32Value.
 //
 // Parameters:
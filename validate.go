@@ -22,11 +22,32 @@ type OnValidationErrCallback func(ctx context.Context, err error)
 //
 // Behavior:
 //
-//	Based on fast parameter:
+//	First checks the google.api.field_behavior annotations by CheckFieldBehavior,
+//	rejecting requests missing REQUIRED fields, req is not modified.
+//	Then based on fast parameter:
 //	- fast=true: Attempts to call Validate() or Validate(false)
 //	- fast=false: Attempts to call ValidateAll() or Validate(true) or Validate()
 //	If validation fails and callback is provided, invokes the callback
-func ValidateRequest(ctx context.Context, req proto.Message, fast bool, callback OnValidationErrCallback) (err error) {
+func ValidateRequest(ctx context.Context, req proto.Message, fast bool, callback OnValidationErrCallback) error {
+	err := CheckFieldBehavior(req, fast)
+	if err == nil {
+		// validators assume the required fields are set
+		err = validate(req, fast)
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	if callback != nil {
+		callback(ctx, err)
+	}
+	return err
+}
+
+// validate calls the Validate, ValidateAll or Validate(all bool) method of req,
+// as generated by validator plugins.
+func validate(req proto.Message, fast bool) (err error) {
 	if fast {
 		switch v := req.(type) {
 		case interface{ Validate() error }:
@@ -44,13 +65,5 @@ func ValidateRequest(ctx context.Context, req proto.Message, fast bool, callback
 			err = v.Validate()
 		}
 	}
-
-	if err == nil {
-		return nil
-	}
-
-	if callback != nil {
-		callback(ctx, err)
-	}
 	return err
 }
@@ -1,48 +1,83 @@
 package ws
 
 import (
+	"log/slog"
+	"net/http"
+
+	"github.com/coder/websocket"
+	"github.com/soyacen/goose"
+	"github.com/soyacen/goose/client/resolver"
 	"github.com/soyacen/goose/server"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-/*
-	unmarshalOptions protojson.UnmarshalOptions
-	marshalOptions   protojson.MarshalOptions
-	middleware       server.Middleware
-	acptOpts         *websocket.AcceptOptions
-	cfg              *ws.ConnConfig
-	logger           *slog.Logger
-*/
-
-/*
-client
-	unmarshalOptions protojson.UnmarshalOptions
-	marshalOptions   protojson.MarshalOptions
-	dialOpts         *websocket.DialOptions
-	connCfg          *ws.ConnConfig
-	logger           *slog.Logger
-*/
-
-// Options interface defines methods to access all configurable options for the server
+// Options interface defines methods to access all configurable options for websocket routes and clients
 type Options interface {
-	// UnmarshalOptions returns the protojson unmarshal options used for decoding requests
+	// UnmarshalOptions returns the protojson unmarshal options used for decoding messages
 	UnmarshalOptions() protojson.UnmarshalOptions
 
-	// MarshalOptions returns the protojson marshal options used for encoding responses
+	// MarshalOptions returns the protojson marshal options used for encoding messages
 	MarshalOptions() protojson.MarshalOptions
 
 	// Middlewares returns the list of middlewares applied to requests
 	Middlewares() []server.Middleware
+
+	// AcceptOptions returns the options used for upgrading HTTP connections on the server side
+	AcceptOptions() *websocket.AcceptOptions
+
+	// DialOptions returns the options used for dialing websocket endpoints on the client side
+	DialOptions() *websocket.DialOptions
+
+	// ConnConfig returns the configuration of the managed websocket connections
+	ConnConfig() *ConnConfig
+
+	// Logger returns the logger used for reporting connection and stream errors
+	Logger() *slog.Logger
+
+	// Resolver returns the resolver used for resolving the target URL of clients
+	Resolver() resolver.Resolver
+
+	// Client returns the http client used for consuming server-sent events streams
+	Client() *http.Client
+
+	// EventIDFunc returns the function giving the ids of server-sent events
+	EventIDFunc() EventIDFunc
+
+	// ErrorEncoder returns the error encoder used for the errors of server-sent events
+	// requests, goose.DefaultEncodeError by default
+	ErrorEncoder() goose.ErrorEncoder
+
+	// ShouldFailFast indicates if fail-fast mode is enabled for validating server-sent
+	// events requests
+	ShouldFailFast() bool
+
+	// OnValidationErrCallback returns the validation error callback of server-sent events
+	// requests
+	OnValidationErrCallback() goose.OnValidationErrCallback
+
+	// Prefix returns the prefix of the route patterns
+	Prefix() string
 }
 
-// options holds the configuration options for the server
+// options holds the configuration options for websocket routes and clients
 type options struct {
-	unmarshalOptions protojson.UnmarshalOptions // Options for unmarshaling protobuf messages
-	marshalOptions   protojson.MarshalOptions   // Options for marshaling protobuf messages
-	middlewares      []server.Middleware        // Middlewares applied to requests
+	unmarshalOptions protojson.UnmarshalOptions    // Options for unmarshaling protobuf messages
+	marshalOptions   protojson.MarshalOptions      // Options for marshaling protobuf messages
+	middlewares      []server.Middleware           // Middlewares applied to requests
+	acceptOptions    *websocket.AcceptOptions      // Options for accepting websocket connections
+	dialOptions      *websocket.DialOptions        // Options for dialing websocket connections
+	connConfig       *ConnConfig                   // Configuration of websocket connections
+	logger           *slog.Logger                  // Logger for connection and stream errors
+	resolver         resolver.Resolver             // Resolver used for resolving URLs
+	client           *http.Client                  // Http client for server-sent events streams
+	eventID          EventIDFunc                   // Ids of server-sent events
+	errorEncoder     goose.ErrorEncoder            // Encoder for the errors of server-sent events requests
+	shouldFailFast   bool                          // Flag indicating if fail-fast mode is enabled
+	onValidationErr  goose.OnValidationErrCallback // Callback for validation errors
+	prefix           string                        // Prefix of the route patterns
 }
 
-// Option defines a function type for modifying server options
+// Option defines a function type for modifying websocket options
 type Option func(o *options)
 
 // apply applies the given options to the current options struct
@@ -59,7 +94,30 @@ func (o *options) apply(opts ...Option) *options {
 	return o
 }
 
-// UnmarshalOptions returns the protojson unmarshal options used for decoding requests
+// correct fills the options left nil with their defaults
+func (o *options) correct() *options {
+	if o.acceptOptions == nil {
+		o.acceptOptions = AcceptOptions()
+	}
+	if o.dialOptions == nil {
+		o.dialOptions = DialOptions()
+	}
+	if o.connConfig == nil {
+		o.connConfig = DefaultConnConfig()
+	}
+	if o.logger == nil {
+		o.logger = slog.Default()
+	}
+	if o.client == nil {
+		o.client = http.DefaultClient
+	}
+	if o.errorEncoder == nil {
+		o.errorEncoder = goose.DefaultEncodeError
+	}
+	return o
+}
+
+// UnmarshalOptions returns the protojson unmarshal options used for decoding messages
 //
 // Returns:
 //   - protojson.UnmarshalOptions: The unmarshal options
@@ -67,7 +125,7 @@ func (o *options) UnmarshalOptions() protojson.UnmarshalOptions {
 	return o.unmarshalOptions
 }
 
-// MarshalOptions returns the protojson marshal options used for encoding responses
+// MarshalOptions returns the protojson marshal options used for encoding messages
 //
 // Returns:
 //   - protojson.MarshalOptions: The marshal options
@@ -83,7 +141,95 @@ func (o *options) Middlewares() []server.Middleware {
 	return o.middlewares
 }
 
-// UnmarshalOptions sets the protojson unmarshal options used for decoding requests
+// AcceptOptions returns the options used for upgrading HTTP connections on the server side
+//
+// Returns:
+//   - *websocket.AcceptOptions: The accept options, AcceptOptions() by default
+func (o *options) AcceptOptions() *websocket.AcceptOptions {
+	return o.acceptOptions
+}
+
+// DialOptions returns the options used for dialing websocket endpoints on the client side
+//
+// Returns:
+//   - *websocket.DialOptions: The dial options, DialOptions() by default
+func (o *options) DialOptions() *websocket.DialOptions {
+	return o.dialOptions
+}
+
+// ConnConfig returns the configuration of the managed websocket connections
+//
+// Returns:
+//   - *ConnConfig: The connection config, DefaultConnConfig() by default
+func (o *options) ConnConfig() *ConnConfig {
+	return o.connConfig
+}
+
+// Logger returns the logger used for reporting connection and stream errors
+//
+// Returns:
+//   - *slog.Logger: The logger, slog.Default() by default
+func (o *options) Logger() *slog.Logger {
+	return o.logger
+}
+
+// Resolver returns the resolver used for resolving the target URL of clients
+//
+// Returns:
+//   - resolver.Resolver: The URL resolver, nil by default
+func (o *options) Resolver() resolver.Resolver {
+	return o.resolver
+}
+
+// Client returns the http client used for consuming server-sent events streams
+//
+// Returns:
+//   - *http.Client: The http client, http.DefaultClient by default
+func (o *options) Client() *http.Client {
+	return o.client
+}
+
+// EventIDFunc returns the function giving the ids of server-sent events
+//
+// Returns:
+//   - EventIDFunc: The event id function, nil by default for sequential ids
+func (o *options) EventIDFunc() EventIDFunc {
+	return o.eventID
+}
+
+// ErrorEncoder returns the error encoder used for the errors of server-sent events requests
+//
+// Returns:
+//   - goose.ErrorEncoder: The error encoder, goose.DefaultEncodeError by default
+func (o *options) ErrorEncoder() goose.ErrorEncoder {
+	return o.errorEncoder
+}
+
+// ShouldFailFast indicates if fail-fast mode is enabled for validating server-sent events requests
+//
+// Returns:
+//   - bool: True if fail-fast mode is enabled, false otherwise
+func (o *options) ShouldFailFast() bool {
+	return o.shouldFailFast
+}
+
+// OnValidationErrCallback returns the validation error callback of server-sent events requests
+//
+// Returns:
+//   - goose.OnValidationErrCallback: The validation error callback, nil by default
+func (o *options) OnValidationErrCallback() goose.OnValidationErrCallback {
+	return o.onValidationErr
+}
+
+// Prefix returns the prefix of the route patterns
+//
+// Returns:
+//   - string: The prefix, empty if routes are mounted at the root
+func (o *options) Prefix() string {
+	return o.prefix
+}
+
+// UnmarshalOptions sets the protojson unmarshal options used for decoding messages
 //
 // Parameters:
 //   - opts: The protojson unmarshal options to use
@@ -96,7 +242,7 @@ func UnmarshalOptions(opts protojson.UnmarshalOptions) Option {
 	}
 }
 
-// MarshalOptions sets the protojson marshal options used for encoding responses
+// MarshalOptions sets the protojson marshal options used for encoding messages
 //
 // Parameters:
 //   - opts: The protojson marshal options to use
@@ -122,6 +268,147 @@ func Middlewares(middlewares ...server.Middleware) Option {
 	}
 }
 
+// AcceptOpts sets the options used for upgrading HTTP connections on the server side
+//
+// Parameters:
+//   - opts: The websocket accept options to use
+//
+// Returns:
+//   - Option: A function that sets the accept options
+func AcceptOpts(opts *websocket.AcceptOptions) Option {
+	return func(o *options) {
+		o.acceptOptions = opts
+	}
+}
+
+// DialOpts sets the options used for dialing websocket endpoints on the client side
+//
+// Parameters:
+//   - opts: The websocket dial options to use
+//
+// Returns:
+//   - Option: A function that sets the dial options
+func DialOpts(opts *websocket.DialOptions) Option {
+	return func(o *options) {
+		o.dialOptions = opts
+	}
+}
+
+// Config sets the configuration of the managed websocket connections
+//
+// Parameters:
+//   - cfg: The connection config to use
+//
+// Returns:
+//   - Option: A function that sets the connection config
+func Config(cfg *ConnConfig) Option {
+	return func(o *options) {
+		o.connConfig = cfg
+	}
+}
+
+// Logger sets the logger used for reporting connection and stream errors
+//
+// Parameters:
+//   - logger: The logger to use
+//
+// Returns:
+//   - Option: A function that sets the logger
+func Logger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// Resolvers sets the resolver used for resolving the target URL of clients
+//
+// Parameters:
+//   - resolver: The URL resolver to use
+//
+// Returns:
+//   - Option: A function that sets the resolver
+func Resolvers(resolver resolver.Resolver) Option {
+	return func(o *options) {
+		o.resolver = resolver
+	}
+}
+
+// Client sets the http client used for consuming server-sent events streams
+//
+// Parameters:
+//   - client: The http client to use
+//
+// Returns:
+//   - Option: A function that sets the http client
+func Client(client *http.Client) Option {
+	return func(o *options) {
+		o.client = client
+	}
+}
+
+// EventID sets the function giving the ids of server-sent events
+//
+// Parameters:
+//   - f: The event id function to use
+//
+// Returns:
+//   - Option: A function that sets the event id function
+func EventID(f EventIDFunc) Option {
+	return func(o *options) {
+		o.eventID = f
+	}
+}
+
+// ErrorEncoder configures the error encoder of server-sent events requests, the errors of
+// websocket streams are logged as they can not be encoded to the upgraded connection
+//
+// Parameters:
+//   - encoder: The error encoder to use
+//
+// Returns:
+//   - Option: A function that sets the error encoder
+func ErrorEncoder(encoder goose.ErrorEncoder) Option {
+	return func(o *options) {
+		o.errorEncoder = encoder
+	}
+}
+
+// OnValidationErrCallback sets the validation error callback of server-sent events requests
+//
+// Parameters:
+//   - callback: The validation error callback to use
+//
+// Returns:
+//   - Option: A function that sets the validation error callback
+func OnValidationErrCallback(callback goose.OnValidationErrCallback) Option {
+	return func(o *options) {
+		o.onValidationErr = callback
+	}
+}
+
+// FailFast enables fail-fast mode for validating server-sent events requests
+//
+// Returns:
+//   - Option: A function that enables fail-fast mode
+func FailFast() Option {
+	return func(o *options) {
+		o.shouldFailFast = true
+	}
+}
+
+// Prefix mounts the routes under prefix, the pattern of their route info is prefixed too
+//
+// Parameters:
+//   - prefix: The prefix of the route patterns, such as "/api"
+//
+// Returns:
+//   - Option: A function that sets the prefix
+func Prefix(prefix string) Option {
+	return func(o *options) {
+		o.prefix = goose.CleanPrefix(prefix)
+	}
+}
+
 // NewOptions creates a new Options instance with default values and applies the provided options
 //
 // Parameters:
@@ -135,6 +422,6 @@ func NewOptions(opts ...Option) Options {
 		marshalOptions:   protojson.MarshalOptions{},
 		middlewares:      []server.Middleware{},
 	}
-	o = o.apply(opts...)
+	o = o.apply(opts...).correct()
 	return o
 }
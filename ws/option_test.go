@@ -0,0 +1,153 @@
+package ws
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/coder/websocket"
+	"github.com/soyacen/goose"
+	"github.com/soyacen/goose/server"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type mockResolver struct{}
+
+func (mockResolver) Resolve(ctx context.Context, target *url.URL) (*url.URL, error) {
+	return &url.URL{Scheme: "ws", Host: "127.0.0.1:8080"}, nil
+}
+
+func (mockResolver) Scheme() string {
+	return "discovery"
+}
+
+func TestOptions_Defaults(t *testing.T) {
+	opts := NewOptions()
+
+	if !reflect.DeepEqual(opts.UnmarshalOptions(), protojson.UnmarshalOptions{}) {
+		t.Errorf("default UnmarshalOptions not empty")
+	}
+	if !reflect.DeepEqual(opts.MarshalOptions(), protojson.MarshalOptions{}) {
+		t.Errorf("default MarshalOptions not empty")
+	}
+	if !reflect.DeepEqual(opts.AcceptOptions(), AcceptOptions()) {
+		t.Errorf("default AcceptOptions = %v, want %v", opts.AcceptOptions(), AcceptOptions())
+	}
+	if !reflect.DeepEqual(opts.DialOptions(), DialOptions()) {
+		t.Errorf("default DialOptions = %v, want %v", opts.DialOptions(), DialOptions())
+	}
+	if !reflect.DeepEqual(opts.ConnConfig(), DefaultConnConfig()) {
+		t.Errorf("default ConnConfig = %v, want %v", opts.ConnConfig(), DefaultConnConfig())
+	}
+	if opts.Logger() != slog.Default() {
+		t.Errorf("default Logger is not slog.Default()")
+	}
+	if opts.Resolver() != nil {
+		t.Errorf("default Resolver is not nil")
+	}
+	if opts.Client() != http.DefaultClient {
+		t.Errorf("default Client is not http.DefaultClient")
+	}
+	if opts.EventIDFunc() != nil {
+		t.Errorf("default EventIDFunc is not nil")
+	}
+	if reflect.ValueOf(opts.ErrorEncoder()).Pointer() != reflect.ValueOf(goose.DefaultEncodeError).Pointer() {
+		t.Errorf("default ErrorEncoder is not goose.DefaultEncodeError")
+	}
+	if opts.ShouldFailFast() {
+		t.Errorf("default ShouldFailFast is true")
+	}
+	if opts.OnValidationErrCallback() != nil {
+		t.Errorf("default OnValidationErrCallback is not nil")
+	}
+}
+
+func TestOptions_WithOptions(t *testing.T) {
+	unmarshalOpt := protojson.UnmarshalOptions{AllowPartial: true}
+	marshalOpt := protojson.MarshalOptions{EmitUnpopulated: true}
+	acceptOpts := &websocket.AcceptOptions{InsecureSkipVerify: true}
+	dialOpts := &websocket.DialOptions{}
+	cfg := &ConnConfig{WriteBufferSize: 1}
+	logger := slog.New(slog.DiscardHandler)
+	var middleware server.Middleware = server.Chain()
+	client := &http.Client{}
+	eventID := func(ctx context.Context, m proto.Message) string { return "id" }
+	var encoded, called bool
+	errorEncoder := func(ctx context.Context, err error, w http.ResponseWriter) { encoded = true }
+	callback := func(ctx context.Context, err error) { called = true }
+
+	opts := NewOptions(
+		UnmarshalOptions(unmarshalOpt),
+		MarshalOptions(marshalOpt),
+		Middlewares(middleware),
+		AcceptOpts(acceptOpts),
+		DialOpts(dialOpts),
+		Config(cfg),
+		Logger(logger),
+		Resolvers(mockResolver{}),
+		Client(client),
+		EventID(eventID),
+		ErrorEncoder(errorEncoder),
+		FailFast(),
+		OnValidationErrCallback(callback),
+		Prefix("api/"),
+	)
+
+	if !reflect.DeepEqual(opts.UnmarshalOptions(), unmarshalOpt) {
+		t.Errorf("UnmarshalOptions not set correctly")
+	}
+	if !reflect.DeepEqual(opts.MarshalOptions(), marshalOpt) {
+		t.Errorf("MarshalOptions not set correctly")
+	}
+	if len(opts.Middlewares()) != 1 {
+		t.Errorf("Middlewares length = %d, want 1", len(opts.Middlewares()))
+	}
+	if opts.AcceptOptions() != acceptOpts {
+		t.Errorf("AcceptOptions not set correctly")
+	}
+	if opts.DialOptions() != dialOpts {
+		t.Errorf("DialOptions not set correctly")
+	}
+	if opts.ConnConfig() != cfg {
+		t.Errorf("ConnConfig not set correctly")
+	}
+	if opts.Logger() != logger {
+		t.Errorf("Logger not set correctly")
+	}
+	if _, ok := opts.Resolver().(mockResolver); !ok {
+		t.Errorf("Resolver not set correctly")
+	}
+	if opts.Client() != client {
+		t.Errorf("Client not set correctly")
+	}
+	if opts.EventIDFunc()(context.Background(), nil) != "id" {
+		t.Errorf("EventIDFunc not set correctly")
+	}
+	if opts.ErrorEncoder()(context.Background(), nil, nil); !encoded {
+		t.Errorf("ErrorEncoder not set correctly")
+	}
+	if !opts.ShouldFailFast() {
+		t.Errorf("ShouldFailFast not set correctly")
+	}
+	if opts.OnValidationErrCallback()(context.Background(), nil); !called {
+		t.Errorf("OnValidationErrCallback not set correctly")
+	}
+	if opts.Prefix() != "/api" {
+		t.Errorf("Prefix = %q, want /api", opts.Prefix())
+	}
+}
+
+func TestResolveTarget(t *testing.T) {
+	got, err := ResolveTarget(context.Background(), nil, "ws://localhost:8080")
+	if err != nil || got != "ws://localhost:8080" {
+		t.Errorf("ResolveTarget(nil) = %v, %v; want ws://localhost:8080, nil", got, err)
+	}
+	got, err = ResolveTarget(context.Background(), mockResolver{}, "discovery://chat")
+	if err != nil || got != "ws://127.0.0.1:8080" {
+		t.Errorf("ResolveTarget(mockResolver) = %v, %v; want ws://127.0.0.1:8080, nil", got, err)
+	}
+}
@@ -0,0 +1,393 @@
+package ws
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/soyacen/goose"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// EventStreamContentType is the content type of a server-sent events stream.
+	EventStreamContentType = "text/event-stream"
+	// LastEventIDKey is the header a reconnecting client sends the id of the last received event in.
+	LastEventIDKey = "Last-Event-ID"
+)
+
+const (
+	// EventTypeMessage is the type of the events carrying a response message.
+	EventTypeMessage = "message"
+	// EventTypeClose is the type of the event ending a stream that completed successfully.
+	EventTypeClose = "close"
+	// EventTypeError is the type of the event ending a stream whose handler returned an error.
+	EventTypeError = "error"
+)
+
+// EventIDFunc returns the id of the server-sent event carrying m.
+// Streams number their events 1, 2, 3... by default, continuing after a numeric Last-Event-ID.
+type EventIDFunc func(ctx context.Context, m proto.Message) string
+
+type lastEventIDKey struct{}
+
+// WithLastEventID returns a copy of ctx carrying the id of the last received event.
+// Clients send it as the Last-Event-ID header to resume a server-sent events stream.
+func WithLastEventID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, lastEventIDKey{}, id)
+}
+
+// LastEventID returns the id of the last event received by the client, the context of a
+// server-sent events stream carries the Last-Event-ID header of the request, so that
+// handlers can resume the stream after it.
+func LastEventID(ctx context.Context) string {
+	id, _ := ctx.Value(lastEventIDKey{}).(string)
+	return id
+}
+
+// IsWebsocketRequest reports whether the request asks for a websocket upgrade.
+func IsWebsocketRequest(request *http.Request) bool {
+	for _, value := range request.Header.Values("Upgrade") {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "websocket") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// EventStreamTarget returns the target of a client consuming server-sent events, ws and wss
+// targets are requested over http and https.
+func EventStreamTarget(target string) string {
+	if rest, ok := strings.CutPrefix(target, "ws://"); ok {
+		return "http://" + rest
+	}
+	if rest, ok := strings.CutPrefix(target, "wss://"); ok {
+		return "https://" + rest
+	}
+	return target
+}
+
+// EventError is the error of a stream ended by an error event, Data holds the data of the event.
+type EventError struct {
+	Data string
+}
+
+// Error implements error.
+func (e *EventError) Error() string {
+	return "ws: server-sent error event: " + e.Data
+}
+
+var _ ServerStreamingServer[*anypb.Any] = (*SSEServerStream[*anypb.Any])(nil)
+
+// SSEServerStream is the server side of a server-streaming RPC served as server-sent events.
+// Every response message is written as an event and flushed to the client.
+type SSEServerStream[Res proto.Message] struct {
+	ctx            context.Context
+	response       http.ResponseWriter
+	flusher        http.Flusher
+	marshalOptions protojson.MarshalOptions
+	eventID        EventIDFunc
+	seq            uint64
+	mu             sync.Mutex
+	closed         bool
+}
+
+// NewSSEServerStream writes the headers of a server-sent events stream and returns the stream.
+// The Last-Event-ID header of the request is available from the stream context via LastEventID.
+// It fails if neither response nor the writers it wraps implement http.Flusher.
+func NewSSEServerStream[Res proto.Message](
+	ctx context.Context,
+	response http.ResponseWriter,
+	request *http.Request,
+	marshalOpts protojson.MarshalOptions,
+	eventID EventIDFunc,
+) (*SSEServerStream[Res], error) {
+	flusher, ok := flusherOf(response)
+	if !ok {
+		return nil, errors.New("ws: response writer does not support flushing")
+	}
+	lastEventID := request.Header.Get(LastEventIDKey)
+	// default ids continue after the last event the client received.
+	seq, _ := strconv.ParseUint(lastEventID, 10, 64)
+	header := response.Header()
+	header.Set(goose.ContentTypeKey, EventStreamContentType)
+	header.Set("Cache-Control", "no-cache")
+	// keeps buffering proxies from holding the events back.
+	header.Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return &SSEServerStream[Res]{
+		ctx:            WithLastEventID(ctx, lastEventID),
+		response:       response,
+		flusher:        flusher,
+		marshalOptions: marshalOpts,
+		eventID:        eventID,
+		seq:            seq,
+	}, nil
+}
+
+// flusherOf returns the http.Flusher of w, looking through writers wrapped by middlewares.
+func flusherOf(w http.ResponseWriter) (http.Flusher, bool) {
+	for {
+		if flusher, ok := w.(http.Flusher); ok {
+			return flusher, true
+		}
+		unwrapper, ok := w.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return nil, false
+		}
+		w = unwrapper.Unwrap()
+	}
+}
+
+// Context returns the stream's context.
+func (s *SSEServerStream[Res]) Context() context.Context { return s.ctx }
+
+// Send writes m as a message event.
+func (s *SSEServerStream[Res]) Send(m Res) error {
+	return s.SendMsg(m)
+}
+
+// SendMsg writes m as a message event, its id is given by the EventIDFunc of the stream.
+func (s *SSEServerStream[Res]) SendMsg(m proto.Message) error {
+	data, err := s.marshalOptions.Marshal(m)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return io.ErrClosedPipe
+	}
+	s.seq++
+	id := strconv.FormatUint(s.seq, 10)
+	if s.eventID != nil {
+		id = s.eventID(s.ctx, m)
+	}
+	return s.writeEvent(id, EventTypeMessage, data)
+}
+
+// RecvMsg always returns io.EOF, the only request message of a server-streaming RPC is
+// decoded from the http request.
+func (s *SSEServerStream[Res]) RecvMsg(m proto.Message) error {
+	return io.EOF
+}
+
+// CloseSend ends the stream with a close event, telling the client not to reconnect.
+func (s *SSEServerStream[Res]) CloseSend() error {
+	return s.CloseWithError(nil)
+}
+
+// CloseWithError ends the stream with an error event holding err, or with a close event if err is nil.
+// The data of the error event is the JSON of err if it implements json.Marshaler, its message otherwise.
+func (s *SSEServerStream[Res]) CloseWithError(err error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	if err == nil {
+		return s.writeEvent("", EventTypeClose, []byte("{}"))
+	}
+	data := []byte(err.Error())
+	if marshaler, ok := err.(json.Marshaler); ok {
+		if body, marshalErr := marshaler.MarshalJSON(); marshalErr == nil {
+			data = body
+		}
+	}
+	return s.writeEvent("", EventTypeError, data)
+}
+
+// writeEvent writes one event and flushes it, every line of data gets its own data field.
+func (s *SSEServerStream[Res]) writeEvent(id string, event string, data []byte) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	var b strings.Builder
+	if id != "" {
+		b.WriteString("id: " + id + "\n")
+	}
+	b.WriteString("event: " + event + "\n")
+	for _, line := range strings.Split(string(data), "\n") {
+		b.WriteString("data: " + strings.TrimSuffix(line, "\r") + "\n")
+	}
+	b.WriteString("\n")
+	if _, err := io.WriteString(s.response, b.String()); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+var _ ServerStreamingClient[*anypb.Any] = (*SSEClientStream[*anypb.Any])(nil)
+
+// SSEClientStream is the client side of a server-streaming RPC consumed as server-sent events.
+type SSEClientStream[Res proto.Message] struct {
+	ctx              context.Context
+	cancel           context.CancelFunc
+	body             io.ReadCloser
+	reader           *bufio.Reader
+	unmarshalOptions protojson.UnmarshalOptions
+	lastEventID      string
+	err              error
+}
+
+// NewSSEClientStream sends the request and returns the stream of its events. The Last-Event-ID
+// header is set from the context, see WithLastEventID. Responses other than 200 OK are decoded
+// with goose.DefaultDecodeError.
+func NewSSEClientStream[Res proto.Message](
+	ctx context.Context,
+	client *http.Client,
+	request *http.Request,
+	unmarshalOpts protojson.UnmarshalOptions,
+) (*SSEClientStream[Res], error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	request = request.WithContext(ctx)
+	request.Header.Set("Accept", EventStreamContentType)
+	request.Header.Set("Cache-Control", "no-cache")
+	if id := LastEventID(ctx); id != "" {
+		request.Header.Set(LastEventIDKey, id)
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		defer cancel()
+		defer response.Body.Close()
+		if err, ok := goose.DefaultDecodeError(ctx, response, goose.DefaultErrorFactory); ok {
+			return nil, err
+		}
+		body, _ := io.ReadAll(response.Body)
+		return nil, goose.NewError(response.StatusCode, string(body))
+	}
+	if mediaType, _, _ := mime.ParseMediaType(response.Header.Get(goose.ContentTypeKey)); mediaType != EventStreamContentType {
+		cancel()
+		response.Body.Close()
+		return nil, errors.New("ws: unexpected content type " + response.Header.Get(goose.ContentTypeKey))
+	}
+	return &SSEClientStream[Res]{
+		ctx:              ctx,
+		cancel:           cancel,
+		body:             response.Body,
+		reader:           bufio.NewReader(response.Body),
+		unmarshalOptions: unmarshalOpts,
+	}, nil
+}
+
+// Context returns the stream's context.
+func (s *SSEClientStream[Res]) Context() context.Context { return s.ctx }
+
+// LastEventID returns the id of the last received event, pass it to WithLastEventID
+// to resume the stream after it.
+func (s *SSEClientStream[Res]) LastEventID() string { return s.lastEventID }
+
+// CloseSend is a no-op, the request of a server-streaming RPC is sent when the stream is created.
+func (s *SSEClientStream[Res]) CloseSend() error { return nil }
+
+// SendMsg always fails, server-sent events only flow from the server to the client.
+func (s *SSEClientStream[Res]) SendMsg(m proto.Message) error {
+	return errors.New("ws: server-sent events streams can not send messages")
+}
+
+// Close stops receiving events and releases the connection.
+func (s *SSEClientStream[Res]) Close() error {
+	s.cancel()
+	return s.body.Close()
+}
+
+// Recv receives the next response message.
+func (s *SSEClientStream[Res]) Recv() (Res, error) {
+	m := newMessage[Res]()
+	if err := s.RecvMsg(m); err != nil {
+		var zero Res
+		return zero, err
+	}
+	return m, nil
+}
+
+// RecvMsg reads the next message event into m. It returns io.EOF after a close event, an *EventError
+// after an error event and io.ErrUnexpectedEOF if the connection ends before either of them,
+// in which case the stream may be resumed after LastEventID. Events of unknown types are skipped.
+func (s *SSEClientStream[Res]) RecvMsg(m proto.Message) error {
+	if s.err != nil {
+		return s.err
+	}
+	for {
+		id, event, data, err := s.readEvent()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return s.fail(err)
+		}
+		if id != nil {
+			s.lastEventID = *id
+		}
+		switch event {
+		case "", EventTypeMessage:
+			return s.unmarshalOptions.Unmarshal([]byte(data), m)
+		case EventTypeClose:
+			return s.fail(io.EOF)
+		case EventTypeError:
+			return s.fail(&EventError{Data: data})
+		}
+	}
+}
+
+// fail ends the stream with err, later calls of RecvMsg return it again.
+func (s *SSEClientStream[Res]) fail(err error) error {
+	s.err = err
+	_ = s.Close()
+	return err
+}
+
+// readEvent reads the fields of the next event, id is nil if the event has no id field.
+func (s *SSEClientStream[Res]) readEvent() (id *string, event string, data string, err error) {
+	var dataLines []string
+	var hasField bool
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return nil, "", "", err
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if line == "" {
+			if !hasField {
+				continue
+			}
+			return id, event, strings.Join(dataLines, "\n"), nil
+		}
+		if strings.HasPrefix(line, ":") {
+			// comment, used as keep-alive
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		hasField = true
+		switch field {
+		case "id":
+			id = &value
+		case "event":
+			event = value
+		case "data":
+			dataLines = append(dataLines, value)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSSEServerStream(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/events", nil)
+	request.Header.Set(LastEventIDKey, "7")
+	recorder := httptest.NewRecorder()
+
+	stream, err := NewSSEServerStream[*wrapperspb.StringValue](context.Background(), recorder, request, protojson.MarshalOptions{Multiline: true}, nil)
+	if err != nil {
+		t.Fatalf("NewSSEServerStream failed: %v", err)
+	}
+	if id := LastEventID(stream.Context()); id != "7" {
+		t.Errorf("LastEventID = %q, want 7", id)
+	}
+	if err := stream.Send(wrapperspb.String("a")); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend failed: %v", err)
+	}
+	if err := stream.Send(wrapperspb.String("b")); !errors.Is(err, io.ErrClosedPipe) {
+		t.Errorf("Send after CloseSend = %v, want io.ErrClosedPipe", err)
+	}
+
+	if ct := recorder.Header().Get("Content-Type"); ct != EventStreamContentType {
+		t.Errorf("Content-Type = %q, want %q", ct, EventStreamContentType)
+	}
+	want := "id: 8\nevent: message\ndata: \"a\"\n\nevent: close\ndata: {}\n\n"
+	if got := recorder.Body.String(); got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+func TestSSEServerStream_EventID(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	eventID := func(ctx context.Context, m proto.Message) string {
+		return m.(*wrapperspb.StringValue).GetValue()
+	}
+	stream, err := NewSSEServerStream[*wrapperspb.StringValue](context.Background(), recorder, httptest.NewRequest(http.MethodGet, "/events", nil), protojson.MarshalOptions{}, eventID)
+	if err != nil {
+		t.Fatalf("NewSSEServerStream failed: %v", err)
+	}
+	if err := stream.Send(wrapperspb.String("x")); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if err := stream.CloseWithError(errors.New("line1\nline2")); err != nil {
+		t.Fatalf("CloseWithError failed: %v", err)
+	}
+	want := "id: x\nevent: message\ndata: \"x\"\n\nevent: error\ndata: line1\ndata: line2\n\n"
+	if got := recorder.Body.String(); got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+func TestSSEClientStream(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(LastEventIDKey) != "3" {
+			t.Errorf("Last-Event-ID = %q, want 3", r.Header.Get(LastEventIDKey))
+		}
+		w.Header().Set("Content-Type", EventStreamContentType)
+		_, _ = io.WriteString(w, strings.Join([]string{
+			": keep-alive",
+			"",
+			"id: 4",
+			"data:",
+			"data: \"a\"",
+			"",
+			"event: unknown",
+			"data: skipped",
+			"",
+			"id: 5",
+			"event: message",
+			"data:\"b\"",
+			"",
+			"event: error",
+			"data: boom",
+			"",
+			"",
+		}, "\n"))
+	}))
+	defer srv.Close()
+
+	ctx := WithLastEventID(context.Background(), "3")
+	request, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	stream, err := NewSSEClientStream[*wrapperspb.StringValue](ctx, nil, request, protojson.UnmarshalOptions{})
+	if err != nil {
+		t.Fatalf("NewSSEClientStream failed: %v", err)
+	}
+	// data lines are joined with newlines
+	m, err := stream.Recv()
+	if err != nil || m.GetValue() != "a" {
+		t.Fatalf("Recv = %v, %v; want a, nil", m, err)
+	}
+	if m, err = stream.Recv(); err != nil || m.GetValue() != "b" {
+		t.Fatalf("Recv = %v, %v; want b, nil", m, err)
+	}
+	if id := stream.LastEventID(); id != "5" {
+		t.Errorf("LastEventID = %q, want 5", id)
+	}
+	var eventErr *EventError
+	if _, err = stream.Recv(); !errors.As(err, &eventErr) || eventErr.Data != "boom" {
+		t.Fatalf("Recv = %v, want EventError boom", err)
+	}
+	if _, err = stream.Recv(); !errors.As(err, &eventErr) {
+		t.Fatalf("Recv after error = %v, want the same error", err)
+	}
+}
+
+func TestSSEClientStream_UnexpectedEOF(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", EventStreamContentType)
+		_, _ = io.WriteString(w, "id: 1\ndata: \"a\"\n\n")
+	}))
+	defer srv.Close()
+
+	request, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	stream, err := NewSSEClientStream[*wrapperspb.StringValue](context.Background(), nil, request, protojson.UnmarshalOptions{})
+	if err != nil {
+		t.Fatalf("NewSSEClientStream failed: %v", err)
+	}
+	if m, err := stream.Recv(); err != nil || m.GetValue() != "a" {
+		t.Fatalf("Recv = %v, %v; want a, nil", m, err)
+	}
+	if _, err := stream.Recv(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Recv = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestEventStreamTarget(t *testing.T) {
+	for target, want := range map[string]string{
+		"ws://localhost:8080":   "http://localhost:8080",
+		"wss://localhost:8080":  "https://localhost:8080",
+		"http://localhost:8080": "http://localhost:8080",
+		"discovery://chat":      "discovery://chat",
+	} {
+		if got := EventStreamTarget(target); got != want {
+			t.Errorf("EventStreamTarget(%q) = %q, want %q", target, got, want)
+		}
+	}
+}
+
+func TestIsWebsocketRequest(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	if IsWebsocketRequest(request) {
+		t.Errorf("plain request reported as websocket")
+	}
+	request.Header.Set("Upgrade", "WebSocket")
+	if !IsWebsocketRequest(request) {
+		t.Errorf("upgrade request not reported as websocket")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/coder/websocket"
+	"github.com/soyacen/goose/client/resolver"
 )
 
 // AcceptOptions returns the default websocket.AcceptOptions for upgrading
@@ -30,3 +31,16 @@ func IsNormalClose(err error) bool {
 		status == websocket.StatusGoingAway ||
 		errors.Is(err, context.Canceled)
 }
+
+// ResolveTarget resolves the target URL of a websocket client with the given resolver.
+// The target is returned unchanged if the resolver is nil.
+func ResolveTarget(ctx context.Context, r resolver.Resolver, target string) (string, error) {
+	if r == nil {
+		return target, nil
+	}
+	u, err := resolver.Resolve(ctx, r, target)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}